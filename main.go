@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -27,9 +28,15 @@ import (
 	componentClient "github.com/cloudogu/k8s-component-lib/client"
 	"github.com/cloudogu/k8s-component-operator/pkg/config"
 	"github.com/cloudogu/k8s-component-operator/pkg/controllers"
+	"github.com/cloudogu/k8s-component-operator/pkg/discovery"
 	"github.com/cloudogu/k8s-component-operator/pkg/health"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm"
 	"github.com/cloudogu/k8s-component-operator/pkg/logging"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
+	"github.com/cloudogu/k8s-component-operator/pkg/metrics"
+	"github.com/cloudogu/k8s-component-operator/pkg/registries"
+	"github.com/cloudogu/k8s-component-operator/pkg/update"
+	componentWebhook "github.com/cloudogu/k8s-component-operator/pkg/webhook"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -42,6 +49,12 @@ var (
 	probeAddr   string
 )
 
+// webhookPort is the port of the admission webhook server.
+const webhookPort = 9443
+
+// leaderElectionID is the name of the lease used for the leader election between the replicas of the operator.
+const leaderElectionID = "k8s-component-operator.k8s.cloudogu.com"
+
 var (
 	// Version of the application
 	Version = "0.0.0"
@@ -95,16 +108,38 @@ func configureManager(ctx context.Context, k8sManager manager.Manager, operatorC
 		return err
 	}
 
-	err = configureReconciler(ctx, k8sManager, clientSet, operatorConfig)
+	maintenanceWindow, err := maintenance.ParseWindow(operatorConfig.MaintenanceWindow)
 	if err != nil {
-		return fmt.Errorf("failed to configure reconciler: %w", err)
+		return err
 	}
 
-	err = addRunners(k8sManager, clientSet, operatorConfig)
+	err = metrics.RegisterComponentCollector(k8sManager.GetClient(), operatorConfig.Namespace)
 	if err != nil {
 		return err
 	}
 
+	helmClientFactory := newHelmClientFactory(operatorConfig)
+	registryWatcher := registries.NewWatcher(operatorConfig.Namespace, clientSet, helmClientFactory)
+	err = registryWatcher.Reload(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read helm registries: %w", err)
+	}
+
+	err = configureReconciler(k8sManager, clientSet, helmClientFactory, operatorConfig, maintenanceWindow)
+	if err != nil {
+		return fmt.Errorf("failed to configure reconciler: %w", err)
+	}
+
+	err = configureWebhooks(k8sManager, clientSet, helmClientFactory, operatorConfig)
+	if err != nil {
+		return fmt.Errorf("failed to configure webhooks: %w", err)
+	}
+
+	err = addRunners(k8sManager, clientSet, helmClientFactory, registryWatcher, operatorConfig, maintenanceWindow)
+	if err != nil {
+		return fmt.Errorf("failed to add runners: %w", err)
+	}
+
 	// +kubebuilder:scaffold:builder
 	err = addChecks(k8sManager)
 	if err != nil {
@@ -114,9 +149,46 @@ func configureManager(ctx context.Context, k8sManager manager.Manager, operatorC
 	return nil
 }
 
-func addRunners(k8sManager manager.Manager, clientSet componentClient.ComponentEcosystemInterface, operatorConfig *config.OperatorConfig) error {
-	healthSyncIntervalHandler := health.NewSyncIntervalHandler(operatorConfig.Namespace, clientSet, operatorConfig.HealthSyncIntervalMins)
-	err := k8sManager.Add(healthSyncIntervalHandler)
+func addRunners(k8sManager manager.Manager, clientSet componentClient.ComponentEcosystemInterface, helmClientFactory *helm.ClientFactory, registryWatcher *registries.Watcher, operatorConfig *config.OperatorConfig, maintenanceWindow maintenance.Window) error {
+	err := k8sManager.Add(registryWatcher)
+	if err != nil {
+		return err
+	}
+
+	healthSyncIntervalHandler := health.NewSyncIntervalHandler(operatorConfig.Namespace, operatorConfig.PodName, clientSet, operatorConfig.HealthSyncIntervalMins)
+	err = k8sManager.Add(healthSyncIntervalHandler)
+	if err != nil {
+		return err
+	}
+
+	autoUpgradePolicy, err := update.ParsePolicy(operatorConfig.AutoUpgradePolicy)
+	if err != nil {
+		return err
+	}
+
+	updateCheckIntervalHandler := update.NewCheckIntervalHandler(
+		operatorConfig.Namespace,
+		clientSet,
+		helmClientFactory.NewHelmClient,
+		k8sManager.GetEventRecorderFor("k8s-component-operator"),
+		operatorConfig.UpdateCheckInterval,
+		autoUpgradePolicy,
+		maintenanceWindow,
+	)
+	err = k8sManager.Add(updateCheckIntervalHandler)
+	if err != nil {
+		return err
+	}
+
+	discoveryIntervalHandler := discovery.NewIntervalHandler(
+		operatorConfig.Namespace,
+		clientSet,
+		helmClientFactory.NewHelmClient,
+		operatorConfig.DiscoveryInterval,
+		operatorConfig.DiscoveryCreateComponents,
+		operatorConfig.DefaultComponentNamespace,
+	)
+	err = k8sManager.Add(discoveryIntervalHandler)
 	if err != nil {
 		return err
 	}
@@ -139,6 +211,23 @@ func getK8sManagerOptions(operatorConfig *config.OperatorConfig) manager.Options
 			}},
 		}},
 		HealthProbeBindAddress: probeAddr,
+		LeaderElection:         operatorConfig.LeaderElection,
+		LeaderElectionID:       leaderElectionID,
+		// the lease is held in the namespace of the operator as all replicas reconcile the components of this namespace
+		LeaderElectionNamespace: operatorConfig.Namespace,
+		// Helm operations run with a context which is not canceled on shutdown. On a regular shutdown, the manager waits up
+		// to the graceful shutdown timeout for running reconciliations before it releases the lease. If the lease is lost
+		// instead, the manager skips the graceful shutdown and the operator exits immediately, interrupting running Helm
+		// operations. The next leader recovers them from the operation journal.
+		LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:                 &operatorConfig.LeaderElectionLease.Duration,
+		RenewDeadline:                 &operatorConfig.LeaderElectionLease.RenewDeadline,
+		RetryPeriod:                   &operatorConfig.LeaderElectionLease.RetryPeriod,
+		GracefulShutdownTimeout:       &operatorConfig.GracefulShutdownTimeout,
+	}
+
+	if operatorConfig.WebhookEnabled {
+		options.WebhookServer = webhook.NewServer(webhook.Options{Port: webhookPort, CertDir: operatorConfig.WebhookCertDir})
 	}
 
 	return options
@@ -155,28 +244,14 @@ func startK8sManager(ctx context.Context, k8sManager manager.Manager) error {
 	return nil
 }
 
-func configureReconciler(ctx context.Context, k8sManager manager.Manager, clientSet componentClient.ComponentEcosystemInterface, operatorConfig *config.OperatorConfig) error {
+func configureReconciler(k8sManager manager.Manager, clientSet componentClient.ComponentEcosystemInterface, helmClientFactory *helm.ClientFactory, operatorConfig *config.OperatorConfig, maintenanceWindow maintenance.Window) error {
 	eventRecorder := k8sManager.GetEventRecorderFor("k8s-component-operator")
 
-	helmRepoData, err := config.GetHelmRepositoryData(ctx, clientSet.CoreV1().ConfigMaps(operatorConfig.Namespace))
-	if err != nil {
-		return err
-	}
-	operatorConfig.HelmRepositoryData = helmRepoData
-
-	debug := config.Stage == config.StageDevelopment
-	helmClientFactory := helm.NewClientFactory(
-		operatorConfig.Namespace,
-		operatorConfig.HelmRepositoryData,
-		debug,
-		logging.FormattingLoggerWithName("helm-client", ctrl.Log.Info),
-	)
-
 	yamlSerializer := yaml.NewSerializer()
 	reader := configref.NewConfigMapRefReader(clientSet.CoreV1().ConfigMaps(operatorConfig.Namespace))
 
-	componentReconciler := controllers.NewComponentReconciler(clientSet, helmClientFactory.NewHelmClient, eventRecorder, operatorConfig.Namespace, operatorConfig.HelmClientTimeoutMins, yamlSerializer, reader, operatorConfig.RequeueTime)
-	err = componentReconciler.SetupWithManager(k8sManager)
+	componentReconciler := controllers.NewComponentReconciler(clientSet, helmClientFactory.NewHelmClient, eventRecorder, operatorConfig.Namespace, operatorConfig.HelmClientTimeoutMins, yamlSerializer, reader, operatorConfig.RequeueTime, operatorConfig.MaxRequeueTime, operatorConfig.AllowDowngrades, maintenanceWindow, operatorConfig.UpgradeVerificationTimeout)
+	err := componentReconciler.SetupWithManager(k8sManager)
 	if err != nil {
 		return fmt.Errorf("failed to setup reconciler with manager: %w", err)
 	}
@@ -190,6 +265,37 @@ func configureReconciler(ctx context.Context, k8sManager manager.Manager, client
 	return nil
 }
 
+func configureWebhooks(k8sManager manager.Manager, clientSet componentClient.ComponentEcosystemInterface, helmClientFactory *helm.ClientFactory, operatorConfig *config.OperatorConfig) error {
+	if !operatorConfig.WebhookEnabled {
+		return nil
+	}
+
+	validator := componentWebhook.NewComponentValidator(clientSet.CoreV1().ConfigMaps(operatorConfig.Namespace), operatorConfig.AllowDowngrades)
+	err := validator.SetupWebhookWithManager(k8sManager)
+	if err != nil {
+		return fmt.Errorf("failed to setup validating webhook with manager: %w", err)
+	}
+
+	defaulter := componentWebhook.NewComponentDefaulter(helmClientFactory.NewHelmClient, operatorConfig.DefaultComponentNamespace, operatorConfig.PinLatestVersion)
+	err = defaulter.SetupWebhookWithManager(k8sManager)
+	if err != nil {
+		return fmt.Errorf("failed to setup mutating webhook with manager: %w", err)
+	}
+
+	return nil
+}
+
+func newHelmClientFactory(operatorConfig *config.OperatorConfig) *helm.ClientFactory {
+	debug := config.Stage == config.StageDevelopment
+	// the registries are set by the registry watcher
+	return helm.NewClientFactory(
+		operatorConfig.Namespace,
+		nil,
+		debug,
+		logging.FormattingLoggerWithName("helm-client", ctrl.Log.Info),
+	)
+}
+
 func createEcosystemClientSet(k8sManager manager.Manager) (*componentClient.EcosystemClientset, error) {
 	clientSet, err := kubernetes.NewForConfig(k8sManager.GetConfig())
 	if err != nil {
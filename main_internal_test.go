@@ -90,6 +90,7 @@ func Test_startDoguOperator(t *testing.T) {
 	defaultMockDefinitions := map[string]mockDefinition{
 		"GetScheme":           {ReturnValue: scheme},
 		"GetConfig":           {ReturnValue: myConfig},
+		"GetClient":           {ReturnValue: nil},
 		"GetEventRecorderFor": {Arguments: []interface{}{mock.Anything}, ReturnValue: nil},
 		"GetControllerOptions": {ReturnValue: config.Controller{
 			SkipNameValidation: &skipNameValidation,
@@ -0,0 +1,50 @@
+// Package annotations contains the annotations of components which control the operations of the component operator.
+package annotations
+
+import (
+	"strconv"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+const (
+	// AllowDowngradeAnnotation enables downgrades for a single component if set to "true".
+	AllowDowngradeAnnotation = "k8s.cloudogu.com/allow-downgrade"
+	// ForceDeleteAnnotation allows to delete a component if set to "true" even if other installed components depend on it.
+	ForceDeleteAnnotation = "k8s.cloudogu.com/force-delete"
+	// CascadeDeleteAnnotation uninstalls all components depending on a component before the component itself if set to "true".
+	CascadeDeleteAnnotation = "k8s.cloudogu.com/cascade-delete"
+	// IgnoreDependentConstraintsAnnotation allows to upgrade a component if set to "true" even if the new version does
+	// not satisfy the version requirements of installed components depending on it.
+	IgnoreDependentConstraintsAnnotation = "k8s.cloudogu.com/ignore-dependent-constraints"
+	// EmergencyOperationAnnotation allows to upgrade, downgrade or delete a component outside its maintenance window if
+	// set to "true".
+	EmergencyOperationAnnotation = "k8s.cloudogu.com/emergency-operation"
+	// MaintenanceWindowAnnotation overrides the cluster-wide maintenance window for a single component.
+	MaintenanceWindowAnnotation = "k8s.cloudogu.com/maintenance-window"
+	// DryRunAnnotation renders installations, upgrades and downgrades of a component with a helm dry-run instead of
+	// performing them if set to "true". The diff to the deployed release is stored in a config map.
+	DryRunAnnotation = "k8s.cloudogu.com/dry-run"
+	// AdoptAnnotation takes over an existing helm release of the component without reinstalling it if set to "true".
+	// The annotation is removed after the adoption.
+	AdoptAnnotation = "k8s.cloudogu.com/adopt"
+	// MigrateNamespaceAnnotation allows to change the deploy namespace of an installed component if set to "true". The
+	// component is then migrated to the new namespace. The annotation is removed after the migration.
+	MigrateNamespaceAnnotation = "k8s.cloudogu.com/migrate-namespace"
+	// MigrateResourcesAnnotation lists the secrets and persistent volume claims which are taken along by a migration,
+	// e.g. "secret/credentials,pvc/data".
+	MigrateResourcesAnnotation = "k8s.cloudogu.com/migrate-resources"
+	// AutoUpgradePolicyAnnotation overrides the cluster-wide auto-upgrade policy for a single component.
+	AutoUpgradePolicyAnnotation = "k8s.cloudogu.com/auto-upgrade-policy"
+)
+
+// IsTrue returns true if the component has the given annotation with a value that parses to true.
+func IsTrue(component *k8sv1.Component, annotation string) bool {
+	value, ok := component.Annotations[annotation]
+	if !ok {
+		return false
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	return err == nil && parsed
+}
@@ -0,0 +1,31 @@
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+func TestIsTrue(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "should be true for true", annotations: map[string]string{AdoptAnnotation: "true"}, want: true},
+		{name: "should be true for other boolean values", annotations: map[string]string{AdoptAnnotation: "1"}, want: true},
+		{name: "should be false for false", annotations: map[string]string{AdoptAnnotation: "false"}, want: false},
+		{name: "should be false for invalid values", annotations: map[string]string{AdoptAnnotation: "yes"}, want: false},
+		{name: "should be false without annotation", annotations: map[string]string{DryRunAnnotation: "true"}, want: false},
+		{name: "should be false without annotations", annotations: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component := &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			assert.Equal(t, tt.want, IsTrue(component, AdoptAnnotation))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package conditions
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+const (
+	// TypeReady is true if the component is installed and all its applications are available.
+	TypeReady = "Ready"
+	// TypeProgressing is true while an installation, upgrade, downgrade or deletion is in progress or waits to be retried.
+	TypeProgressing = "Progressing"
+	// TypeDependenciesSatisfied is true if all dependencies of the component are installed in a matching version.
+	TypeDependenciesSatisfied = "DependenciesSatisfied"
+	// TypeValuesValid is true if the values of the component could be read and parsed.
+	TypeValuesValid = "ValuesValid"
+	// TypeDegraded is true if the component is installed but not all its applications are available.
+	TypeDegraded = "Degraded"
+	// TypeFailed is true if the last operation of the component failed.
+	TypeFailed = "Failed"
+	// TypeAdopted is true if the component took over an existing helm release instead of installing it.
+	TypeAdopted = "Adopted"
+)
+
+const (
+	// ReasonSucceeded is used if the last operation succeeded.
+	ReasonSucceeded = "Succeeded"
+	// ReasonRetrying is used if a failed operation waits to be retried.
+	ReasonRetrying = "Retrying"
+	// ReasonScheduled is used if an operation waits for the maintenance window.
+	ReasonScheduled = "Scheduled"
+	// ReasonCanceled is used if an operation waiting for the maintenance window is no longer required.
+	ReasonCanceled = "Canceled"
+	// ReasonDependenciesInstalled is used if all dependencies are installed in a matching version.
+	ReasonDependenciesInstalled = "DependenciesInstalled"
+	// ReasonDependenciesUnsatisfied is used if dependencies are missing or installed in a wrong version.
+	ReasonDependenciesUnsatisfied = "DependenciesUnsatisfied"
+	// ReasonValuesApplied is used if the values were applied successfully.
+	ReasonValuesApplied = "ValuesApplied"
+	// ReasonInvalidValues is used if the values could not be read or parsed.
+	ReasonInvalidValues = "InvalidValues"
+	// ReasonAvailable is used if all applications of the component are available.
+	ReasonAvailable = "Available"
+	// ReasonUnavailable is used if not all applications of the component are available.
+	ReasonUnavailable = "Unavailable"
+	// ReasonNotInstalled is used if the component is not installed yet or an operation is in progress.
+	ReasonNotInstalled = "NotInstalled"
+	// ReasonHealthUnknown is used if the health of the component cannot be determined, e.g. because the operator
+	// shuts down.
+	ReasonHealthUnknown = "HealthUnknown"
+	// ReasonReleaseAdopted is used if an existing helm release was adopted.
+	ReasonReleaseAdopted = "ReleaseAdopted"
+	// ReasonVerifying is used while an upgraded component is waited for to become available.
+	ReasonVerifying = "Verifying"
+	// ReasonRolledBack is used if an upgrade was rolled back because the component did not become available.
+	ReasonRolledBack = "RolledBack"
+)
+
+// componentClient contains the methods of the component client needed to persist conditions.
+type componentClient interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Component, error)
+	UpdateStatus(ctx context.Context, component *v1.Component, opts metav1.UpdateOptions) (*v1.Component, error)
+}
+
+// Find returns the condition with the given type or nil if the component does not have it.
+func Find(component *v1.Component, conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(component.Status.Conditions, conditionType)
+}
+
+// Set sets the given conditions in the status of the component and returns true if any of them changed. The observed
+// generation of the conditions is set to the generation of the component. The transition time only changes with the
+// status.
+func Set(component *v1.Component, newConditions ...metav1.Condition) bool {
+	changed := false
+	for _, condition := range newConditions {
+		condition.ObservedGeneration = component.Generation
+		changed = meta.SetStatusCondition(&component.Status.Conditions, condition) || changed
+	}
+
+	return changed
+}
+
+// Writer persists conditions of components.
+type Writer struct {
+	client componentClient
+}
+
+// NewWriter creates a new Writer which persists conditions with the given component client.
+func NewWriter(client componentClient) *Writer {
+	return &Writer{client: client}
+}
+
+// Update sets the given conditions on the current state of the component and updates its status in the cluster if any
+// of the conditions changed. The given component is updated with the result so that subsequent updates do not conflict.
+func (w *Writer) Update(ctx context.Context, component *v1.Component, newConditions []metav1.Condition) error {
+	return retry.OnConflict(func() error {
+		updatedComponent, err := w.client.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if !Set(updatedComponent, newConditions...) {
+			return nil
+		}
+
+		updatedComponent, err = w.client.UpdateStatus(ctx, updatedComponent, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		*component = *updatedComponent
+		return nil
+	})
+}
@@ -0,0 +1,160 @@
+package conditions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+var testCtx = context.Background()
+
+func newTestComponent() *v1.Component {
+	return &v1.Component{ObjectMeta: metav1.ObjectMeta{Name: "dogu-op", Generation: 3}}
+}
+
+func newTestCondition(status metav1.ConditionStatus) metav1.Condition {
+	return metav1.Condition{Type: TypeProgressing, Status: status, Reason: "Installation", Message: "Installation in progress"}
+}
+
+func TestFind(t *testing.T) {
+	t.Run("should return nil without conditions", func(t *testing.T) {
+		assert.Nil(t, Find(newTestComponent(), TypeProgressing))
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Run("should set conditions with observed generation", func(t *testing.T) {
+		// given
+		component := newTestComponent()
+
+		// when
+		changed := Set(component, newTestCondition(metav1.ConditionTrue))
+
+		// then
+		assert.True(t, changed)
+		actual := Find(component, TypeProgressing)
+		require.NotNil(t, actual)
+		assert.Equal(t, metav1.ConditionTrue, actual.Status)
+		assert.Equal(t, "Installation", actual.Reason)
+		assert.Equal(t, int64(3), actual.ObservedGeneration)
+		assert.False(t, actual.LastTransitionTime.IsZero())
+		assert.Nil(t, Find(component, TypeReady))
+	})
+	t.Run("should not change equal conditions", func(t *testing.T) {
+		// given
+		component := newTestComponent()
+		Set(component, newTestCondition(metav1.ConditionTrue))
+		before := component.DeepCopy().Status.Conditions
+
+		// when
+		changed := Set(component, newTestCondition(metav1.ConditionTrue))
+
+		// then
+		assert.False(t, changed)
+		assert.Equal(t, before, component.Status.Conditions)
+	})
+	t.Run("should change conditions on new generation", func(t *testing.T) {
+		// given
+		component := newTestComponent()
+		Set(component, newTestCondition(metav1.ConditionTrue))
+		component.Generation = 4
+
+		// when
+		changed := Set(component, newTestCondition(metav1.ConditionTrue))
+
+		// then
+		assert.True(t, changed)
+		assert.Equal(t, int64(4), Find(component, TypeProgressing).ObservedGeneration)
+	})
+}
+
+func TestWriter_Update(t *testing.T) {
+	t.Run("should update changed conditions", func(t *testing.T) {
+		// given
+		component := newTestComponent()
+		clientMock := newMockComponentClient(t)
+		clientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(newTestComponent(), nil)
+		clientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, c *v1.Component, _ metav1.UpdateOptions) (*v1.Component, error) {
+			c.ResourceVersion = "2"
+			return c, nil
+		})
+		sut := NewWriter(clientMock)
+
+		// when
+		err := sut.Update(testCtx, component, []metav1.Condition{newTestCondition(metav1.ConditionTrue)})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2", component.ResourceVersion)
+		assert.Equal(t, metav1.ConditionTrue, Find(component, TypeProgressing).Status)
+	})
+	t.Run("should not update unchanged conditions", func(t *testing.T) {
+		// given
+		component := newTestComponent()
+		Set(component, newTestCondition(metav1.ConditionTrue))
+		clientMock := newMockComponentClient(t)
+		clientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		sut := NewWriter(clientMock)
+
+		// when
+		err := sut.Update(testCtx, component, []metav1.Condition{newTestCondition(metav1.ConditionTrue)})
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should retry on conflict", func(t *testing.T) {
+		// given
+		component := newTestComponent()
+		clientMock := newMockComponentClient(t)
+		clientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).RunAndReturn(func(context.Context, string, metav1.GetOptions) (*v1.Component, error) {
+			return newTestComponent(), nil
+		}).Twice()
+		clientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).
+			Return(nil, k8serrors.NewConflict(schema.GroupResource{Resource: "components"}, "dogu-op", assert.AnError)).Once()
+		clientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).
+			RunAndReturn(func(_ context.Context, c *v1.Component, _ metav1.UpdateOptions) (*v1.Component, error) {
+				return c, nil
+			}).Once()
+		sut := NewWriter(clientMock)
+
+		// when
+		err := sut.Update(testCtx, component, []metav1.Condition{newTestCondition(metav1.ConditionTrue)})
+
+		// then
+		require.NoError(t, err)
+		assert.NotNil(t, Find(component, TypeProgressing))
+	})
+	t.Run("should fail to get component", func(t *testing.T) {
+		// given
+		clientMock := newMockComponentClient(t)
+		clientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(nil, assert.AnError)
+		sut := NewWriter(clientMock)
+
+		// when
+		err := sut.Update(testCtx, newTestComponent(), []metav1.Condition{newTestCondition(metav1.ConditionTrue)})
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should fail to update status of component", func(t *testing.T) {
+		// given
+		clientMock := newMockComponentClient(t)
+		clientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(newTestComponent(), nil)
+		clientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+		sut := NewWriter(clientMock)
+
+		// when
+		err := sut.Update(testCtx, newTestComponent(), []metav1.Condition{newTestCondition(metav1.ConditionTrue)})
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -31,8 +31,8 @@ const (
 	runtimeLocal = "local"
 	// RequeueTimeInNanosecondsEnvironmentVariable is the name of the environment variable containing the configured requeueTime
 	RequeueTimeInNanosecondsEnvironmentVariable = "REQUEUE_TIME_IN_NANOSECONDS"
-	// helmRepositoryConfigMapName is the name
-	helmRepositoryConfigMapName = "component-operator-helm-repository"
+	// HelmRepositoryConfigMapName is the name of the config map configuring the helm registries.
+	HelmRepositoryConfigMapName = "component-operator-helm-repository"
 )
 
 const defaultRequeueTime = time.Second * 3
@@ -45,23 +45,68 @@ var (
 var (
 	envVarNamespace = "NAMESPACE"
 
-	envHelmClientTimeoutMins      = "HELM_CLIENT_TIMEOUT_MINS"
-	defaultHelmClientTimeoutMins  = time.Duration(15) * time.Minute
-	envHealthSyncIntervalMins     = "HEALTH_SYNC_INTERVAL_MINS"
-	defaultHealthSyncIntervalMins = time.Duration(2) * time.Minute
+	envHelmClientTimeoutMins          = "HELM_CLIENT_TIMEOUT_MINS"
+	defaultHelmClientTimeoutMins      = time.Duration(15) * time.Minute
+	envHealthSyncIntervalMins         = "HEALTH_SYNC_INTERVAL_MINS"
+	defaultHealthSyncIntervalMins     = time.Duration(2) * time.Minute
+	envAllowDowngrades                = "ALLOW_COMPONENT_DOWNGRADES"
+	envMaxRequeueTimeMins             = "MAX_REQUEUE_TIME_MINS"
+	defaultMaxRequeueTimeMins         = time.Duration(10) * time.Minute
+	envUpdateCheckIntervalMins        = "UPDATE_CHECK_INTERVAL_MINS"
+	defaultUpdateCheckInterval        = time.Duration(60) * time.Minute
+	envAutoUpgradePolicy              = "AUTO_UPGRADE_POLICY"
+	envMaintenanceWindow              = "MAINTENANCE_WINDOW"
+	envUpgradeVerificationTimeout     = "UPGRADE_VERIFICATION_TIMEOUT_MINS"
+	defaultUpgradeVerificationTimeout = time.Duration(5) * time.Minute
+	envPodName                        = "POD_NAME"
+	envLeaderElection                 = "LEADER_ELECTION_ENABLED"
+	envLeaseDuration                  = "LEADER_ELECTION_LEASE_DURATION_SECS"
+	defaultLeaseDuration              = time.Duration(15) * time.Second
+	envRenewDeadline                  = "LEADER_ELECTION_RENEW_DEADLINE_SECS"
+	defaultRenewDeadline              = time.Duration(10) * time.Second
+	envRetryPeriod                    = "LEADER_ELECTION_RETRY_PERIOD_SECS"
+	defaultRetryPeriod                = time.Duration(2) * time.Second
+	envGracefulShutdownTimeout        = "GRACEFUL_SHUTDOWN_TIMEOUT_SECS"
+	defaultGracefulShutdownTimeout    = time.Duration(50) * time.Second
+	envWebhookEnabled                 = "WEBHOOK_ENABLED"
+	envWebhookCertDir                 = "WEBHOOK_CERT_DIR"
+	defaultWebhookCertDir             = "/tmp/k8s-webhook-server/serving-certs"
+	envDefaultComponentNamespace      = "DEFAULT_COMPONENT_NAMESPACE"
+	defaultComponentNamespace         = "k8s"
+	envPinLatestVersion               = "WEBHOOK_PIN_LATEST_VERSION"
+	envDiscoveryIntervalMins          = "DISCOVERY_INTERVAL_MINS"
+	defaultDiscoveryInterval          = time.Duration(30) * time.Minute
+	envDiscoveryCreateComponents      = "DISCOVERY_CREATE_COMPONENTS"
 
 	log = ctrl.Log.WithName("config")
 )
 
 const (
-	configMapSchema      = "schema"
-	configMapPlainHttp   = "plainHttp"
-	configMapInsecureTls = "insecureTls"
+	configMapSchema           = "schema"
+	configMapPlainHttp        = "plainHttp"
+	configMapInsecureTls      = "insecureTls"
+	configMapRegistries       = "registries"
+	configMapNamespaceMapping = "namespaceMapping"
+	configMapCredentials      = "credentialsSecret"
+	configMapCABundleConfig   = "caBundleConfigMap"
+	configMapCABundleSecret   = "caBundleSecret"
+	configMapCABundleKey      = "caBundleKey"
+	configMapClientCertSecret = "clientCertSecret"
+	configMapProxySecret      = "proxySecret"
 )
 
+// DefaultRegistryName is the name of the registry configured by the keys endpoint, schema, plainHttp and insecureTls
+// of the helm repository config map.
+const DefaultRegistryName = "default"
+
 type EndpointSchema string
 
-const EndpointSchemaOCI EndpointSchema = "oci"
+const (
+	// EndpointSchemaOCI describes an OCI registry which provides the charts as OCI artifacts.
+	EndpointSchemaOCI EndpointSchema = "oci"
+	// EndpointSchemaHTTPS describes a classic chart repository which lists its charts in an index.yaml.
+	EndpointSchemaHTTPS EndpointSchema = "https"
+)
 
 type configMapInterface interface {
 	corev1.ConfigMapInterface
@@ -69,6 +114,8 @@ type configMapInterface interface {
 
 // HelmRepositoryData contains all necessary data for the helm repository.
 type HelmRepositoryData struct {
+	// Name identifies the registry for the selection by components and the namespace mapping.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 	// Endpoint contains the Helm registry endpoint URL.
 	Endpoint string `json:"endpoint" yaml:"endpoint"`
 	// Schema describes the way how clients communicate with the Helm registry endpoint.
@@ -77,11 +124,35 @@ type HelmRepositoryData struct {
 	PlainHttp bool `json:"plainHttp,omitempty" yaml:"plainHttp,omitempty"`
 	// InsecureTls allows invalid or selfsigned certificates to be used. This option may be overridden by PlainHttp which forces HTTP traffic.
 	InsecureTLS bool `json:"insecureTls" yaml:"insecureTls"`
+	// CredentialsSecret contains the name of a secret in the namespace of the operator with the credentials for the
+	// registry. The shared registry config of the operator is used if it is empty.
+	CredentialsSecret string `json:"credentialsSecret,omitempty" yaml:"credentialsSecret,omitempty"`
+	// Username is read from the CredentialsSecret and never serialized.
+	Username string `json:"-" yaml:"-"`
+	// Password is read from the CredentialsSecret and never serialized.
+	Password string `json:"-" yaml:"-"`
+	// CABundle references CA certificates which are trusted for the registry in addition to the system CAs.
+	CABundle *CABundleRef `json:"caBundle,omitempty" yaml:"caBundle,omitempty"`
+	// ClientCertSecret contains the name of a secret of the type kubernetes.io/tls in the namespace of the operator with
+	// the client certificate and key which authenticate the operator at the registry by mTLS.
+	ClientCertSecret string `json:"clientCertSecret,omitempty" yaml:"clientCertSecret,omitempty"`
+	// CAData contains the PEM encoded CA certificates read from the CABundle. It is never serialized.
+	CAData []byte `json:"-" yaml:"-"`
+	// CertData contains the PEM encoded client certificate read from the ClientCertSecret. It is never serialized.
+	CertData []byte `json:"-" yaml:"-"`
+	// KeyData contains the PEM encoded client key read from the ClientCertSecret. It is never serialized.
+	KeyData []byte `json:"-" yaml:"-"`
 }
 
-// URL returns the full URL Helm repository endpoint including schema.
+// URL returns the full URL Helm repository endpoint including schema. Chart repositories accessed with plain http use
+// the schema http instead of https.
 func (hrd *HelmRepositoryData) URL() string {
-	input := []string{string(hrd.Schema), hrd.Endpoint}
+	schema := string(hrd.Schema)
+	if hrd.Schema == EndpointSchemaHTTPS && hrd.PlainHttp {
+		schema = "http"
+	}
+
+	input := []string{schema, hrd.Endpoint}
 
 	return strings.Join(input, "://")
 }
@@ -95,8 +166,92 @@ func (hrd *HelmRepositoryData) validate() error {
 		return fmt.Errorf("endpoint URL '%s' solely consist of the endpoint without schema or ://", hrd.Endpoint)
 	}
 
-	if hrd.Schema != EndpointSchemaOCI {
-		return fmt.Errorf("endpoint uses an unsupported schema '%s': valid schemas are: oci", hrd.Schema)
+	if hrd.Schema != EndpointSchemaOCI && hrd.Schema != EndpointSchemaHTTPS {
+		return fmt.Errorf("endpoint uses an unsupported schema '%s': valid schemas are: oci, https", hrd.Schema)
+	}
+
+	if hrd.CABundle != nil {
+		return hrd.CABundle.validate()
+	}
+
+	return nil
+}
+
+// HelmRegistries contains all configured helm registries in the order in which charts are looked up.
+type HelmRegistries struct {
+	// Registries contains the registries in the order of the fallback.
+	Registries []*HelmRepositoryData `json:"registries" yaml:"registries"`
+	// NamespaceMapping maps component namespaces like k8s-testing to the name of the registry serving them.
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty" yaml:"namespaceMapping,omitempty"`
+	// ProxySecret contains the name of the secret with the proxy of all registries. It defaults to ces-proxy.
+	ProxySecret string `json:"proxySecret,omitempty" yaml:"proxySecret,omitempty"`
+	// Proxy is resolved from the ProxySecret. No proxy is used if it is nil.
+	Proxy *ProxyConfig `json:"-" yaml:"-"`
+}
+
+// Get returns the registry with the given name or nil if no such registry is configured.
+func (hr *HelmRegistries) Get(name string) *HelmRepositoryData {
+	for _, registry := range hr.Registries {
+		if registry.Name == name {
+			return registry
+		}
+	}
+
+	return nil
+}
+
+// Select returns the registries in which a chart is looked up. The registry with the given name comes first. Without
+// a name, the registry mapped to the namespace of the chart or else the first registry comes first. All other
+// registries follow in the configured order.
+func (hr *HelmRegistries) Select(registryName string, namespace string) ([]*HelmRepositoryData, error) {
+	if registryName == "" {
+		registryName = hr.NamespaceMapping[namespace]
+	}
+
+	if registryName == "" {
+		return hr.Registries, nil
+	}
+
+	selected := hr.Get(registryName)
+	if selected == nil {
+		return nil, fmt.Errorf("helm registry %q is not configured", registryName)
+	}
+
+	registries := []*HelmRepositoryData{selected}
+	for _, registry := range hr.Registries {
+		if registry != selected {
+			registries = append(registries, registry)
+		}
+	}
+
+	return registries, nil
+}
+
+func (hr *HelmRegistries) validate() error {
+	if len(hr.Registries) == 0 {
+		return fmt.Errorf("at least one registry must be configured")
+	}
+
+	names := map[string]bool{}
+	for _, registry := range hr.Registries {
+		if registry.Name == "" {
+			return fmt.Errorf("registry with endpoint '%s' must have a name", registry.Endpoint)
+		}
+		if names[registry.Name] {
+			return fmt.Errorf("registry name '%s' is not unique", registry.Name)
+		}
+		names[registry.Name] = true
+
+		err := registry.validate()
+		if err != nil {
+			return fmt.Errorf("registry '%s' is invalid: %w", registry.Name, err)
+		}
+	}
+
+	for namespace, registryName := range hr.NamespaceMapping {
+		if !names[registryName] {
+			return fmt.Errorf("namespace '%s' is mapped to the unknown registry '%s'", namespace, registryName)
+		}
 	}
 
 	return nil
@@ -107,12 +262,66 @@ type OperatorConfig struct {
 	// Namespace specifies the namespace that the operator is deployed to.
 	Namespace string `json:"namespace"`
 	// Version contains the current version of the operator
-	Version *semver.Version `json:"version"`
-	// HelmRepositoryData contains all necessary data for the helm repository.
-	HelmRepositoryData     *HelmRepositoryData `json:"helm_repository"`
+	Version                *semver.Version `json:"version"`
 	HelmClientTimeoutMins  time.Duration
 	HealthSyncIntervalMins time.Duration
 	RequeueTime            time.Duration
+	// MaxRequeueTime caps the exponentially growing requeue time of failed component operations.
+	MaxRequeueTime time.Duration
+	// AllowDowngrades enables downgrades for all components. Downgrades can also be enabled per component by annotation.
+	AllowDowngrades bool
+	// UpdateCheckInterval is the interval in which the registry is checked for newer versions of all components.
+	UpdateCheckInterval time.Duration
+	// AutoUpgradePolicy is the default policy for automatic upgrades of components.
+	AutoUpgradePolicy string
+	// MaintenanceWindow is the default maintenance window for disruptive operations on components.
+	MaintenanceWindow string
+	// UpgradeVerificationTimeout is the maximum time to wait for an upgraded component to become available before the
+	// upgrade is rolled back.
+	UpgradeVerificationTimeout time.Duration
+	// PodName is the name of the pod the operator runs in.
+	PodName string
+	// LeaderElection ensures that only one replica of the operator performs operations at the same time.
+	LeaderElection bool
+	// LeaderElectionLease contains the durations of the lease used for the leader election.
+	LeaderElectionLease LeaseConfig
+	// GracefulShutdownTimeout is the maximum time to wait for running operations to finish before the operator stops
+	// and gives up its leadership.
+	GracefulShutdownTimeout time.Duration
+	// WebhookEnabled starts the admission webhooks for components.
+	WebhookEnabled bool
+	// WebhookCertDir is the directory containing the serving certificate (tls.crt) and key (tls.key) of the webhooks.
+	WebhookCertDir string
+	// DefaultComponentNamespace is the registry namespace set by the mutating webhook for components without one.
+	DefaultComponentNamespace string
+	// PinLatestVersion lets the mutating webhook replace an empty version of a new component with the latest version.
+	PinLatestVersion bool
+	// DiscoveryInterval is the interval in which helm releases and components are compared to discover orphans.
+	DiscoveryInterval time.Duration
+	// DiscoveryCreateComponents lets the discovery create components adopting deployed releases without a component.
+	DiscoveryCreateComponents bool
+}
+
+// LeaseConfig contains the durations of the lease used for the leader election.
+type LeaseConfig struct {
+	// Duration is the time non-leaders wait before they try to acquire the leadership of an unrenewed lease.
+	Duration time.Duration
+	// RenewDeadline is the time the leader retries to renew the lease before it gives up its leadership.
+	RenewDeadline time.Duration
+	// RetryPeriod is the time between tries to acquire or renew the lease.
+	RetryPeriod time.Duration
+}
+
+func (lc LeaseConfig) validate() error {
+	if lc.RenewDeadline >= lc.Duration {
+		return fmt.Errorf("renew deadline %s must be shorter than the lease duration %s", lc.RenewDeadline, lc.Duration)
+	}
+
+	if lc.RetryPeriod >= lc.RenewDeadline {
+		return fmt.Errorf("retry period %s must be shorter than the renew deadline %s", lc.RetryPeriod, lc.RenewDeadline)
+	}
+
+	return nil
 }
 
 // NewOperatorConfig creates a new operator config by reading values from the environment variables
@@ -145,84 +354,186 @@ func NewOperatorConfig(version string) (*OperatorConfig, error) {
 	}
 
 	return &OperatorConfig{
-		Namespace:              namespace,
-		Version:                parsedVersion,
-		HelmClientTimeoutMins:  readMinuteDurationEnv(envHelmClientTimeoutMins, defaultHelmClientTimeoutMins),
-		HealthSyncIntervalMins: readMinuteDurationEnv(envHealthSyncIntervalMins, defaultHealthSyncIntervalMins),
-		RequeueTime:            requeueTime,
+		Namespace:                  namespace,
+		Version:                    parsedVersion,
+		HelmClientTimeoutMins:      readMinuteDurationEnv(envHelmClientTimeoutMins, defaultHelmClientTimeoutMins),
+		HealthSyncIntervalMins:     readMinuteDurationEnv(envHealthSyncIntervalMins, defaultHealthSyncIntervalMins),
+		RequeueTime:                requeueTime,
+		MaxRequeueTime:             readMinuteDurationEnv(envMaxRequeueTimeMins, defaultMaxRequeueTimeMins),
+		AllowDowngrades:            readBoolEnv(envAllowDowngrades, false),
+		UpdateCheckInterval:        readMinuteDurationEnv(envUpdateCheckIntervalMins, defaultUpdateCheckInterval),
+		AutoUpgradePolicy:          readStringEnv(envAutoUpgradePolicy, ""),
+		MaintenanceWindow:          readStringEnv(envMaintenanceWindow, ""),
+		UpgradeVerificationTimeout: readMinuteDurationEnv(envUpgradeVerificationTimeout, defaultUpgradeVerificationTimeout),
+		PodName:                    readStringEnv(envPodName, ""),
+		LeaderElection:             readBoolEnv(envLeaderElection, true),
+		LeaderElectionLease:        readLeaseConfig(),
+		GracefulShutdownTimeout:    readSecondDurationEnv(envGracefulShutdownTimeout, defaultGracefulShutdownTimeout),
+		WebhookEnabled:             readBoolEnv(envWebhookEnabled, false),
+		WebhookCertDir:             readStringEnv(envWebhookCertDir, defaultWebhookCertDir),
+		DefaultComponentNamespace:  readStringEnv(envDefaultComponentNamespace, defaultComponentNamespace),
+		PinLatestVersion:           readBoolEnv(envPinLatestVersion, false),
+		DiscoveryInterval:          readMinuteDurationEnv(envDiscoveryIntervalMins, defaultDiscoveryInterval),
+		DiscoveryCreateComponents:  readBoolEnv(envDiscoveryCreateComponents, false),
 	}, nil
 }
 
-// GetHelmRepositoryData reads the repository data either from file or from a secret in the cluster.
-func GetHelmRepositoryData(ctx context.Context, configMapClient configMapInterface) (*HelmRepositoryData, error) {
+func readLeaseConfig() LeaseConfig {
+	leaseConfig := LeaseConfig{
+		Duration:      readSecondDurationEnv(envLeaseDuration, defaultLeaseDuration),
+		RenewDeadline: readSecondDurationEnv(envRenewDeadline, defaultRenewDeadline),
+		RetryPeriod:   readSecondDurationEnv(envRetryPeriod, defaultRetryPeriod),
+	}
+
+	err := leaseConfig.validate()
+	if err != nil {
+		log.Error(err, "invalid leader election lease, using default lease")
+		return LeaseConfig{Duration: defaultLeaseDuration, RenewDeadline: defaultRenewDeadline, RetryPeriod: defaultRetryPeriod}
+	}
+
+	return leaseConfig
+}
+
+// GetHelmRegistries reads the registries either from file or from a config map in the cluster.
+func GetHelmRegistries(ctx context.Context, configMapClient configMapInterface) (*HelmRegistries, error) {
 	runtime, err := getEnvVar(runtimeEnvironmentVariable)
 	if err != nil {
 		log.Info("Runtime env var not found.")
 	}
 
 	if runtime == runtimeLocal {
-		return NewHelmRepoDataFromFile(devHelmRepoDataPath)
+		return NewHelmRegistriesFromFile(devHelmRepoDataPath)
 	}
 
-	return NewHelmRepoDataFromCluster(ctx, configMapClient)
+	return NewHelmRegistriesFromCluster(ctx, configMapClient)
 }
 
-// NewHelmRepoDataFromCluster reads the repo data ConfigMap, validates and returns it.
-func NewHelmRepoDataFromCluster(ctx context.Context, configMapClient configMapInterface) (*HelmRepositoryData, error) {
-	configMap, err := configMapClient.Get(ctx, helmRepositoryConfigMapName, metav1.GetOptions{})
+// NewHelmRegistriesFromCluster reads the repo data ConfigMap, validates and returns it. The keys endpoint, schema,
+// plainHttp, insecureTls, credentialsSecret, caBundleConfigMap, caBundleSecret, caBundleKey and clientCertSecret
+// configure the default registry. Further named registries and the namespace mapping are read as yaml from the keys
+// registries and namespaceMapping. The key proxySecret names the secret with the proxy of all registries.
+func NewHelmRegistriesFromCluster(ctx context.Context, configMapClient configMapInterface) (*HelmRegistries, error) {
+	configMap, err := configMapClient.Get(ctx, HelmRepositoryConfigMapName, metav1.GetOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get helm repository configMap %s: %w", helmRepositoryConfigMapName, err)
+		return nil, fmt.Errorf("failed to get helm repository configMap %s: %w", HelmRepositoryConfigMapName, err)
+	}
+
+	registries := &HelmRegistries{ProxySecret: configMap.Data[configMapProxySecret]}
+	registriesYaml, hasRegistries := configMap.Data[configMapRegistries]
+	if configMap.Data["endpoint"] != "" || !hasRegistries {
+		defaultRegistry, err := newDefaultRegistryFromConfigMap(configMap.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		registries.Registries = append(registries.Registries, defaultRegistry)
 	}
 
+	if hasRegistries {
+		var namedRegistries []*HelmRepositoryData
+		err = yaml.Unmarshal([]byte(registriesYaml), &namedRegistries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse field %s from configMap %s: %w", configMapRegistries, HelmRepositoryConfigMapName, err)
+		}
+
+		registries.Registries = append(registries.Registries, namedRegistries...)
+	}
+
+	if mappingYaml, exists := configMap.Data[configMapNamespaceMapping]; exists {
+		err = yaml.Unmarshal([]byte(mappingYaml), &registries.NamespaceMapping)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse field %s from configMap %s: %w", configMapNamespaceMapping, HelmRepositoryConfigMapName, err)
+		}
+	}
+
+	err = registries.validate()
+	if err != nil {
+		return nil, fmt.Errorf("config map '%s' failed validation: %w", HelmRepositoryConfigMapName, err)
+	}
+
+	return registries, nil
+}
+
+func newDefaultRegistryFromConfigMap(data map[string]string) (*HelmRepositoryData, error) {
+	var err error
 	plainHttp := false
-	if plainHttpStr, exists := configMap.Data[configMapPlainHttp]; exists {
+	if plainHttpStr, exists := data[configMapPlainHttp]; exists {
 		plainHttp, err = strconv.ParseBool(plainHttpStr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse field %s from configMap %s", configMapPlainHttp, helmRepositoryConfigMapName)
+			return nil, fmt.Errorf("failed to parse field %s from configMap %s", configMapPlainHttp, HelmRepositoryConfigMapName)
 		}
 	}
 	insecureTls := false
-	if insecureTlsStr, exists := configMap.Data[configMapInsecureTls]; exists {
+	if insecureTlsStr, exists := data[configMapInsecureTls]; exists {
 		insecureTls, err = strconv.ParseBool(insecureTlsStr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse field %s from configMap %s", configMapInsecureTls, helmRepositoryConfigMapName)
+			return nil, fmt.Errorf("failed to parse field %s from configMap %s", configMapInsecureTls, HelmRepositoryConfigMapName)
 		}
 	}
 
-	schema := configMap.Data[configMapSchema]
+	schema := data[configMapSchema]
 	repoData := &HelmRepositoryData{
-		Endpoint:    configMap.Data["endpoint"],
-		Schema:      EndpointSchema(schema),
-		PlainHttp:   plainHttp,
-		InsecureTLS: insecureTls,
+		Name:              DefaultRegistryName,
+		Endpoint:          data["endpoint"],
+		Schema:            EndpointSchema(schema),
+		PlainHttp:         plainHttp,
+		InsecureTLS:       insecureTls,
+		CredentialsSecret: data[configMapCredentials],
+		ClientCertSecret:  data[configMapClientCertSecret],
+	}
+
+	if data[configMapCABundleConfig] != "" || data[configMapCABundleSecret] != "" {
+		repoData.CABundle = &CABundleRef{
+			ConfigMap: data[configMapCABundleConfig],
+			Secret:    data[configMapCABundleSecret],
+			Key:       data[configMapCABundleKey],
+		}
 	}
 
 	err = repoData.validate()
 	if err != nil {
-		return nil, fmt.Errorf("config map '%s' failed validation: %w", helmRepositoryConfigMapName, err)
+		return nil, fmt.Errorf("config map '%s' failed validation: %w", HelmRepositoryConfigMapName, err)
 	}
 
 	return repoData, nil
 }
 
-func NewHelmRepoDataFromFile(filepath string) (*HelmRepositoryData, error) {
+// helmRegistriesFile describes the local configuration file which configures the default registry on the top level
+// like the config map.
+type helmRegistriesFile struct {
+	HelmRepositoryData `json:",inline"`
+	Registries         []*HelmRepositoryData `json:"registries,omitempty"`
+	NamespaceMapping   map[string]string     `json:"namespaceMapping,omitempty"`
+	ProxySecret        string                `json:"proxySecret,omitempty"`
+}
+
+// NewHelmRegistriesFromFile reads the registries from the local configuration file, validates and returns them.
+func NewHelmRegistriesFromFile(filepath string) (*HelmRegistries, error) {
 	fileBytes, err := os.ReadFile(filepath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read configuration %s: %w", filepath, err)
 	}
 
-	repoData := &HelmRepositoryData{}
-	err = yaml.Unmarshal(fileBytes, repoData)
+	registriesFile := &helmRegistriesFile{}
+	err = yaml.Unmarshal(fileBytes, registriesFile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal configuration %s: %w", filepath, err)
 	}
 
-	err = repoData.validate()
+	registries := &HelmRegistries{NamespaceMapping: registriesFile.NamespaceMapping, ProxySecret: registriesFile.ProxySecret}
+	if registriesFile.Endpoint != "" || len(registriesFile.Registries) == 0 {
+		defaultRegistry := registriesFile.HelmRepositoryData
+		defaultRegistry.Name = DefaultRegistryName
+		registries.Registries = append(registries.Registries, &defaultRegistry)
+	}
+	registries.Registries = append(registries.Registries, registriesFile.Registries...)
+
+	err = registries.validate()
 	if err != nil {
 		return nil, fmt.Errorf("helm repository data from file '%s' failed validation: %w", filepath, err)
 	}
 
-	return repoData, nil
+	return registries, nil
 }
 
 func readNamespace() (string, error) {
@@ -243,6 +554,14 @@ func getEnvVar(name string) (string, error) {
 }
 
 func readMinuteDurationEnv(env string, defaultValue time.Duration) time.Duration {
+	return readDurationEnv(env, defaultValue, time.Minute)
+}
+
+func readSecondDurationEnv(env string, defaultValue time.Duration) time.Duration {
+	return readDurationEnv(env, defaultValue, time.Second)
+}
+
+func readDurationEnv(env string, defaultValue time.Duration, unit time.Duration) time.Duration {
 	valueString, err := getEnvVar(env)
 	if err != nil {
 		logrus.Warningf("failed to read %s environment variable, using default value", env)
@@ -260,7 +579,33 @@ func readMinuteDurationEnv(env string, defaultValue time.Duration) time.Duration
 		return defaultValue
 	}
 
-	return time.Duration(valueParsed) * time.Minute
+	return time.Duration(valueParsed) * unit
+}
+
+func readBoolEnv(env string, defaultValue bool) bool {
+	valueString, err := getEnvVar(env)
+	if err != nil {
+		logrus.Debugf("failed to read %s environment variable, using default value", env)
+		return defaultValue
+	}
+
+	valueParsed, err := strconv.ParseBool(valueString)
+	if err != nil {
+		logrus.Warningf("failed to parse %s environment variable, using default value", env)
+		return defaultValue
+	}
+
+	return valueParsed
+}
+
+func readStringEnv(env string, defaultValue string) string {
+	valueString, err := getEnvVar(env)
+	if err != nil {
+		logrus.Debugf("failed to read %s environment variable, using default value", env)
+		return defaultValue
+	}
+
+	return valueString
 }
 
 func readReconcilerRequeueTime() (time.Duration, error) {
@@ -42,23 +42,155 @@ func TestNewOperatorConfig(t *testing.T) {
 		require.NotNil(t, operatorConfig)
 		assert.Equal(t, expectedNamespace, operatorConfig.Namespace)
 		assert.Equal(t, "0.1.0", operatorConfig.Version.Original())
+		assert.False(t, operatorConfig.AllowDowngrades)
+		assert.Equal(t, 10*time.Minute, operatorConfig.MaxRequeueTime)
+		assert.Equal(t, 60*time.Minute, operatorConfig.UpdateCheckInterval)
+		assert.Empty(t, operatorConfig.AutoUpgradePolicy)
+		assert.Empty(t, operatorConfig.MaintenanceWindow)
+		assert.Equal(t, 5*time.Minute, operatorConfig.UpgradeVerificationTimeout)
+		assert.True(t, operatorConfig.LeaderElection)
+		assert.Equal(t, LeaseConfig{Duration: 15 * time.Second, RenewDeadline: 10 * time.Second, RetryPeriod: 2 * time.Second}, operatorConfig.LeaderElectionLease)
+		assert.Equal(t, 50*time.Second, operatorConfig.GracefulShutdownTimeout)
+		assert.False(t, operatorConfig.WebhookEnabled)
+		assert.Equal(t, "/tmp/k8s-webhook-server/serving-certs", operatorConfig.WebhookCertDir)
+		assert.Equal(t, "k8s", operatorConfig.DefaultComponentNamespace)
+		assert.False(t, operatorConfig.PinLatestVersion)
+		assert.Equal(t, 30*time.Minute, operatorConfig.DiscoveryInterval)
+		assert.False(t, operatorConfig.DiscoveryCreateComponents)
+	})
+	t.Run("Create config with webhook settings", func(t *testing.T) {
+		// given
+		t.Setenv("WEBHOOK_ENABLED", "true")
+		t.Setenv("WEBHOOK_CERT_DIR", "/certs")
+		t.Setenv("DEFAULT_COMPONENT_NAMESPACE", "k8s-testing")
+		t.Setenv("WEBHOOK_PIN_LATEST_VERSION", "true")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, operatorConfig.WebhookEnabled)
+		assert.Equal(t, "/certs", operatorConfig.WebhookCertDir)
+		assert.Equal(t, "k8s-testing", operatorConfig.DefaultComponentNamespace)
+		assert.True(t, operatorConfig.PinLatestVersion)
+	})
+	t.Run("Create config with leader election settings", func(t *testing.T) {
+		// given
+		t.Setenv("POD_NAME", "k8s-component-operator-abc")
+		t.Setenv("LEADER_ELECTION_ENABLED", "false")
+		t.Setenv("LEADER_ELECTION_LEASE_DURATION_SECS", "60")
+		t.Setenv("LEADER_ELECTION_RENEW_DEADLINE_SECS", "40")
+		t.Setenv("LEADER_ELECTION_RETRY_PERIOD_SECS", "5")
+		t.Setenv("GRACEFUL_SHUTDOWN_TIMEOUT_SECS", "120")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.Equal(t, "k8s-component-operator-abc", operatorConfig.PodName)
+		assert.False(t, operatorConfig.LeaderElection)
+		assert.Equal(t, LeaseConfig{Duration: 60 * time.Second, RenewDeadline: 40 * time.Second, RetryPeriod: 5 * time.Second}, operatorConfig.LeaderElectionLease)
+		assert.Equal(t, 120*time.Second, operatorConfig.GracefulShutdownTimeout)
+	})
+	t.Run("Create config with default lease for invalid lease", func(t *testing.T) {
+		// given
+		t.Setenv("LEADER_ELECTION_LEASE_DURATION_SECS", "10")
+		t.Setenv("LEADER_ELECTION_RENEW_DEADLINE_SECS", "20")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.Equal(t, LeaseConfig{Duration: 15 * time.Second, RenewDeadline: 10 * time.Second, RetryPeriod: 2 * time.Second}, operatorConfig.LeaderElectionLease)
+	})
+	t.Run("Create config with upgrade verification timeout", func(t *testing.T) {
+		// given
+		t.Setenv("UPGRADE_VERIFICATION_TIMEOUT_MINS", "20")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.Equal(t, 20*time.Minute, operatorConfig.UpgradeVerificationTimeout)
+	})
+	t.Run("Create config with update check and auto-upgrade settings", func(t *testing.T) {
+		// given
+		t.Setenv("UPDATE_CHECK_INTERVAL_MINS", "15")
+		t.Setenv("AUTO_UPGRADE_POLICY", "patch")
+		t.Setenv("MAINTENANCE_WINDOW", "Sat 02:00-04:00")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.Equal(t, 15*time.Minute, operatorConfig.UpdateCheckInterval)
+		assert.Equal(t, "patch", operatorConfig.AutoUpgradePolicy)
+		assert.Equal(t, "Sat 02:00-04:00", operatorConfig.MaintenanceWindow)
+	})
+	t.Run("Create config with discovery settings", func(t *testing.T) {
+		// given
+		t.Setenv("DISCOVERY_INTERVAL_MINS", "5")
+		t.Setenv("DISCOVERY_CREATE_COMPONENTS", "true")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.Equal(t, 5*time.Minute, operatorConfig.DiscoveryInterval)
+		assert.True(t, operatorConfig.DiscoveryCreateComponents)
+	})
+	t.Run("Create config with max requeue time", func(t *testing.T) {
+		// given
+		t.Setenv("MAX_REQUEUE_TIME_MINS", "30")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.Equal(t, 30*time.Minute, operatorConfig.MaxRequeueTime)
+	})
+	t.Run("Create config with downgrades allowed", func(t *testing.T) {
+		// given
+		t.Setenv("ALLOW_COMPONENT_DOWNGRADES", "true")
+
+		// when
+		operatorConfig, err := NewOperatorConfig("0.1.0")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, operatorConfig)
+		assert.True(t, operatorConfig.AllowDowngrades)
 	})
 }
 
-func TestGetHelmRepositoryData(t *testing.T) {
+func TestGetHelmRegistries(t *testing.T) {
 	t.Run("should return local developer", func(t *testing.T) {
 		// given
 		t.Setenv("RUNTIME", "local")
 		devHelmRepoDataPath = "testdata/helm-repository.yaml"
-		expected := &HelmRepositoryData{
+		expected := &HelmRegistries{Registries: []*HelmRepositoryData{{
+			Name:        "default",
 			Endpoint:    "192.168.56.3:30100",
 			Schema:      EndpointSchemaOCI,
 			PlainHttp:   true,
 			InsecureTLS: true,
-		}
+		}}}
 
 		// when
-		result, err := GetHelmRepositoryData(testCtx, nil)
+		result, err := GetHelmRegistries(testCtx, nil)
 
 		// then
 		require.NoError(t, err)
@@ -73,15 +205,16 @@ func TestGetHelmRepositoryData(t *testing.T) {
 			Data:       map[string]string{"endpoint": "endpoint", "schema": "oci", "plainHttp": "false", "insecureTls": "true"},
 		}
 		mockConfigMapInterface.On("Get", mock.Anything, "component-operator-helm-repository", mock.Anything).Return(configMap, nil)
-		expected := &HelmRepositoryData{
+		expected := &HelmRegistries{Registries: []*HelmRepositoryData{{
+			Name:        "default",
 			Endpoint:    "endpoint",
 			Schema:      EndpointSchemaOCI,
 			PlainHttp:   false,
 			InsecureTLS: true,
-		}
+		}}}
 
 		// when
-		result, err := GetHelmRepositoryData(testCtx, mockConfigMapInterface)
+		result, err := GetHelmRegistries(testCtx, mockConfigMapInterface)
 
 		// then
 		require.NoError(t, err)
@@ -89,7 +222,7 @@ func TestGetHelmRepositoryData(t *testing.T) {
 	})
 }
 
-func TestNewHelmRepoDataFromCluster(t *testing.T) {
+func TestNewHelmRegistriesFromCluster(t *testing.T) {
 	getOpts := metav1.GetOptions{}
 	t.Run("should fail on getting config map", func(t *testing.T) {
 		// given
@@ -97,7 +230,7 @@ func TestNewHelmRepoDataFromCluster(t *testing.T) {
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(nil, assert.AnError)
 
 		// when
-		_, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
 		require.Error(t, err)
@@ -111,7 +244,7 @@ func TestNewHelmRepoDataFromCluster(t *testing.T) {
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
 
 		// when
-		_, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
 		require.Error(t, err)
@@ -124,7 +257,7 @@ func TestNewHelmRepoDataFromCluster(t *testing.T) {
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
 
 		// when
-		_, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
 		require.Error(t, err)
@@ -137,7 +270,7 @@ func TestNewHelmRepoDataFromCluster(t *testing.T) {
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
 
 		// when
-		_, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
 		require.Error(t, err)
@@ -150,24 +283,38 @@ func TestNewHelmRepoDataFromCluster(t *testing.T) {
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
 
 		// when
-		_, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
 		require.Error(t, err)
-		assert.ErrorContains(t, err, "config map 'component-operator-helm-repository' failed validation: endpoint uses an unsupported schema '': valid schemas are: oci")
+		assert.ErrorContains(t, err, "config map 'component-operator-helm-repository' failed validation: endpoint uses an unsupported schema '': valid schemas are: oci, https")
 	})
 	t.Run("should fail because endpoint schema is unsupported", func(t *testing.T) {
 		// given
-		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "myEndpoint", "schema": "https"}}
+		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "myEndpoint", "schema": "ftp"}}
 		configMapClient := newMockConfigMapInterface(t)
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
 
 		// when
-		_, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
 		require.Error(t, err)
-		assert.ErrorContains(t, err, "config map 'component-operator-helm-repository' failed validation: endpoint uses an unsupported schema 'https': valid schemas are: oci")
+		assert.ErrorContains(t, err, "config map 'component-operator-helm-repository' failed validation: endpoint uses an unsupported schema 'ftp': valid schemas are: oci, https")
+	})
+	t.Run("should succeed to read https chart repository", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "charts.example.com", "schema": "https"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.NoError(t, err)
+		expected := &HelmRegistries{Registries: []*HelmRepositoryData{{Name: "default", Endpoint: "charts.example.com", Schema: EndpointSchemaHTTPS}}}
+		assert.Equal(t, expected, actual)
 	})
 	t.Run("should succeed to parse plainHttp and insecureTls and validate endpoint", func(t *testing.T) {
 		// given
@@ -176,25 +323,237 @@ func TestNewHelmRepoDataFromCluster(t *testing.T) {
 		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
 
 		// when
-		actual, err := NewHelmRepoDataFromCluster(testCtx, configMapClient)
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
 
 		// then
-		expected := &HelmRepositoryData{
+		expected := &HelmRegistries{Registries: []*HelmRepositoryData{{
+			Name:        "default",
 			Endpoint:    "myEndpoint",
 			Schema:      EndpointSchemaOCI,
 			PlainHttp:   true,
 			InsecureTLS: true,
-		}
+		}}}
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+	t.Run("should read credentials secret of default registry", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "myEndpoint", "schema": "oci", "credentialsSecret": "registry-credentials"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "registry-credentials", actual.Registries[0].CredentialsSecret)
+	})
+	t.Run("should read CA bundle and client certificate of default registry", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{
+			"endpoint":          "myEndpoint",
+			"schema":            "oci",
+			"caBundleConfigMap": "registry-ca",
+			"caBundleKey":       "bundle.pem",
+			"clientCertSecret":  "registry-client-cert",
+		}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &CABundleRef{ConfigMap: "registry-ca", Key: "bundle.pem"}, actual.Registries[0].CABundle)
+		assert.Equal(t, "registry-client-cert", actual.Registries[0].ClientCertSecret)
+	})
+	t.Run("should fail for CA bundle in config map and secret", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "myEndpoint", "schema": "oci", "caBundleConfigMap": "registry-ca", "caBundleSecret": "registry-ca"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "CA bundle must reference either a config map or a secret")
+	})
+	t.Run("should read proxy secret", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "myEndpoint", "schema": "oci", "proxySecret": "registry-proxy"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "registry-proxy", actual.ProxySecret)
+	})
+}
+
+func TestNewHelmRegistriesFromCluster_namedRegistries(t *testing.T) {
+	getOpts := metav1.GetOptions{}
+	registriesYaml := `- name: prod
+  endpoint: registry.example.com
+  schema: oci
+- name: internal
+  endpoint: charts.internal/helm
+  schema: https
+  insecureTls: true
+`
+
+	t.Run("should read named registries and namespace mapping", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"registries": registriesYaml, "namespaceMapping": "k8s: prod\nk8s-testing: internal\n"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
 		require.NoError(t, err)
+		expected := &HelmRegistries{
+			Registries: []*HelmRepositoryData{
+				{Name: "prod", Endpoint: "registry.example.com", Schema: EndpointSchemaOCI},
+				{Name: "internal", Endpoint: "charts.internal/helm", Schema: EndpointSchemaHTTPS, InsecureTLS: true},
+			},
+			NamespaceMapping: map[string]string{"k8s": "prod", "k8s-testing": "internal"},
+		}
 		assert.Equal(t, expected, actual)
 	})
+	t.Run("should put default registry first", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"endpoint": "myEndpoint", "schema": "oci", "registries": registriesYaml}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		actual, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, actual.Registries, 3)
+		assert.Equal(t, "default", actual.Registries[0].Name)
+		assert.Equal(t, "prod", actual.Registries[1].Name)
+		assert.Equal(t, "internal", actual.Registries[2].Name)
+	})
+	t.Run("should fail to parse registries", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"registries": "name: [invalid"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse field registries from configMap component-operator-helm-repository")
+	})
+	t.Run("should fail to parse namespace mapping", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"registries": registriesYaml, "namespaceMapping": "- k8s"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse field namespaceMapping from configMap component-operator-helm-repository")
+	})
+	t.Run("should fail on invalid named registry", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"registries": "- name: prod\n  endpoint: registry.example.com\n"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "component-operator-helm-repository", getOpts).Return(configMap, nil)
+
+		// when
+		_, err := NewHelmRegistriesFromCluster(testCtx, configMapClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "config map 'component-operator-helm-repository' failed validation: registry 'prod' is invalid: endpoint uses an unsupported schema ''")
+	})
+}
+
+func TestHelmRegistries_validate(t *testing.T) {
+	prod := &HelmRepositoryData{Name: "prod", Endpoint: "registry.example.com", Schema: EndpointSchemaOCI}
+
+	tests := []struct {
+		name       string
+		registries *HelmRegistries
+		wantErr    string
+	}{
+		{name: "should succeed", registries: &HelmRegistries{Registries: []*HelmRepositoryData{prod}, NamespaceMapping: map[string]string{"k8s": "prod"}}},
+		{name: "should fail without registries", registries: &HelmRegistries{}, wantErr: "at least one registry must be configured"},
+		{name: "should fail without name", registries: &HelmRegistries{Registries: []*HelmRepositoryData{{Endpoint: "registry.example.com", Schema: EndpointSchemaOCI}}}, wantErr: "registry with endpoint 'registry.example.com' must have a name"},
+		{name: "should fail on duplicate name", registries: &HelmRegistries{Registries: []*HelmRepositoryData{prod, prod}}, wantErr: "registry name 'prod' is not unique"},
+		{name: "should fail on unknown mapped registry", registries: &HelmRegistries{Registries: []*HelmRepositoryData{prod}, NamespaceMapping: map[string]string{"k8s-testing": "internal"}}, wantErr: "namespace 'k8s-testing' is mapped to the unknown registry 'internal'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.registries.validate()
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestHelmRegistries_Select(t *testing.T) {
+	prod := &HelmRepositoryData{Name: "prod"}
+	internal := &HelmRepositoryData{Name: "internal"}
+	mirror := &HelmRepositoryData{Name: "mirror"}
+	sut := &HelmRegistries{
+		Registries:       []*HelmRepositoryData{prod, internal, mirror},
+		NamespaceMapping: map[string]string{"k8s": "prod", "k8s-testing": "internal"},
+	}
+
+	tests := []struct {
+		name         string
+		registryName string
+		namespace    string
+		want         []*HelmRepositoryData
+		wantErr      string
+	}{
+		{name: "should start with registry mapped to namespace", namespace: "k8s-testing", want: []*HelmRepositoryData{internal, prod, mirror}},
+		{name: "should prefer registry selected by name", registryName: "mirror", namespace: "k8s-testing", want: []*HelmRepositoryData{mirror, prod, internal}},
+		{name: "should use configured order for unmapped namespace", namespace: "other", want: []*HelmRepositoryData{prod, internal, mirror}},
+		{name: "should fail for unknown registry", registryName: "unknown", wantErr: "helm registry \"unknown\" is not configured"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sut.Select(tt.registryName, tt.namespace)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
 }
 
-func TestNewHelmRepoDataFromFile(t *testing.T) {
+func TestNewHelmRegistriesFromFile(t *testing.T) {
 	tests := []struct {
 		name     string
 		filepath string
-		want     *HelmRepositoryData
+		want     *HelmRegistries
 		wantErr  assert.ErrorAssertionFunc
 	}{
 		{
@@ -217,11 +576,24 @@ func TestNewHelmRepoDataFromFile(t *testing.T) {
 		{
 			name:     "should succeed",
 			filepath: "testdata/helm-repository.yaml",
-			want: &HelmRepositoryData{
+			want: &HelmRegistries{Registries: []*HelmRepositoryData{{
+				Name:        "default",
 				Endpoint:    "192.168.56.3:30100",
 				Schema:      EndpointSchemaOCI,
 				PlainHttp:   true,
 				InsecureTLS: true,
+			}}},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "should succeed with named registries",
+			filepath: "testdata/helm-registries.yaml",
+			want: &HelmRegistries{
+				Registries: []*HelmRepositoryData{
+					{Name: "default", Endpoint: "registry.example.com", Schema: EndpointSchemaOCI},
+					{Name: "internal", Endpoint: "charts.internal/helm", Schema: EndpointSchemaHTTPS, PlainHttp: true, CredentialsSecret: "internal-credentials"},
+				},
+				NamespaceMapping: map[string]string{"k8s-testing": "internal"},
 			},
 			wantErr: assert.NoError,
 		},
@@ -236,21 +608,31 @@ func TestNewHelmRepoDataFromFile(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewHelmRepoDataFromFile(tt.filepath)
-			if !tt.wantErr(t, err, fmt.Sprintf("NewHelmRepoDataFromFile(%v)", tt.filepath)) {
+			got, err := NewHelmRegistriesFromFile(tt.filepath)
+			if !tt.wantErr(t, err, fmt.Sprintf("NewHelmRegistriesFromFile(%v)", tt.filepath)) {
 				return
 			}
-			assert.Equalf(t, tt.want, got, "NewHelmRepoDataFromFile(%v)", tt.filepath)
+			assert.Equalf(t, tt.want, got, "NewHelmRegistriesFromFile(%v)", tt.filepath)
 		})
 	}
 }
 
 func TestHelmRepositoryData_URL(t *testing.T) {
-	actual := &HelmRepositoryData{
-		Endpoint: "example.com",
-		Schema:   "oci",
+	tests := []struct {
+		name     string
+		repoData *HelmRepositoryData
+		want     string
+	}{
+		{name: "oci registry", repoData: &HelmRepositoryData{Endpoint: "example.com", Schema: "oci"}, want: "oci://example.com"},
+		{name: "oci registry with plain http", repoData: &HelmRepositoryData{Endpoint: "example.com", Schema: "oci", PlainHttp: true}, want: "oci://example.com"},
+		{name: "https chart repository", repoData: &HelmRepositoryData{Endpoint: "example.com/charts", Schema: "https"}, want: "https://example.com/charts"},
+		{name: "https chart repository with plain http", repoData: &HelmRepositoryData{Endpoint: "example.com/charts", Schema: "https", PlainHttp: true}, want: "http://example.com/charts"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.repoData.URL())
+		})
 	}
-	assert.Equal(t, "oci://example.com", actual.URL())
 }
 
 func Test_readMinuteDurationEnv(t *testing.T) {
@@ -329,3 +711,57 @@ func Test_readMinuteDurationEnv(t *testing.T) {
 		})
 	}
 }
+
+func Test_readBoolEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		setEnvVar    bool
+		envVarValue  string
+		defaultValue bool
+		want         bool
+	}{
+		{name: "Environment variable not set", setEnvVar: false, defaultValue: true, want: true},
+		{name: "Environment variable not set correctly", setEnvVar: true, envVarValue: "yes please", defaultValue: false, want: false},
+		{name: "Successfully read true", setEnvVar: true, envVarValue: "true", defaultValue: false, want: true},
+		{name: "Successfully read false", setEnvVar: true, envVarValue: "false", defaultValue: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnvVar {
+				t.Setenv("TEST_BOOL_ENV", tt.envVarValue)
+			}
+
+			assert.Equal(t, tt.want, readBoolEnv("TEST_BOOL_ENV", tt.defaultValue))
+		})
+	}
+}
+
+func TestLeaseConfig_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		lease   LeaseConfig
+		wantErr string
+	}{
+		{name: "valid lease", lease: LeaseConfig{Duration: 15 * time.Second, RenewDeadline: 10 * time.Second, RetryPeriod: 2 * time.Second}},
+		{name: "renew deadline too long", lease: LeaseConfig{Duration: 10 * time.Second, RenewDeadline: 10 * time.Second, RetryPeriod: 2 * time.Second}, wantErr: "renew deadline 10s must be shorter than the lease duration 10s"},
+		{name: "retry period too long", lease: LeaseConfig{Duration: 15 * time.Second, RenewDeadline: 10 * time.Second, RetryPeriod: 12 * time.Second}, wantErr: "retry period 12s must be shorter than the renew deadline 10s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.lease.validate()
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_readSecondDurationEnv(t *testing.T) {
+	t.Setenv("TEST_SECOND_ENV", "30")
+
+	assert.Equal(t, 30*time.Second, readSecondDurationEnv("TEST_SECOND_ENV", time.Second))
+	assert.Equal(t, time.Second, readSecondDurationEnv("TEST_UNSET_SECOND_ENV", time.Second))
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+type secretInterface interface {
+	corev1.SecretInterface
+}
+
+// dockerConfigJson describes the content of secrets of the type kubernetes.io/dockerconfigjson.
+type dockerConfigJson struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// Auth contains the base64 encoded "username:password".
+	Auth string `json:"auth,omitempty"`
+}
+
+// ReferencedSecrets returns the names of all secrets referenced by the registries for credentials, CA bundles and
+// client certificates as well as the name of the proxy secret.
+func (hr *HelmRegistries) ReferencedSecrets() []string {
+	var secretNames []string
+	for _, registry := range hr.Registries {
+		if registry.CredentialsSecret != "" {
+			secretNames = append(secretNames, registry.CredentialsSecret)
+		}
+		if registry.CABundle != nil && registry.CABundle.Secret != "" {
+			secretNames = append(secretNames, registry.CABundle.Secret)
+		}
+		if registry.ClientCertSecret != "" {
+			secretNames = append(secretNames, registry.ClientCertSecret)
+		}
+	}
+
+	return append(secretNames, hr.proxySecretName())
+}
+
+// ReferencedConfigMaps returns the names of all config maps referenced by the registries for CA bundles.
+func (hr *HelmRegistries) ReferencedConfigMaps() []string {
+	var configMapNames []string
+	for _, registry := range hr.Registries {
+		if registry.CABundle != nil && registry.CABundle.ConfigMap != "" {
+			configMapNames = append(configMapNames, registry.CABundle.ConfigMap)
+		}
+	}
+
+	return configMapNames
+}
+
+// ResolveCredentials reads the username and password of all registries referencing a credentials secret. Secrets of
+// the type kubernetes.io/dockerconfigjson must contain an entry for the host of the registry. All other secrets, e.g.
+// of the type kubernetes.io/basic-auth, must contain the keys username and password. Registries with invalid
+// credentials are left without credentials while the credentials of all other registries are still resolved.
+func (hr *HelmRegistries) ResolveCredentials(ctx context.Context, secretClient secretInterface) error {
+	var errs []error
+	for _, registry := range hr.Registries {
+		if registry.CredentialsSecret == "" {
+			continue
+		}
+
+		err := registry.resolveCredentials(ctx, secretClient)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (hrd *HelmRepositoryData) resolveCredentials(ctx context.Context, secretClient secretInterface) error {
+	secret, err := secretClient.Get(ctx, hrd.CredentialsSecret, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get credentials secret %s of registry %s: %w", hrd.CredentialsSecret, hrd.Name, err)
+	}
+
+	username, password, err := readCredentials(secret, hrd.host())
+	if err != nil {
+		return fmt.Errorf("credentials secret %s of registry %s is invalid: %w", hrd.CredentialsSecret, hrd.Name, err)
+	}
+
+	hrd.Username, hrd.Password = username, password
+	return nil
+}
+
+// host returns the host of the registry endpoint without the path.
+func (hrd *HelmRepositoryData) host() string {
+	host, _, _ := strings.Cut(hrd.Endpoint, "/")
+	return host
+}
+
+func readCredentials(secret *v1.Secret, host string) (username string, password string, err error) {
+	if secret.Type == v1.SecretTypeDockerConfigJson {
+		return readDockerConfigCredentials(secret.Data[v1.DockerConfigJsonKey], host)
+	}
+
+	username = string(secret.Data[v1.BasicAuthUsernameKey])
+	password = string(secret.Data[v1.BasicAuthPasswordKey])
+	if username == "" || password == "" {
+		return "", "", fmt.Errorf("keys %s and %s must not be empty", v1.BasicAuthUsernameKey, v1.BasicAuthPasswordKey)
+	}
+
+	return username, password, nil
+}
+
+func readDockerConfigCredentials(configJson []byte, host string) (username string, password string, err error) {
+	dockerConfig := &dockerConfigJson{}
+	err = json.Unmarshal(configJson, dockerConfig)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse %s: %w", v1.DockerConfigJsonKey, err)
+	}
+
+	for server, auth := range dockerConfig.Auths {
+		if dockerConfigServerHost(server) != host {
+			continue
+		}
+
+		if auth.Auth != "" {
+			return decodeDockerConfigAuth(auth.Auth)
+		}
+
+		if auth.Username == "" || auth.Password == "" {
+			return "", "", fmt.Errorf("credentials for host %s must contain auth or username and password", host)
+		}
+
+		return auth.Username, auth.Password, nil
+	}
+
+	return "", "", fmt.Errorf("no credentials found for host %s", host)
+}
+
+// dockerConfigServerHost returns the host of a server in a docker config which may also contain a schema and a path,
+// e.g. "https://index.docker.io/v1/".
+func dockerConfigServerHost(server string) string {
+	_, withoutSchema, found := strings.Cut(server, "://")
+	if !found {
+		withoutSchema = server
+	}
+
+	host, _, _ := strings.Cut(withoutSchema, "/")
+	return host
+}
+
+func decodeDockerConfigAuth(auth string) (username string, password string, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(auth)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode auth: %w", err)
+	}
+
+	username, password, found := strings.Cut(string(decoded), ":")
+	if !found || username == "" || password == "" {
+		return "", "", fmt.Errorf("auth must contain the username and password separated by a colon")
+	}
+
+	return username, password, nil
+}
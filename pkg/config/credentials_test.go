@@ -0,0 +1,158 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHelmRegistries_ReferencedSecrets(t *testing.T) {
+	// given
+	registries := &HelmRegistries{Registries: []*HelmRepositoryData{
+		{Name: "default", CredentialsSecret: "default-credentials"},
+		{Name: "public", CABundle: &CABundleRef{ConfigMap: "public-ca"}},
+		{Name: "internal", CredentialsSecret: "internal-credentials", CABundle: &CABundleRef{Secret: "internal-ca"}, ClientCertSecret: "internal-client-cert"},
+	}}
+
+	// when
+	secretNames := registries.ReferencedSecrets()
+	configMapNames := registries.ReferencedConfigMaps()
+
+	// then
+	assert.Equal(t, []string{"default-credentials", "internal-credentials", "internal-ca", "internal-client-cert", "ces-proxy"}, secretNames)
+	assert.Equal(t, []string{"public-ca"}, configMapNames)
+}
+
+func TestHelmRegistries_ResolveCredentials(t *testing.T) {
+	getOpts := metav1.GetOptions{}
+	tests := []struct {
+		name         string
+		endpoint     string
+		secret       *v1.Secret
+		wantUsername string
+		wantPassword string
+		wantErr      string
+	}{
+		{
+			name:         "should read basic auth secret",
+			endpoint:     "registry.example.com/k8s",
+			secret:       &v1.Secret{Type: v1.SecretTypeBasicAuth, Data: map[string][]byte{"username": []byte("user"), "password": []byte("secret")}},
+			wantUsername: "user",
+			wantPassword: "secret",
+		},
+		{
+			name:         "should read auth of docker config for host of registry",
+			endpoint:     "registry.example.com/k8s",
+			secret:       newDockerConfigSecret(`{"auths":{"other.example.com":{"auth":"b3RoZXI6b3RoZXI="},"https://registry.example.com/v1/":{"auth":"dXNlcjpzZWNyZXQ="}}}`),
+			wantUsername: "user",
+			wantPassword: "secret",
+		},
+		{
+			name:         "should read username and password of docker config",
+			endpoint:     "registry.example.com",
+			secret:       newDockerConfigSecret(`{"auths":{"registry.example.com":{"username":"user","password":"secret"}}}`),
+			wantUsername: "user",
+			wantPassword: "secret",
+		},
+		{
+			name:     "should fail for basic auth secret without password",
+			endpoint: "registry.example.com",
+			secret:   &v1.Secret{Data: map[string][]byte{"username": []byte("user")}},
+			wantErr:  "credentials secret registry-credentials of registry default is invalid: keys username and password must not be empty",
+		},
+		{
+			name:     "should fail for docker config without host of registry",
+			endpoint: "registry.example.com",
+			secret:   newDockerConfigSecret(`{"auths":{"other.example.com":{"auth":"b3RoZXI6b3RoZXI="}}}`),
+			wantErr:  "no credentials found for host registry.example.com",
+		},
+		{
+			name:     "should fail for invalid docker config",
+			endpoint: "registry.example.com",
+			secret:   newDockerConfigSecret(`{"auths":`),
+			wantErr:  "failed to parse .dockerconfigjson",
+		},
+		{
+			name:     "should fail for auth without password",
+			endpoint: "registry.example.com",
+			secret:   newDockerConfigSecret(`{"auths":{"registry.example.com":{"auth":"dXNlcg=="}}}`),
+			wantErr:  "auth must contain the username and password separated by a colon",
+		},
+		{
+			name:     "should fail for docker config without credentials",
+			endpoint: "registry.example.com",
+			secret:   newDockerConfigSecret(`{"auths":{"registry.example.com":{"username":"user"}}}`),
+			wantErr:  "credentials for host registry.example.com must contain auth or username and password",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			registry := &HelmRepositoryData{Name: "default", Endpoint: tt.endpoint, Schema: EndpointSchemaOCI, CredentialsSecret: "registry-credentials"}
+			registries := &HelmRegistries{Registries: []*HelmRepositoryData{registry, {Name: "public", Endpoint: "public.example.com"}}}
+
+			secretClient := newMockSecretInterface(t)
+			secretClient.EXPECT().Get(testCtx, "registry-credentials", getOpts).Return(tt.secret, nil)
+
+			// when
+			err := registries.ResolveCredentials(testCtx, secretClient)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUsername, registry.Username)
+			assert.Equal(t, tt.wantPassword, registry.Password)
+		})
+	}
+
+	t.Run("should fail to get secret", func(t *testing.T) {
+		// given
+		registries := &HelmRegistries{Registries: []*HelmRepositoryData{{Name: "default", CredentialsSecret: "registry-credentials"}}}
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-credentials", getOpts).Return(nil, assert.AnError)
+
+		// when
+		err := registries.ResolveCredentials(testCtx, secretClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get credentials secret registry-credentials of registry default")
+	})
+
+	t.Run("should resolve credentials of further registries if secret is invalid", func(t *testing.T) {
+		// given
+		internalRegistry := &HelmRepositoryData{Name: "internal", Endpoint: "charts.internal", CredentialsSecret: "internal-credentials"}
+		registries := &HelmRegistries{Registries: []*HelmRepositoryData{
+			{Name: "default", Endpoint: "registry.example.com", CredentialsSecret: "registry-credentials"},
+			internalRegistry,
+		}}
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-credentials", getOpts).Return(&v1.Secret{}, nil)
+		secretClient.EXPECT().Get(testCtx, "internal-credentials", getOpts).Return(&v1.Secret{Data: map[string][]byte{"username": []byte("user"), "password": []byte("secret")}}, nil)
+
+		// when
+		err := registries.ResolveCredentials(testCtx, secretClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "credentials secret registry-credentials of registry default is invalid")
+		assert.Empty(t, registries.Registries[0].Username)
+		assert.Equal(t, "user", internalRegistry.Username)
+		assert.Equal(t, "secret", internalRegistry.Password)
+	})
+}
+
+func newDockerConfigSecret(configJson string) *v1.Secret {
+	return &v1.Secret{
+		Type: v1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{v1.DockerConfigJsonKey: []byte(configJson)},
+	}
+}
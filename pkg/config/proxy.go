@@ -0,0 +1,131 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultProxySecretName is the name of the secret the proxy is read from unless the helm repository config map
+// names another secret with the key proxySecret. The secret is created by the setup.
+const DefaultProxySecretName = "ces-proxy"
+
+// deprecatedProxyURLEnvVar contains the URL of the proxy if the proxy secret does not exist. It is only read for
+// compatibility with older deployments.
+const deprecatedProxyURLEnvVar = "PROXY_URL"
+
+const (
+	proxySecretURL      = "url"
+	proxySecretNoProxy  = "noProxy"
+	proxySecretUsername = "username"
+	proxySecretPassword = "password"
+)
+
+// ProxyConfig contains the proxy all requests to registries are sent through.
+type ProxyConfig struct {
+	// URL contains the URL of the proxy including its credentials.
+	URL *url.URL
+	// NoProxy contains the comma-separated hosts, domains and CIDRs which are accessed without the proxy in the format
+	// of the env var NO_PROXY.
+	NoProxy string
+}
+
+// ProxyFunc returns a function selecting the proxy for requests like http.Transport.Proxy. Requests to hosts matching
+// NoProxy are sent directly. It returns nil if the proxy config is nil.
+func (p *ProxyConfig) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	if p == nil {
+		return nil
+	}
+
+	proxyURL := p.URL.String()
+	proxyFunc := (&httpproxy.Config{HTTPProxy: proxyURL, HTTPSProxy: proxyURL, NoProxy: p.NoProxy}).ProxyFunc()
+	return func(request *http.Request) (*url.URL, error) {
+		return proxyFunc(request.URL)
+	}
+}
+
+func (hr *HelmRegistries) proxySecretName() string {
+	if hr.ProxySecret != "" {
+		return hr.ProxySecret
+	}
+
+	return DefaultProxySecretName
+}
+
+// ResolveProxy reads the proxy of all registries from the proxy secret. The secret contains the URL of the proxy in
+// the key url and optionally the keys noProxy, username and password. If the secret does not exist, the deprecated
+// env var PROXY_URL is used instead. No proxy is used if neither contains a URL.
+func (hr *HelmRegistries) ResolveProxy(ctx context.Context, secretClient secretInterface) error {
+	secretName := hr.proxySecretName()
+	secret, err := secretClient.Get(ctx, secretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return hr.resolveDeprecatedProxy(secretName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get proxy secret %s: %w", secretName, err)
+	}
+
+	proxy, err := readProxyConfig(secret)
+	if err != nil {
+		return fmt.Errorf("proxy secret %s is invalid: %w", secretName, err)
+	}
+
+	hr.Proxy = proxy
+	return nil
+}
+
+func (hr *HelmRegistries) resolveDeprecatedProxy(secretName string) error {
+	rawURL := os.Getenv(deprecatedProxyURLEnvVar)
+	if rawURL == "" {
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("WARNING: The env var %s is deprecated and will be removed in a future release. Configure the proxy in the secret %s instead.",
+		deprecatedProxyURLEnvVar, secretName))
+
+	proxyURL, err := parseProxyURL(rawURL)
+	if err != nil {
+		return fmt.Errorf("env var %s is invalid: %w", deprecatedProxyURLEnvVar, err)
+	}
+
+	hr.Proxy = &ProxyConfig{URL: proxyURL}
+	return nil
+}
+
+func readProxyConfig(secret *v1.Secret) (*ProxyConfig, error) {
+	rawURL := string(secret.Data[proxySecretURL])
+	if rawURL == "" {
+		return nil, nil
+	}
+
+	proxyURL, err := parseProxyURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// credentials of the secret take precedence over credentials contained in the url
+	if username := string(secret.Data[proxySecretUsername]); username != "" {
+		proxyURL.User = url.UserPassword(username, string(secret.Data[proxySecretPassword]))
+	}
+
+	return &ProxyConfig{URL: proxyURL, NoProxy: string(secret.Data[proxySecretNoProxy])}, nil
+}
+
+func parseProxyURL(rawURL string) (*url.URL, error) {
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse proxy url: %w", err)
+	}
+	if proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("proxy url %q must contain a scheme and a host", proxyURL.Redacted())
+	}
+
+	return proxyURL, nil
+}
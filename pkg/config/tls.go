@@ -0,0 +1,108 @@
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultCABundleKey is the key of the CA bundle in the referenced config map or secret if no key is given.
+const defaultCABundleKey = "ca.crt"
+
+// CABundleRef references a key of a config map or secret in the namespace of the operator which contains PEM encoded
+// CA certificates.
+type CABundleRef struct {
+	// ConfigMap contains the name of the config map with the CA bundle.
+	ConfigMap string `json:"configMap,omitempty" yaml:"configMap,omitempty"`
+	// Secret contains the name of the secret with the CA bundle. Either ConfigMap or Secret must be set.
+	Secret string `json:"secret,omitempty" yaml:"secret,omitempty"`
+	// Key contains the key of the CA bundle. It defaults to ca.crt.
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
+}
+
+func (ref *CABundleRef) validate() error {
+	if (ref.ConfigMap == "") == (ref.Secret == "") {
+		return fmt.Errorf("CA bundle must reference either a config map or a secret")
+	}
+
+	return nil
+}
+
+func (ref *CABundleRef) key() string {
+	if ref.Key == "" {
+		return defaultCABundleKey
+	}
+
+	return ref.Key
+}
+
+// ResolveTLS reads the CA bundles and client certificates of all registries referencing them. Client certificates are
+// read from secrets of the type kubernetes.io/tls. Registries with invalid TLS settings are left without them while
+// the TLS settings of all other registries are still resolved.
+func (hr *HelmRegistries) ResolveTLS(ctx context.Context, configMapClient configMapInterface, secretClient secretInterface) error {
+	var errs []error
+	for _, registry := range hr.Registries {
+		if registry.CABundle != nil {
+			err := registry.resolveCABundle(ctx, configMapClient, secretClient)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if registry.ClientCertSecret != "" {
+			err := registry.resolveClientCert(ctx, secretClient)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (hrd *HelmRepositoryData) resolveCABundle(ctx context.Context, configMapClient configMapInterface, secretClient secretInterface) error {
+	key := hrd.CABundle.key()
+
+	var caBundle []byte
+	if hrd.CABundle.ConfigMap != "" {
+		configMap, err := configMapClient.Get(ctx, hrd.CABundle.ConfigMap, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get CA bundle config map %s of registry %s: %w", hrd.CABundle.ConfigMap, hrd.Name, err)
+		}
+		caBundle = []byte(configMap.Data[key])
+	} else {
+		secret, err := secretClient.Get(ctx, hrd.CABundle.Secret, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get CA bundle secret %s of registry %s: %w", hrd.CABundle.Secret, hrd.Name, err)
+		}
+		caBundle = secret.Data[key]
+	}
+
+	if !x509.NewCertPool().AppendCertsFromPEM(caBundle) {
+		return fmt.Errorf("CA bundle of registry %s contains no PEM encoded certificates in key %s", hrd.Name, key)
+	}
+
+	hrd.CAData = caBundle
+	return nil
+}
+
+func (hrd *HelmRepositoryData) resolveClientCert(ctx context.Context, secretClient secretInterface) error {
+	secret, err := secretClient.Get(ctx, hrd.ClientCertSecret, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get client certificate secret %s of registry %s: %w", hrd.ClientCertSecret, hrd.Name, err)
+	}
+
+	certData, keyData := secret.Data[v1.TLSCertKey], secret.Data[v1.TLSPrivateKeyKey]
+	_, err = tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return fmt.Errorf("client certificate secret %s of registry %s is invalid: %w", hrd.ClientCertSecret, hrd.Name, err)
+	}
+
+	hrd.CertData, hrd.KeyData = certData, keyData
+	return nil
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func readTestFile(t *testing.T, name string) []byte {
+	data, err := os.ReadFile("testdata/tls/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func TestCABundleRef_validate(t *testing.T) {
+	assert.NoError(t, (&CABundleRef{ConfigMap: "registry-ca"}).validate())
+	assert.NoError(t, (&CABundleRef{Secret: "registry-ca"}).validate())
+	assert.ErrorContains(t, (&CABundleRef{}).validate(), "CA bundle must reference either a config map or a secret")
+	assert.ErrorContains(t, (&CABundleRef{ConfigMap: "registry-ca", Secret: "registry-ca"}).validate(), "CA bundle must reference either a config map or a secret")
+}
+
+func TestHelmRegistries_ResolveTLS(t *testing.T) {
+	getOpts := metav1.GetOptions{}
+	caBundle := readTestFile(t, "ca.crt")
+	clientCert := readTestFile(t, "tls.crt")
+	clientKey := readTestFile(t, "tls.key")
+
+	t.Run("should read CA bundle from config map with default key", func(t *testing.T) {
+		// given
+		registry := &HelmRepositoryData{Name: "internal", CABundle: &CABundleRef{ConfigMap: "registry-ca"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "registry-ca", getOpts).Return(&v1.ConfigMap{Data: map[string]string{"ca.crt": string(caBundle)}}, nil)
+
+		// when
+		err := (&HelmRegistries{Registries: []*HelmRepositoryData{registry}}).ResolveTLS(testCtx, configMapClient, newMockSecretInterface(t))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, caBundle, registry.CAData)
+	})
+
+	t.Run("should read CA bundle and client certificate from secrets", func(t *testing.T) {
+		// given
+		registry := &HelmRepositoryData{Name: "internal", CABundle: &CABundleRef{Secret: "registry-ca", Key: "bundle.pem"}, ClientCertSecret: "registry-client-cert"}
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-ca", getOpts).Return(&v1.Secret{Data: map[string][]byte{"bundle.pem": caBundle}}, nil)
+		secretClient.EXPECT().Get(testCtx, "registry-client-cert", getOpts).Return(&v1.Secret{
+			Type: v1.SecretTypeTLS,
+			Data: map[string][]byte{"tls.crt": clientCert, "tls.key": clientKey},
+		}, nil)
+
+		// when
+		err := (&HelmRegistries{Registries: []*HelmRepositoryData{registry}}).ResolveTLS(testCtx, newMockConfigMapInterface(t), secretClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, caBundle, registry.CAData)
+		assert.Equal(t, clientCert, registry.CertData)
+		assert.Equal(t, clientKey, registry.KeyData)
+	})
+
+	t.Run("should fail for CA bundle without certificates", func(t *testing.T) {
+		// given
+		registry := &HelmRepositoryData{Name: "internal", CABundle: &CABundleRef{ConfigMap: "registry-ca"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "registry-ca", getOpts).Return(&v1.ConfigMap{Data: map[string]string{"other": string(caBundle)}}, nil)
+
+		// when
+		err := (&HelmRegistries{Registries: []*HelmRepositoryData{registry}}).ResolveTLS(testCtx, configMapClient, newMockSecretInterface(t))
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "CA bundle of registry internal contains no PEM encoded certificates in key ca.crt")
+		assert.Nil(t, registry.CAData)
+	})
+
+	t.Run("should fail to get CA bundle config map", func(t *testing.T) {
+		// given
+		registry := &HelmRepositoryData{Name: "internal", CABundle: &CABundleRef{ConfigMap: "registry-ca"}}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, "registry-ca", getOpts).Return(nil, assert.AnError)
+
+		// when
+		err := (&HelmRegistries{Registries: []*HelmRepositoryData{registry}}).ResolveTLS(testCtx, configMapClient, newMockSecretInterface(t))
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get CA bundle config map registry-ca of registry internal")
+	})
+
+	t.Run("should fail for client certificate with wrong key", func(t *testing.T) {
+		// given
+		registry := &HelmRepositoryData{Name: "internal", ClientCertSecret: "registry-client-cert"}
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-client-cert", getOpts).Return(&v1.Secret{
+			Data: map[string][]byte{"tls.crt": clientCert, "tls.key": readTestFile(t, "ca.crt")},
+		}, nil)
+
+		// when
+		err := (&HelmRegistries{Registries: []*HelmRepositoryData{registry}}).ResolveTLS(testCtx, newMockConfigMapInterface(t), secretClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "client certificate secret registry-client-cert of registry internal is invalid")
+		assert.Nil(t, registry.CertData)
+	})
+
+	t.Run("should fail to get client certificate secret", func(t *testing.T) {
+		// given
+		registry := &HelmRepositoryData{Name: "internal", ClientCertSecret: "registry-client-cert"}
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-client-cert", getOpts).Return(nil, assert.AnError)
+
+		// when
+		err := (&HelmRegistries{Registries: []*HelmRepositoryData{registry}}).ResolveTLS(testCtx, newMockConfigMapInterface(t), secretClient)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get client certificate secret registry-client-cert of registry internal")
+	})
+}
@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+)
+
+// reconcileTriggerAnnotations are the annotations whose changes trigger a reconciliation although the generation of the
+// component does not change, e.g. so that a blocked downgrade or deletion is retried as soon as it is allowed.
+var reconcileTriggerAnnotations = []string{
+	annotations.EmergencyOperationAnnotation,
+	annotations.DryRunAnnotation,
+	annotations.AdoptAnnotation,
+	annotations.MigrateNamespaceAnnotation,
+	annotations.ForceDeleteAnnotation,
+	annotations.CascadeDeleteAnnotation,
+	annotations.AllowDowngradeAnnotation,
+	annotations.IgnoreDependentConstraintsAnnotation,
+}
+
+// annotationChangedPredicate lets pass updates which change one of the given annotations, e.g. so that held operations
+// start immediately instead of waiting for the maintenance window.
+func annotationChangedPredicate(annotations ...string) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+
+			oldAnnotations := e.ObjectOld.GetAnnotations()
+			newAnnotations := e.ObjectNew.GetAnnotations()
+			return slices.ContainsFunc(annotations, func(annotation string) bool {
+				return oldAnnotations[annotation] != newAnnotations[annotation]
+			})
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+)
+
+func Test_annotationChangedPredicate(t *testing.T) {
+	sut := annotationChangedPredicate(annotations.EmergencyOperationAnnotation, annotations.DryRunAnnotation)
+	withAnnotations := func(annotations map[string]string) *k8sv1.Component {
+		return &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+
+	t.Run("should pass update which sets the emergency annotation", func(t *testing.T) {
+		assert.True(t, sut.Update(event.UpdateEvent{ObjectOld: withAnnotations(nil), ObjectNew: withAnnotations(map[string]string{annotations.EmergencyOperationAnnotation: "true"})}))
+	})
+	t.Run("should pass update which removes the dry-run annotation", func(t *testing.T) {
+		assert.True(t, sut.Update(event.UpdateEvent{ObjectOld: withAnnotations(map[string]string{annotations.DryRunAnnotation: "true"}), ObjectNew: withAnnotations(nil)}))
+	})
+	t.Run("should filter update of other annotations", func(t *testing.T) {
+		assert.False(t, sut.Update(event.UpdateEvent{ObjectOld: withAnnotations(nil), ObjectNew: withAnnotations(map[string]string{annotations.MaintenanceWindowAnnotation: "Sat,Sun 00:00-24:00"})}))
+	})
+}
+
+func Test_reconcileTriggerAnnotations(t *testing.T) {
+	sut := annotationChangedPredicate(reconcileTriggerAnnotations...)
+	withAnnotation := func(annotation string) *k8sv1.Component {
+		return &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotation: "true"}}}
+	}
+
+	for _, annotation := range []string{
+		annotations.ForceDeleteAnnotation,
+		annotations.CascadeDeleteAnnotation,
+		annotations.AllowDowngradeAnnotation,
+		annotations.IgnoreDependentConstraintsAnnotation,
+	} {
+		t.Run("should pass update which sets "+annotation, func(t *testing.T) {
+			assert.True(t, sut.Update(event.UpdateEvent{ObjectOld: &k8sv1.Component{}, ObjectNew: withAnnotation(annotation)}))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"helm.sh/helm/v3/pkg/chart"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/labels"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+// adoptIfRequested adopts the deployed helm release of a component with the AdoptAnnotation. It returns false if
+// adoption is not requested and the component must be installed regularly. The component is never installed while the
+// annotation is set, so the adoption fails if there is no deployed release.
+func (cim *ComponentInstallManager) adoptIfRequested(ctx context.Context, component *k8sv1.Component) (adopted bool, err error) {
+	if !annotations.IsTrue(component, annotations.AdoptAnnotation) {
+		return false, nil
+	}
+
+	release, err := cim.helmClient.GetRelease(component.Spec.Name)
+	switch {
+	case errors.Is(err, driver.ErrReleaseNotFound):
+		err = fmt.Errorf("no release %q found to adopt; remove the annotation %s to install the component", component.Spec.Name, annotations.AdoptAnnotation)
+	case err != nil:
+		return false, &genericRequeueableError{"failed to get release to adopt for component " + component.Spec.Name, err}
+	case release.Info.Status.IsPending():
+		return false, &genericRequeueableError{"failed to adopt component " + component.Spec.Name,
+			fmt.Errorf("release %q has the pending status %q", release.Name, release.Info.Status)}
+	case release.Info.Status != helmRelease.StatusDeployed:
+		err = fmt.Errorf("release %q has the status %q and cannot be adopted; remove the annotation %s to reinstall the component", release.Name, release.Info.Status, annotations.AdoptAnnotation)
+	default:
+		return true, cim.adopt(ctx, component, release)
+	}
+
+	cim.recorder.Eventf(component, corev1.EventTypeWarning, InstallEventReason, "Adoption of helm release failed: %s", err.Error())
+	return false, err
+}
+
+// adopt takes ownership of the deployed release without reinstalling it. The release is only upgraded with its current
+// version and values if its resources lack the component labels.
+func (cim *ComponentInstallManager) adopt(ctx context.Context, component *k8sv1.Component, release *helmRelease.Release) error {
+	logger := log.FromContext(ctx)
+
+	releaseVersion, err := cim.verifyAdoptableRelease(ctx, component, release)
+	if err != nil {
+		cim.recorder.Eventf(component, corev1.EventTypeWarning, InstallEventReason, "Adoption of helm release failed: %s", err.Error())
+		return err
+	}
+
+	// pin the version of the release so that the adoption does not lead to an upgrade to the latest version
+	if component.Spec.Version == "" {
+		component, err = cim.componentClient.UpdateExpectedComponentVersion(ctx, component.Spec.Name, releaseVersion)
+		if err != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to update expected version for component %q", component.Spec.Name), err}
+		}
+	}
+
+	if !slices.Contains(component.Finalizers, k8sv1.FinalizerName) {
+		component, err = cim.componentClient.AddFinalizer(ctx, component, k8sv1.FinalizerName)
+		if err != nil {
+			return &genericRequeueableError{"failed to add finalizer " + k8sv1.FinalizerName, err}
+		}
+	}
+
+	// create a new context that does not get canceled immediately on SIGTERM
+	helmCtx := context.WithoutCancel(ctx)
+
+	err = cim.applyComponentLabels(helmCtx, component, release, releaseVersion)
+	if err != nil {
+		return err
+	}
+
+	component, err = cim.componentClient.UpdateStatusInstalled(helmCtx, component)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to update status-installed for component %q", component.Spec.Name), err}
+	}
+
+	err = cim.healthManager.UpdateComponentHealthWithInstalledVersion(ctx, component.Spec.Name, component.Namespace, releaseVersion)
+	if err != nil {
+		return fmt.Errorf("failed to update health status and installed version for component %q: %w", component.Spec.Name, err)
+	}
+
+	message := fmt.Sprintf("Adopted helm release %q in namespace %q with version %s (revision %d)", release.Name, release.Namespace, releaseVersion, release.Version)
+	err = cim.recordAdoption(ctx, component, message)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to record adoption of component %q", component.Spec.Name), err}
+	}
+
+	cim.recorder.Event(component, corev1.EventTypeNormal, InstallEventReason, message)
+	logger.Info(message)
+
+	return nil
+}
+
+// verifyAdoptableRelease checks that the release was deployed from the chart of the component in its registry namespace
+// to the deploy namespace of the component. It returns the chart version of the release.
+func (cim *ComponentInstallManager) verifyAdoptableRelease(ctx context.Context, component *k8sv1.Component, release *helmRelease.Release) (string, error) {
+	if release.Chart == nil || release.Chart.Metadata == nil {
+		return "", fmt.Errorf("release %q does not contain chart metadata", release.Name)
+	}
+
+	releaseChart := release.Chart.Metadata
+	if releaseChart.Name != component.Spec.Name {
+		return "", fmt.Errorf("chart %q of release %q does not match component %q", releaseChart.Name, release.Name, component.Spec.Name)
+	}
+
+	deployNamespace := getDeployNamespace(component)
+	if release.Namespace != deployNamespace {
+		return "", fmt.Errorf("release %q is deployed in namespace %q instead of the deploy namespace %q of the component", release.Name, release.Namespace, deployNamespace)
+	}
+
+	chartName := helm.GetHelmChartName(component)
+	registryChart, err := cim.helmClient.GetChart(ctx, &client.ChartSpec{ChartName: chartName, Version: releaseChart.Version})
+	if err != nil {
+		return "", &genericRequeueableError{fmt.Sprintf("failed to get chart %s:%s to verify release %q", chartName, releaseChart.Version, release.Name), err}
+	}
+
+	if !hasSameTemplates(release.Chart, registryChart) {
+		return "", fmt.Errorf("release %q was not deployed from chart %s:%s: the templates differ", release.Name, chartName, releaseChart.Version)
+	}
+
+	return releaseChart.Version, nil
+}
+
+// applyComponentLabels upgrades the release with its current version and values if its resources lack the component
+// labels which are required for the health checks.
+func (cim *ComponentInstallManager) applyComponentLabels(ctx context.Context, component *k8sv1.Component, release *helmRelease.Release, releaseVersion string) error {
+	componentLabels := map[string]string{
+		k8sv1.ComponentNameLabelKey:    component.Spec.Name,
+		k8sv1.ComponentVersionLabelKey: releaseVersion,
+	}
+
+	labeled, err := labels.HasLabels(release.Manifest, componentLabels)
+	if err != nil {
+		return fmt.Errorf("failed to check component labels of release %q: %w", release.Name, err)
+	}
+
+	if labeled {
+		return nil
+	}
+
+	log.FromContext(ctx).Info(fmt.Sprintf("Release %q lacks component labels, upgrading it with version %s", release.Name, releaseVersion))
+	chartSpec, err := helm.GetHelmChartSpec(ctx, withVersion(component, releaseVersion))
+	if err != nil {
+		return fmt.Errorf("failed to get helm chart spec: %w", err)
+	}
+	chartSpec.Timeout = cim.timeout
+	// keep the values of the release, the values of the component are applied by a regular upgrade afterward
+	chartSpec.ValuesYamlOverwrite = ""
+	chartSpec.ReuseValues = true
+
+	err = cim.helmClient.InstallOrUpgrade(ctx, chartSpec)
+	if err != nil {
+		return &genericRequeueableError{"failed to apply component labels to release " + release.Name, err}
+	}
+
+	return nil
+}
+
+// recordAdoption sets the adopted condition and removes the AdoptAnnotation. The changed annotation triggers a new
+// reconciliation which applies a differing version or values of the component with a regular upgrade.
+func (cim *ComponentInstallManager) recordAdoption(ctx context.Context, component *k8sv1.Component, message string) error {
+	return retry.OnConflict(func() error {
+		updatedComponent, err := cim.componentClient.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if conditions.Set(updatedComponent, newCondition(conditions.TypeAdopted, metav1.ConditionTrue, conditions.ReasonReleaseAdopted, message)) {
+			updatedComponent, err = cim.componentClient.UpdateStatus(ctx, updatedComponent, metav1.UpdateOptions{})
+			if err != nil {
+				return err
+			}
+		}
+
+		delete(updatedComponent.Annotations, annotations.AdoptAnnotation)
+
+		_, err = cim.componentClient.Update(ctx, updatedComponent, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func hasSameTemplates(a *chart.Chart, b *chart.Chart) bool {
+	if len(a.Templates) != len(b.Templates) {
+		return false
+	}
+
+	templates := make(map[string][]byte, len(a.Templates))
+	for _, template := range a.Templates {
+		templates[template.Name] = template.Data
+	}
+
+	for _, template := range b.Templates {
+		data, ok := templates[template.Name]
+		if !ok || !bytes.Equal(data, template.Data) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,370 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+)
+
+const labeledManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: dogu-op-config
+  labels:
+    k8s.cloudogu.com/component.name: dogu-op
+    k8s.cloudogu.com/component.version: 0.1.0
+`
+
+const unlabeledManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: dogu-op-config
+`
+
+func getAdoptableComponent() *k8sv1.Component {
+	component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.1.0")
+	component.Annotations = map[string]string{annotations.AdoptAnnotation: "true"}
+	component.Finalizers = []string{k8sv1.FinalizerName}
+	return component
+}
+
+func getAdoptableRelease(manifest string) *release.Release {
+	return &release.Release{
+		Name:      "dogu-op",
+		Namespace: "ecosystem",
+		Version:   3,
+		Info:      &release.Info{Status: release.StatusDeployed},
+		Chart:     getAdoptableChart("deployment"),
+		Manifest:  manifest,
+	}
+}
+
+func getAdoptableChart(template string) *chart.Chart {
+	return &chart.Chart{
+		Metadata:  &chart.Metadata{Name: "dogu-op", Version: "0.1.0"},
+		Templates: []*chart.File{{Name: "templates/deployment.yaml", Data: []byte(template)}},
+	}
+}
+
+func TestComponentInstallManager_adoptIfRequested(t *testing.T) {
+	t.Run("should not adopt component without annotation", func(t *testing.T) {
+		// given
+		sut := ComponentInstallManager{}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, getComponent("ecosystem", "k8s", "", "dogu-op", "0.1.0"))
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, adopted)
+	})
+
+	t.Run("should fail instead of installing if no release exists", func(t *testing.T) {
+		// given
+		component := getAdoptableComponent()
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, driver.ErrReleaseNotFound)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeWarning, InstallEventReason, "Adoption of helm release failed: %s", mock.Anything)
+		sut := ComponentInstallManager{helmClient: helmClientMock, recorder: recorderMock}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, adopted)
+		assert.ErrorContains(t, err, `no release "dogu-op" found to adopt; remove the annotation k8s.cloudogu.com/adopt to install the component`)
+		var requeueableErr *genericRequeueableError
+		assert.False(t, errors.As(err, &requeueableErr))
+	})
+
+	t.Run("should fail instead of installing if release is not deployed", func(t *testing.T) {
+		// given
+		component := getAdoptableComponent()
+		failedRelease := getAdoptableRelease(labeledManifest)
+		failedRelease.Info.Status = release.StatusFailed
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(failedRelease, nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeWarning, InstallEventReason, "Adoption of helm release failed: %s", mock.Anything)
+		sut := ComponentInstallManager{helmClient: helmClientMock, recorder: recorderMock}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, adopted)
+		assert.ErrorContains(t, err, `release "dogu-op" has the status "failed" and cannot be adopted`)
+	})
+
+	t.Run("should requeue if release is pending", func(t *testing.T) {
+		// given
+		pendingRelease := getAdoptableRelease(labeledManifest)
+		pendingRelease.Info.Status = release.StatusPendingUpgrade
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(pendingRelease, nil)
+		sut := ComponentInstallManager{helmClient: helmClientMock}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, getAdoptableComponent())
+
+		// then
+		require.Error(t, err)
+		assert.False(t, adopted)
+		var requeueableErr *genericRequeueableError
+		assert.ErrorAs(t, err, &requeueableErr)
+		assert.ErrorContains(t, err, `release "dogu-op" has the pending status "pending-upgrade"`)
+	})
+
+	t.Run("should fail to get release", func(t *testing.T) {
+		// given
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, assert.AnError)
+		sut := ComponentInstallManager{helmClient: helmClientMock}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, getAdoptableComponent())
+
+		// then
+		require.Error(t, err)
+		assert.False(t, adopted)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get release to adopt for component dogu-op")
+	})
+
+	t.Run("should adopt labeled release without upgrade", func(t *testing.T) {
+		// given
+		component := getAdoptableComponent()
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getAdoptableRelease(labeledManifest), nil)
+		helmClientMock.EXPECT().GetChart(testCtx, &client.ChartSpec{ChartName: "k8s/dogu-op", Version: "0.1.0"}).Return(getAdoptableChart("deployment"), nil)
+
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().UpdateStatusInstalled(ctxWithoutCancel, component).Return(component, nil)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, updated *k8sv1.Component, _ metav1.UpdateOptions) (*k8sv1.Component, error) {
+				adoptedCondition := conditions.Find(updated, conditions.TypeAdopted)
+				require.NotNil(t, adoptedCondition)
+				assert.Equal(t, metav1.ConditionTrue, adoptedCondition.Status)
+				assert.Equal(t, conditions.ReasonReleaseAdopted, adoptedCondition.Reason)
+				assert.Equal(t, `Adopted helm release "dogu-op" in namespace "ecosystem" with version 0.1.0 (revision 3)`, adoptedCondition.Message)
+				return updated, nil
+			})
+		componentClientMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, updated *k8sv1.Component, _ metav1.UpdateOptions) (*k8sv1.Component, error) {
+				assert.NotContains(t, updated.Annotations, annotations.AdoptAnnotation)
+				return updated, nil
+			})
+
+		healthManagerMock := newMockHealthManager(t)
+		healthManagerMock.EXPECT().UpdateComponentHealthWithInstalledVersion(testCtx, "dogu-op", "ecosystem", "0.1.0").Return(nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Event(component, corev1.EventTypeNormal, InstallEventReason, `Adopted helm release "dogu-op" in namespace "ecosystem" with version 0.1.0 (revision 3)`)
+
+		sut := ComponentInstallManager{
+			componentClient: componentClientMock,
+			helmClient:      helmClientMock,
+			healthManager:   healthManagerMock,
+			recorder:        recorderMock,
+			timeout:         defaultHelmClientTimeoutMins,
+		}
+
+		// when
+		err := sut.Install(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should adopt unlabeled release with upgrade keeping version and values", func(t *testing.T) {
+		// given
+		component := getAdoptableComponent()
+		component.Spec.Version = ""
+		component.Finalizers = nil
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getAdoptableRelease(unlabeledManifest), nil)
+		helmClientMock.EXPECT().GetChart(testCtx, mock.Anything).Return(getAdoptableChart("deployment"), nil)
+		helmClientMock.EXPECT().InstallOrUpgrade(ctxWithoutCancel, mock.Anything).RunAndReturn(func(_ context.Context, spec *client.ChartSpec) error {
+			assert.Equal(t, "k8s/dogu-op", spec.ChartName)
+			assert.Equal(t, "0.1.0", spec.Version)
+			assert.Equal(t, "ecosystem", spec.Namespace)
+			assert.True(t, spec.ReuseValues)
+			assert.Empty(t, spec.ValuesYamlOverwrite)
+			assert.Equal(t, defaultHelmClientTimeoutMins, spec.Timeout)
+			return nil
+		})
+
+		pinnedComponent := getAdoptableComponent()
+		pinnedComponent.Finalizers = nil
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().UpdateExpectedComponentVersion(testCtx, "dogu-op", "0.1.0").Return(pinnedComponent, nil)
+		componentClientMock.EXPECT().AddFinalizer(testCtx, pinnedComponent, k8sv1.FinalizerName).Return(pinnedComponent, nil)
+		componentClientMock.EXPECT().UpdateStatusInstalled(ctxWithoutCancel, pinnedComponent).Return(pinnedComponent, nil)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(pinnedComponent.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).Return(pinnedComponent, nil)
+		componentClientMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).Return(pinnedComponent, nil)
+
+		healthManagerMock := newMockHealthManager(t)
+		healthManagerMock.EXPECT().UpdateComponentHealthWithInstalledVersion(testCtx, "dogu-op", "ecosystem", "0.1.0").Return(nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Event(pinnedComponent, corev1.EventTypeNormal, InstallEventReason, mock.Anything)
+
+		sut := ComponentInstallManager{
+			componentClient: componentClientMock,
+			helmClient:      helmClientMock,
+			healthManager:   healthManagerMock,
+			recorder:        recorderMock,
+			timeout:         defaultHelmClientTimeoutMins,
+		}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, adopted)
+	})
+
+	t.Run("should fail to apply component labels", func(t *testing.T) {
+		// given
+		component := getAdoptableComponent()
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getAdoptableRelease(unlabeledManifest), nil)
+		helmClientMock.EXPECT().GetChart(testCtx, mock.Anything).Return(getAdoptableChart("deployment"), nil)
+		helmClientMock.EXPECT().InstallOrUpgrade(ctxWithoutCancel, mock.Anything).Return(assert.AnError)
+
+		sut := ComponentInstallManager{helmClient: helmClientMock, timeout: defaultHelmClientTimeoutMins}
+
+		// when
+		adopted, err := sut.adoptIfRequested(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, adopted)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to apply component labels to release dogu-op")
+	})
+}
+
+func TestComponentInstallManager_verifyAdoptableRelease(t *testing.T) {
+	t.Run("should return version of release", func(t *testing.T) {
+		// given
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetChart(testCtx, &client.ChartSpec{ChartName: "k8s/dogu-op", Version: "0.1.0"}).Return(getAdoptableChart("deployment"), nil)
+		sut := ComponentInstallManager{helmClient: helmClientMock}
+
+		// when
+		version, err := sut.verifyAdoptableRelease(testCtx, getAdoptableComponent(), getAdoptableRelease(labeledManifest))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "0.1.0", version)
+	})
+
+	t.Run("should reject release without chart", func(t *testing.T) {
+		// given
+		chartlessRelease := getAdoptableRelease(labeledManifest)
+		chartlessRelease.Chart = nil
+		sut := ComponentInstallManager{}
+
+		// when
+		_, err := sut.verifyAdoptableRelease(testCtx, getAdoptableComponent(), chartlessRelease)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `release "dogu-op" does not contain chart metadata`)
+	})
+
+	t.Run("should reject release of other chart", func(t *testing.T) {
+		// given
+		otherRelease := getAdoptableRelease(labeledManifest)
+		otherRelease.Chart.Metadata.Name = "other"
+		sut := ComponentInstallManager{}
+
+		// when
+		_, err := sut.verifyAdoptableRelease(testCtx, getAdoptableComponent(), otherRelease)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `chart "other" of release "dogu-op" does not match component "dogu-op"`)
+	})
+
+	t.Run("should reject release in other namespace", func(t *testing.T) {
+		// given
+		component := getAdoptableComponent()
+		component.Spec.DeployNamespace = "longhorn-system"
+		sut := ComponentInstallManager{}
+
+		// when
+		_, err := sut.verifyAdoptableRelease(testCtx, component, getAdoptableRelease(labeledManifest))
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `release "dogu-op" is deployed in namespace "ecosystem" instead of the deploy namespace "longhorn-system" of the component`)
+	})
+
+	t.Run("should fail to get chart from registry", func(t *testing.T) {
+		// given
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetChart(testCtx, mock.Anything).Return(nil, assert.AnError)
+		sut := ComponentInstallManager{helmClient: helmClientMock}
+
+		// when
+		_, err := sut.verifyAdoptableRelease(testCtx, getAdoptableComponent(), getAdoptableRelease(labeledManifest))
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, `failed to get chart k8s/dogu-op:0.1.0 to verify release "dogu-op"`)
+	})
+
+	t.Run("should reject release of chart from other registry namespace", func(t *testing.T) {
+		// given
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetChart(testCtx, mock.Anything).Return(getAdoptableChart("modified deployment"), nil)
+		sut := ComponentInstallManager{helmClient: helmClientMock}
+
+		// when
+		_, err := sut.verifyAdoptableRelease(testCtx, getAdoptableComponent(), getAdoptableRelease(labeledManifest))
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `release "dogu-op" was not deployed from chart k8s/dogu-op:0.1.0: the templates differ`)
+	})
+}
+
+func Test_hasSameTemplates(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *chart.Chart
+		b    *chart.Chart
+		want bool
+	}{
+		{name: "should match equal templates", a: getAdoptableChart("deployment"), b: getAdoptableChart("deployment"), want: true},
+		{name: "should not match changed template", a: getAdoptableChart("deployment"), b: getAdoptableChart("service"), want: false},
+		{name: "should not match additional template", a: getAdoptableChart("deployment"), b: &chart.Chart{Templates: append(getAdoptableChart("deployment").Templates, &chart.File{Name: "templates/service.yaml"})}, want: false},
+		{name: "should not match renamed template", a: getAdoptableChart("deployment"), b: &chart.Chart{Templates: []*chart.File{{Name: "templates/other.yaml", Data: []byte("deployment")}}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasSameTemplates(tt.a, tt.b))
+		})
+	}
+}
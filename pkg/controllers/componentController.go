@@ -7,15 +7,22 @@ import (
 	"time"
 
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
+	"github.com/cloudogu/k8s-component-operator/pkg/metrics"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -34,6 +41,8 @@ const (
 	UpgradeEventReason = "Upgrade"
 	// DowngradeEventReason The name of the downgrade event
 	DowngradeEventReason = "Downgrade"
+	// MigrationEventReason The name of the deploy namespace migration event
+	MigrationEventReason = "Migration"
 	// RequeueEventReason The name of the requeue event
 	RequeueEventReason = "Requeue"
 	// FailedNameValidationEventReason The name of the event to validate spec.name and metadata.name of a component.
@@ -42,8 +51,12 @@ const (
 	Install = operation("Install")
 	// Upgrade represents the upgrade-operation
 	Upgrade = operation("Upgrade")
-	// Downgrade represents the downgrade-operation. Currently not supported.
+	// Downgrade represents the downgrade-operation. Downgrades are only performed if they are allowed operator-wide or by
+	// the AllowDowngradeAnnotation.
 	Downgrade = operation("Downgrade")
+	// Migrate represents the migration of an installed component to another deploy namespace. Migrations are only
+	// performed for components with the MigrateNamespaceAnnotation.
+	Migrate = operation("Migrate")
 	// Delete represents the delete-operation
 	Delete = operation("Delete")
 	// Ignore represents the ignore-operation
@@ -61,6 +74,8 @@ type ComponentManager interface {
 	installManager
 	deleteManager
 	upgradeManager
+	downgradeManager
+	migrateManager
 }
 
 // ComponentReconciler watches every Component object in the cluster and handles them accordingly.
@@ -76,33 +91,49 @@ type ComponentReconciler struct {
 	yamlSerializer            yaml.Serializer
 	reader                    configMapRefReader
 	configMapInterface        configMapInterface
+	allowDowngrades           bool
+	dependencyWaitIndex       *dependencyWaitIndex
+	maintenanceWindow         maintenance.Window
+	conditionWriter           conditionWriter
+	journal                   operationJournal
+	now                       func() time.Time
 }
 
-func NewComponentReconciler(clientSet componentEcosystemInterface, newHelmClient newHelmClientFunc, recorder record.EventRecorder, namespace string, timeout time.Duration, yamlSerializer yaml.Serializer, reader configMapRefReader, requeueTime time.Duration) *ComponentReconciler {
-	componentRequeueHandler := NewComponentRequeueHandler(clientSet, recorder, namespace, requeueTime)
+func NewComponentReconciler(clientSet componentEcosystemInterface, newHelmClient newHelmClientFunc, recorder record.EventRecorder, namespace string, timeout time.Duration, yamlSerializer yaml.Serializer, reader configMapRefReader, requeueTime time.Duration, maxRequeueTime time.Duration, allowDowngrades bool, maintenanceWindow maintenance.Window, upgradeVerificationTimeout time.Duration) *ComponentReconciler {
+	componentRequeueHandler := NewComponentRequeueHandler(clientSet, recorder, namespace, requeueTime, maxRequeueTime)
+	operationJournal := journal.New(clientSet.CoreV1().ConfigMaps(namespace))
 
 	return &ComponentReconciler{
 		clientSet: clientSet,
 		recorder:  recorder,
 		componentManagerFactory: &defaultComponentManagerFactory{
-			namespace: namespace,
-			clientSet: clientSet,
-			recorder:  recorder,
-			timeout:   timeout,
+			namespace:                  namespace,
+			clientSet:                  clientSet,
+			recorder:                   recorder,
+			timeout:                    timeout,
+			upgradeVerificationTimeout: upgradeVerificationTimeout,
+			journal:                    operationJournal,
 		},
 		helmClientFactory: newHelmClient,
 		operationEvaluatorFactory: &defaultOperationEvaluatorFactory{
-			recorder:       recorder,
-			timeout:        timeout,
-			yamlSerializer: yamlSerializer,
-			reader:         reader,
+			componentClient: clientSet.ComponentV1Alpha1().Components(namespace),
+			recorder:        recorder,
+			timeout:         timeout,
+			yamlSerializer:  yamlSerializer,
+			reader:          reader,
 		},
-		requeueHandler:     componentRequeueHandler,
-		namespace:          namespace,
-		yamlSerializer:     yamlSerializer,
-		reader:             reader,
-		timeout:            timeout,
-		configMapInterface: clientSet.CoreV1().ConfigMaps(namespace),
+		requeueHandler:      componentRequeueHandler,
+		namespace:           namespace,
+		yamlSerializer:      yamlSerializer,
+		reader:              reader,
+		timeout:             timeout,
+		configMapInterface:  clientSet.CoreV1().ConfigMaps(namespace),
+		allowDowngrades:     allowDowngrades,
+		dependencyWaitIndex: newDependencyWaitIndex(),
+		maintenanceWindow:   maintenanceWindow,
+		conditionWriter:     conditions.NewWriter(clientSet.ComponentV1Alpha1().Components(namespace)),
+		journal:             operationJournal,
+		now:                 time.Now,
 	}
 }
 
@@ -115,6 +146,9 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	component, err := r.clientSet.ComponentV1Alpha1().Components(req.Namespace).Get(ctx, req.Name, v1.GetOptions{})
 	if err != nil {
 		logger.Info(fmt.Sprintf("failed to get component %+v: %s", req, err))
+		if k8serrors.IsNotFound(err) {
+			r.dependencyWaitIndex.remove(req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	logger.Info(fmt.Sprintf("Component %+v has been found", req))
@@ -129,13 +163,38 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, fmt.Errorf("failed to create helm client: %w", err)
 	}
 
+	err = r.recoverInterruptedOperation(ctx, component, hc)
+	if err != nil {
+		return requeueWithError(err)
+	}
+
 	operationEvaluator := r.operationEvaluatorFactory.NewOperationEvaluator(hc)
 	operation, err := operationEvaluator.EvaluateRequiredOperation(ctx, component)
 	if err != nil {
+		if helm.IsValuesError(err) {
+			r.updateConditions(ctx, component, invalidValuesConditions(err))
+		}
 		return requeueWithError(fmt.Errorf("failed to evaluate required operation: %w", err))
 	}
 	logger.Info(fmt.Sprintf("Required operation is %s", operation))
 
+	if isDryRun(component, operation) {
+		return r.performDryRun(ctx, component, hc, operation)
+	}
+
+	scheduledStart, err := r.getScheduledStart(component, operation)
+	if err != nil {
+		return requeueWithError(fmt.Errorf("failed to get maintenance window: %w", err))
+	}
+	if scheduledStart != nil {
+		return r.scheduleOperation(ctx, component, operation, *scheduledStart)
+	}
+
+	err = r.clearSchedule(ctx, component, operation)
+	if err != nil {
+		return requeueWithError(err)
+	}
+
 	componentManager := r.componentManagerFactory.NewComponentManager(hc)
 
 	switch operation {
@@ -146,7 +205,9 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	case Upgrade:
 		return r.performUpgradeOperation(ctx, component, componentManager)
 	case Downgrade:
-		return r.performDowngradeOperation(component)
+		return r.performDowngradeOperation(ctx, component, componentManager)
+	case Migrate:
+		return r.performOperation(ctx, component, MigrationEventReason, k8sv1.ComponentStatusTryToUpgrade, componentManager.Migrate)
 	case Ignore:
 		return ctrl.Result{}, nil
 	default:
@@ -175,9 +236,20 @@ func (r *ComponentReconciler) performDeleteOperation(ctx context.Context, compon
 	return r.performOperation(ctx, component, DeinstallationEventReason, k8sv1.ComponentStatusTryToDelete, componentManager.Delete)
 }
 
-func (r *ComponentReconciler) performDowngradeOperation(component *k8sv1.Component) (ctrl.Result, error) {
-	r.recorder.Event(component, corev1.EventTypeWarning, DowngradeEventReason, "component downgrades are not allowed")
-	return ctrl.Result{}, fmt.Errorf("downgrades are not allowed")
+func (r *ComponentReconciler) performDowngradeOperation(ctx context.Context, component *k8sv1.Component, componentManager ComponentManager) (ctrl.Result, error) {
+	if !r.isDowngradeAllowed(component) {
+		r.recorder.Event(component, corev1.EventTypeWarning, DowngradeEventReason, "component downgrades are not allowed")
+		err := fmt.Errorf("downgrades are not allowed")
+		r.updateConditions(ctx, component, operationFinishedConditions(DowngradeEventReason, err))
+		return ctrl.Result{}, err
+	}
+
+	return r.performOperation(ctx, component, DowngradeEventReason, k8sv1.ComponentStatusTryToUpgrade, componentManager.Downgrade)
+}
+
+// isDowngradeAllowed checks if downgrades are enabled for all components or for the given component by annotation.
+func (r *ComponentReconciler) isDowngradeAllowed(component *k8sv1.Component) bool {
+	return r.allowDowngrades || annotations.IsTrue(component, annotations.AllowDowngradeAnnotation)
 }
 
 // performOperation executes the given operationFn and requeues if necessary.
@@ -191,7 +263,17 @@ func (r *ComponentReconciler) performOperation(
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	r.updateConditions(ctx, component, operationStartedConditions(eventReason))
+	start := time.Now()
 	operationError := operationFn(ctx, component)
+	if isUpgradeVerificationPending(operationError) {
+		return r.awaitUpgradeVerification(ctx, component, operationError)
+	}
+
+	observeOperation(component, eventReason, start, operationError)
+	r.updateDependencyWaitIndex(ctx, component, operationError)
+	r.updateConditions(ctx, component, operationFinishedConditions(eventReason, operationError))
+
 	contextMessageOnError := fmt.Sprintf("%s failed with component %s", eventReason, component.Name)
 	eventType := corev1.EventTypeNormal
 	message := fmt.Sprintf("%s successful", eventReason)
@@ -215,6 +297,40 @@ func (r *ComponentReconciler) performOperation(
 	return requeueOrFinishOperation(result)
 }
 
+// awaitUpgradeVerification requeues a component whose upgrade waits for the component to become available. The
+// status stays upgrading, so that the verification is continued by the next reconciliation.
+func (r *ComponentReconciler) awaitUpgradeVerification(ctx context.Context, component *k8sv1.Component, pendingErr error) (ctrl.Result, error) {
+	log.FromContext(ctx).Info(pendingErr.Error())
+	r.updateConditions(ctx, component, []v1.Condition{
+		newCondition(conditions.TypeProgressing, v1.ConditionTrue, conditions.ReasonVerifying, pendingErr.Error()),
+	})
+
+	return requeueOrFinishOperation(ctrl.Result{RequeueAfter: upgradeVerificationInterval})
+}
+
+// observeOperation records the metrics of the operation with the given event reason.
+func observeOperation(component *k8sv1.Component, eventReason string, start time.Time, operationError error) {
+	metrics.ObserveOperation(component.Spec.Name, strings.ToLower(eventReason), start, operationError)
+	if helm.IsDependencyUnsatisfiedError(operationError) {
+		metrics.IncDependencyCheckFailures(component.Spec.Name)
+	}
+}
+
+// updateDependencyWaitIndex registers the component as waiting for its unsatisfied dependencies so that it can be
+// reconciled as soon as one of them is installed. The component is removed from the index after a successful operation
+// or if the operation failed for another reason.
+func (r *ComponentReconciler) updateDependencyWaitIndex(ctx context.Context, component *k8sv1.Component, operationError error) {
+	componentKey := types.NamespacedName{Namespace: component.Namespace, Name: component.Name}
+	unsatisfiedDependencies := helm.GetUnsatisfiedDependencies(operationError)
+	if len(unsatisfiedDependencies) == 0 {
+		r.dependencyWaitIndex.remove(componentKey)
+		return
+	}
+
+	log.FromContext(ctx).Info("Component waits for dependencies", "component", component.Name, "dependencies", unsatisfiedDependencies)
+	r.dependencyWaitIndex.setWaiting(componentKey, unsatisfiedDependencies)
+}
+
 // requeueWithError is a syntax sugar function to express that every non-nil error will result in a requeue
 // operation.
 //
@@ -252,10 +368,11 @@ func (r *ComponentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, annotationChangedPredicate(reconcileTriggerAnnotations...))).
 		WithOptions(options).
 		For(&k8sv1.Component{}).
 		WatchesRawSource(r.getConfigMapKind(mgr)).
+		WatchesRawSource(r.getInstalledDependencyKind(mgr)).
 		Complete(r)
 }
 
@@ -287,3 +404,46 @@ func (r *ComponentReconciler) getConfigMapKind(mgr ctrl.Manager) source.TypedSyn
 		}),
 	)
 }
+
+// getDependentComponentRequests returns requests for all components waiting for the given installed component.
+func (r *ComponentReconciler) getDependentComponentRequests(ctx context.Context, component *k8sv1.Component) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, dependent := range r.dependencyWaitIndex.popWaiting(component.Spec.Name) {
+		log.FromContext(ctx).Info("Dependency has been installed, enqueue waiting component", "dependency", component.Spec.Name, "component", dependent.Name)
+		requests = append(requests, reconcile.Request{NamespacedName: dependent})
+	}
+
+	return requests
+}
+
+// getInstalledDependencyKind watches components for a change to the status installed. Other components waiting for
+// them as a dependency are enqueued immediately instead of waiting for their requeue time.
+func (r *ComponentReconciler) getInstalledDependencyKind(mgr ctrl.Manager) source.TypedSyncingSource[reconcile.Request] {
+	return source.TypedKind(
+		mgr.GetCache(),
+		&k8sv1.Component{},
+		handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, component *k8sv1.Component) []reconcile.Request {
+			return r.getDependentComponentRequests(ctx, component)
+		}),
+		installedStatusPredicate(),
+	)
+}
+
+// installedStatusPredicate only lets pass updates where the component status changed to installed.
+func installedStatusPredicate() predicate.TypedPredicate[*k8sv1.Component] {
+	return predicate.TypedFuncs[*k8sv1.Component]{
+		CreateFunc: func(event.TypedCreateEvent[*k8sv1.Component]) bool {
+			return false
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[*k8sv1.Component]) bool {
+			return e.ObjectOld.Status.Status != k8sv1.ComponentStatusInstalled &&
+				e.ObjectNew.Status.Status == k8sv1.ComponentStatusInstalled
+		},
+		DeleteFunc: func(event.TypedDeleteEvent[*k8sv1.Component]) bool {
+			return false
+		},
+		GenericFunc: func(event.TypedGenericEvent[*k8sv1.Component]) bool {
+			return false
+		},
+	}
+}
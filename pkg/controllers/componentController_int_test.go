@@ -51,6 +51,7 @@ var _ = Describe("Dogu Upgrade Tests", func() {
 			*helmClientMock = mockHelmClient{}
 			helmClientMock.EXPECT().SatisfiesDependencies(mock.Anything, mock.Anything).Return(nil)
 			helmClientMock.EXPECT().InstallOrUpgrade(mock.Anything, mock.Anything).Return(nil)
+			helmClientMock.EXPECT().PullChart(mock.Anything, mock.Anything).Return("sha256:abc", nil)
 			rel := &release.Release{
 				Info: &release.Info{Status: release.StatusUnknown},
 			}
@@ -87,6 +88,7 @@ var _ = Describe("Dogu Upgrade Tests", func() {
 			helmClientMock.EXPECT().SatisfiesDependencies(mock.Anything, mock.Anything).Return(nil)
 			helmClientMock.EXPECT().ListDeployedReleases().Return([]*release.Release{{Name: installComponent.Spec.Name, Namespace: installComponent.Namespace, Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "0.1.0"}}}}, nil)
 			helmClientMock.EXPECT().InstallOrUpgrade(mock.Anything, mock.Anything).Return(nil)
+			helmClientMock.EXPECT().PullChart(mock.Anything, mock.Anything).Return("sha256:abc", nil)
 			rel := &release.Release{
 				Info: &release.Info{Status: release.StatusUnknown},
 			}
@@ -137,6 +139,7 @@ var _ = Describe("Dogu Upgrade Tests", func() {
 				Name: "k8s-dogu-operator",
 			}
 			helmClientMock.EXPECT().ListReleasesByStateMask(mock.Anything).Return([]*release.Release{rel}, nil)
+			helmClientMock.EXPECT().GetRelease(mock.Anything).Return(rel, nil)
 			*recorderMock = mockEventRecorder{}
 			recorderMock.EXPECT().Event(mock.Anything, "Normal", "Deinstallation", "Starting deinstallation...")
 			recorderMock.EXPECT().Event(mock.Anything, "Normal", "Deinstallation", "Deinstallation successful")
@@ -5,7 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
@@ -15,22 +18,25 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const testNamespace = "testtestNamespace"
 const testRequeueTime = time.Second
+const testMaxRequeueTime = time.Minute
 
 func TestNewComponentReconciler(t *testing.T) {
 	// given
 	coreV1Mock := newMockCoreV1Interface(t)
 	coreV1Mock.EXPECT().ConfigMaps(testNamespace).Return(newMockConfigMapInterface(t))
-	clientSetMock := newMockComponentEcosystemInterface(t)
+	clientSetMock := newComponentClientSetMock(t, newMockComponentInterface(t))
 	clientSetMock.EXPECT().CoreV1().Return(coreV1Mock)
 
 	configMapRefReaderMock := newMockConfigMapRefReader(t)
@@ -39,7 +45,7 @@ func TestNewComponentReconciler(t *testing.T) {
 	mockRecorder := newMockEventRecorder(t)
 
 	// when
-	manager := NewComponentReconciler(clientSetMock, newHelmClientFunc, mockRecorder, testNamespace, defaultHelmClientTimeoutMins, yaml.NewSerializer(), configMapRefReaderMock, testRequeueTime)
+	manager := NewComponentReconciler(clientSetMock, newHelmClientFunc, mockRecorder, testNamespace, defaultHelmClientTimeoutMins, yaml.NewSerializer(), configMapRefReaderMock, testRequeueTime, testMaxRequeueTime, false, maintenance.Window{}, time.Minute)
 
 	// then
 	require.NotNil(t, manager)
@@ -104,11 +110,14 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			recorder:                  mockRecorder,
 			componentManagerFactory:   componentManagerFactory,
 			operationEvaluatorFactory: mockOperationEvaluatorFactory,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			requeueHandler:            mockRequeueHandler,
 		}
 		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
@@ -153,10 +162,13 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			recorder:                  mockRecorder,
 			componentManagerFactory:   componentManagerFactory,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			requeueHandler:            mockRequeueHandler,
 			operationEvaluatorFactory: mockOperationEvaluatorFactory,
 		}
@@ -203,10 +215,13 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			recorder:                  mockRecorder,
 			componentManagerFactory:   componentManagerFactory,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			requeueHandler:            mockRequeueHandler,
 			operationEvaluatorFactory: mockOperationEvaluatorFactory,
 		}
@@ -219,6 +234,70 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, reconcile.Result{}, result)
 	})
+	t.Run("should recover upgrade interrupted by lost leader lease of another replica before resuming it", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, helmNamespace, "", "dogu-op", "0.2.0")
+		component.Status.Status = "upgrading"
+
+		componentInterfaceMock := newMockComponentInterface(t)
+		componentInterfaceMock.EXPECT().Get(testCtx, "dogu-op", v1.GetOptions{}).Return(component, nil)
+		componentClientGetterMock := newMockComponentV1Alpha1Interface(t)
+		componentClientGetterMock.EXPECT().Components(testNamespace).Return(componentInterfaceMock)
+		clientSetMock := newMockComponentEcosystemInterface(t)
+		clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
+
+		// the previous leader exited while its helm upgrade was pending
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Get(testCtx, "dogu-op").Return(getInterruptedUpgrade(), nil)
+		journalMock.EXPECT().Complete(testCtx, "dogu-op").Return(nil)
+
+		helmClient := newMockHelmClient(t)
+		helmClient.EXPECT().GetRelease("dogu-op").Return(getRevision(4, release.StatusPendingUpgrade), nil).Once()
+		helmClient.EXPECT().MarkReleaseAsFailed("dogu-op", mock.Anything).Return(nil)
+		helmClient.EXPECT().GetRelease("dogu-op").Return(getRevision(4, release.StatusFailed), nil).Once()
+		rollbackSpec := &client.ChartSpec{ReleaseName: "dogu-op", Namespace: testNamespace, Timeout: defaultHelmClientTimeoutMins}
+		helmClient.EXPECT().RollbackRelease(rollbackSpec, 3).Return(nil)
+		helmClientFactory := newMockHelmClientFactory(t)
+		helmClientFactory.EXPECT().NewHelmClient().Return(helmClient, nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Event(component, "Warning", RecoveryEventReason, "Rolled back release revision 4 of interrupted upgrade to version 0.2.0 to revision 3.").Once()
+		mockRecorder.EXPECT().Event(component, "Normal", "Upgrade", "Upgrade successful").Once()
+
+		manager := NewMockComponentManager(t)
+		manager.EXPECT().Upgrade(testCtx, component).Return(nil)
+		componentManagerFactory := newMockComponentManagerFactory(t)
+		componentManagerFactory.EXPECT().NewComponentManager(helmClient).Return(manager)
+
+		mockRequeueHandler := newMockRequeueHandler(t)
+		mockRequeueHandler.EXPECT().Handle(testCtx, "Upgrade failed with component dogu-op", component, nil, mock.Anything).Return(reconcile.Result{}, nil)
+
+		mockOperationEvaluator := newMockOperationEvaluator(t)
+		mockOperationEvaluator.EXPECT().EvaluateRequiredOperation(testCtx, component).Return(Upgrade, nil)
+		mockOperationEvaluatorFactory := newMockOperationEvaluatorFactory(t)
+		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
+
+		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
+			clientSet:                 clientSetMock,
+			recorder:                  mockRecorder,
+			componentManagerFactory:   componentManagerFactory,
+			helmClientFactory:         helmClientFactory,
+			journal:                   journalMock,
+			requeueHandler:            mockRequeueHandler,
+			operationEvaluatorFactory: mockOperationEvaluatorFactory,
+			timeout:                   defaultHelmClientTimeoutMins,
+		}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
+
+		// when
+		result, err := sut.Reconcile(testCtx, req)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+	})
 
 	t.Run("should fail on downgrade", func(t *testing.T) {
 		// given
@@ -248,10 +327,13 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			recorder:                  mockRecorder,
 			componentManagerFactory:   componentManagerFactory,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			operationEvaluatorFactory: mockOperationEvaluatorFactory,
 		}
 		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
@@ -264,6 +346,112 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		assert.ErrorContains(t, err, "downgrades are not allowed")
 	})
 
+	t.Run("success downgrade with downgrade annotation", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, helmNamespace, "", "dogu-op", "0.1.0")
+		component.Status.Status = "installed"
+		component.Annotations = map[string]string{annotations.AllowDowngradeAnnotation: "true"}
+
+		componentInterfaceMock := newMockComponentInterface(t)
+		componentInterfaceMock.EXPECT().Get(testCtx, "dogu-op", v1.GetOptions{}).Return(component, nil)
+		componentClientGetterMock := newMockComponentV1Alpha1Interface(t)
+		componentClientGetterMock.EXPECT().Components(testNamespace).Return(componentInterfaceMock)
+		clientSetMock := newMockComponentEcosystemInterface(t)
+		clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Event(component, "Normal", "Downgrade", "Downgrade successful")
+
+		manager := NewMockComponentManager(t)
+		manager.EXPECT().Downgrade(testCtx, component).Return(nil)
+		helmClient := newMockHelmClient(t)
+		helmClientFactory := newMockHelmClientFactory(t)
+		helmClientFactory.EXPECT().NewHelmClient().Return(helmClient, nil)
+		componentManagerFactory := newMockComponentManagerFactory(t)
+		componentManagerFactory.EXPECT().NewComponentManager(helmClient).Return(manager)
+
+		mockRequeueHandler := newMockRequeueHandler(t)
+		mockRequeueHandler.EXPECT().Handle(testCtx, "Downgrade failed with component dogu-op", component, nil, "tryToUpgrade").Return(reconcile.Result{}, nil)
+
+		mockOperationEvaluator := newMockOperationEvaluator(t)
+		mockOperationEvaluator.EXPECT().EvaluateRequiredOperation(testCtx, component).Return(Downgrade, nil)
+		mockOperationEvaluatorFactory := newMockOperationEvaluatorFactory(t)
+		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
+
+		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
+			clientSet:                 clientSetMock,
+			recorder:                  mockRecorder,
+			componentManagerFactory:   componentManagerFactory,
+			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
+			operationEvaluatorFactory: mockOperationEvaluatorFactory,
+			requeueHandler:            mockRequeueHandler,
+		}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
+
+		// when
+		result, err := sut.Reconcile(testCtx, req)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+	})
+
+	t.Run("success downgrade with downgrades allowed operator-wide", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, helmNamespace, "", "dogu-op", "0.1.0")
+		component.Status.Status = "installed"
+
+		componentInterfaceMock := newMockComponentInterface(t)
+		componentInterfaceMock.EXPECT().Get(testCtx, "dogu-op", v1.GetOptions{}).Return(component, nil)
+		componentClientGetterMock := newMockComponentV1Alpha1Interface(t)
+		componentClientGetterMock.EXPECT().Components(testNamespace).Return(componentInterfaceMock)
+		clientSetMock := newMockComponentEcosystemInterface(t)
+		clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Event(component, "Warning", "Downgrade", "Downgrade failed. Reason: assert.AnError general error for testing")
+
+		manager := NewMockComponentManager(t)
+		manager.EXPECT().Downgrade(testCtx, component).Return(assert.AnError)
+		helmClient := newMockHelmClient(t)
+		helmClientFactory := newMockHelmClientFactory(t)
+		helmClientFactory.EXPECT().NewHelmClient().Return(helmClient, nil)
+		componentManagerFactory := newMockComponentManagerFactory(t)
+		componentManagerFactory.EXPECT().NewComponentManager(helmClient).Return(manager)
+
+		mockRequeueHandler := newMockRequeueHandler(t)
+		mockRequeueHandler.EXPECT().Handle(testCtx, "Downgrade failed with component dogu-op", component, assert.AnError, "tryToUpgrade").Return(reconcile.Result{}, nil)
+
+		mockOperationEvaluator := newMockOperationEvaluator(t)
+		mockOperationEvaluator.EXPECT().EvaluateRequiredOperation(testCtx, component).Return(Downgrade, nil)
+		mockOperationEvaluatorFactory := newMockOperationEvaluatorFactory(t)
+		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
+
+		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
+			clientSet:                 clientSetMock,
+			recorder:                  mockRecorder,
+			componentManagerFactory:   componentManagerFactory,
+			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
+			operationEvaluatorFactory: mockOperationEvaluatorFactory,
+			requeueHandler:            mockRequeueHandler,
+			allowDowngrades:           true,
+		}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
+
+		// when
+		result, err := sut.Reconcile(testCtx, req)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+	})
+
 	t.Run("should ignore equal installed component", func(t *testing.T) {
 		// given
 		component := getComponent(testNamespace, helmNamespace, "", "dogu-op", "0.1.0")
@@ -292,10 +480,13 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			recorder:                  mockRecorder,
 			componentManagerFactory:   componentManagerFactory,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			timeout:                   defaultHelmClientTimeoutMins,
 			yamlSerializer:            yaml.NewSerializer(),
 			reader:                    configMapRefReaderMock,
@@ -321,7 +512,9 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
 
 		sut := ComponentReconciler{
-			clientSet: clientSetMock,
+			conditionWriter:     newConditionWriterMock(t),
+			dependencyWaitIndex: newDependencyWaitIndex(),
+			clientSet:           clientSetMock,
 		}
 		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
 
@@ -344,8 +537,11 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
 
 		sut := ComponentReconciler{
-			clientSet: clientSetMock,
+			conditionWriter:     newConditionWriterMock(t),
+			dependencyWaitIndex: newDependencyWaitIndex(),
+			clientSet:           clientSetMock,
 		}
+		sut.dependencyWaitIndex.setWaiting(types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}, []string{"k8s-etcd"})
 		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
 
 		// when
@@ -353,6 +549,7 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 
 		// then
 		require.NoError(t, err)
+		assert.Empty(t, sut.dependencyWaitIndex.popWaiting("k8s-etcd"))
 	})
 
 	t.Run("should fail on creating helm client", func(t *testing.T) {
@@ -370,8 +567,11 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		helmClientFactory.EXPECT().NewHelmClient().Return(nil, assert.AnError)
 
 		sut := ComponentReconciler{
-			clientSet:         clientSetMock,
-			helmClientFactory: helmClientFactory,
+			conditionWriter:     newConditionWriterMock(t),
+			dependencyWaitIndex: newDependencyWaitIndex(),
+			clientSet:           clientSetMock,
+			helmClientFactory:   helmClientFactory,
+			journal:             newEmptyJournalMock(t),
 		}
 		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "dogu-op"}}
 
@@ -408,8 +608,11 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			componentManagerFactory:   componentManagerFactory,
 			operationEvaluatorFactory: mockOperationEvaluatorFactory,
 		}
@@ -459,10 +662,13 @@ func Test_componentReconciler_Reconcile(t *testing.T) {
 		mockOperationEvaluatorFactory.EXPECT().NewOperationEvaluator(helmClient).Return(mockOperationEvaluator)
 
 		sut := ComponentReconciler{
+			conditionWriter:           newConditionWriterMock(t),
+			dependencyWaitIndex:       newDependencyWaitIndex(),
 			clientSet:                 clientSetMock,
 			recorder:                  mockRecorder,
 			componentManagerFactory:   componentManagerFactory,
 			helmClientFactory:         helmClientFactory,
+			journal:                   newEmptyJournalMock(t),
 			requeueHandler:            mockRequeueHandler,
 			operationEvaluatorFactory: mockOperationEvaluatorFactory,
 		}
@@ -604,3 +810,84 @@ func TestComponentReconciler_getComponentRequest(t *testing.T) {
 		assert.Equal(t, reconcile.Request{NamespacedName: types.NamespacedName{Name: "dogu-op", Namespace: "ecosystem"}}, requests[0])
 	})
 }
+
+func TestComponentReconciler_updateDependencyWaitIndex(t *testing.T) {
+	t.Run("should remove component from index if there are no unsatisfied dependencies", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.1.0")
+		componentKey := types.NamespacedName{Namespace: "ecosystem", Name: "dogu-op"}
+		sut := ComponentReconciler{dependencyWaitIndex: newDependencyWaitIndex()}
+		sut.dependencyWaitIndex.setWaiting(componentKey, []string{"k8s-etcd"})
+
+		// when
+		sut.updateDependencyWaitIndex(testCtx, component, assert.AnError)
+
+		// then
+		assert.Empty(t, sut.dependencyWaitIndex.popWaiting("k8s-etcd"))
+	})
+	t.Run("should remove component from index after successful operation", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.1.0")
+		componentKey := types.NamespacedName{Namespace: "ecosystem", Name: "dogu-op"}
+		sut := ComponentReconciler{dependencyWaitIndex: newDependencyWaitIndex()}
+		sut.dependencyWaitIndex.setWaiting(componentKey, []string{"k8s-etcd"})
+
+		// when
+		sut.updateDependencyWaitIndex(testCtx, component, nil)
+
+		// then
+		assert.Empty(t, sut.dependencyWaitIndex.popWaiting("k8s-etcd"))
+	})
+}
+
+func TestComponentReconciler_getDependentComponentRequests(t *testing.T) {
+	t.Run("should return requests for waiting components", func(t *testing.T) {
+		// given
+		dependency := getComponent("ecosystem", "k8s", "", "k8s-etcd", "3.5.9-1")
+		dependent := types.NamespacedName{Namespace: "ecosystem", Name: "dogu-op"}
+		sut := ComponentReconciler{dependencyWaitIndex: newDependencyWaitIndex()}
+		sut.dependencyWaitIndex.setWaiting(dependent, []string{"k8s-etcd"})
+
+		// when
+		requests := sut.getDependentComponentRequests(testCtx, dependency)
+
+		// then
+		assert.Equal(t, []reconcile.Request{{NamespacedName: dependent}}, requests)
+		assert.Empty(t, sut.getDependentComponentRequests(testCtx, dependency))
+	})
+	t.Run("should return no requests if no component is waiting", func(t *testing.T) {
+		// given
+		dependency := getComponent("ecosystem", "k8s", "", "k8s-etcd", "3.5.9-1")
+		sut := ComponentReconciler{dependencyWaitIndex: newDependencyWaitIndex()}
+
+		// when
+		requests := sut.getDependentComponentRequests(testCtx, dependency)
+
+		// then
+		assert.Empty(t, requests)
+	})
+}
+
+func Test_installedStatusPredicate(t *testing.T) {
+	installing := getComponent("ecosystem", "k8s", "", "k8s-etcd", "3.5.9-1")
+	installing.Status.Status = k8sv1.ComponentStatusInstalling
+	installed := installing.DeepCopy()
+	installed.Status.Status = k8sv1.ComponentStatusInstalled
+
+	sut := installedStatusPredicate()
+
+	t.Run("should pass update to status installed", func(t *testing.T) {
+		assert.True(t, sut.Update(event.TypedUpdateEvent[*k8sv1.Component]{ObjectOld: installing, ObjectNew: installed}))
+	})
+	t.Run("should filter update of installed component", func(t *testing.T) {
+		assert.False(t, sut.Update(event.TypedUpdateEvent[*k8sv1.Component]{ObjectOld: installed, ObjectNew: installed}))
+	})
+	t.Run("should filter update to other status", func(t *testing.T) {
+		assert.False(t, sut.Update(event.TypedUpdateEvent[*k8sv1.Component]{ObjectOld: installed, ObjectNew: installing}))
+	})
+	t.Run("should filter other events", func(t *testing.T) {
+		assert.False(t, sut.Create(event.TypedCreateEvent[*k8sv1.Component]{Object: installed}))
+		assert.False(t, sut.Delete(event.TypedDeleteEvent[*k8sv1.Component]{Object: installed}))
+		assert.False(t, sut.Generic(event.TypedGenericEvent[*k8sv1.Component]{Object: installed}))
+	})
+}
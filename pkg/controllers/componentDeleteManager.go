@@ -5,10 +5,14 @@ import (
 	"fmt"
 
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
 	"github.com/cloudogu/retry-lib/retry"
 )
 
@@ -40,6 +44,11 @@ func (cdm *componentDeleteManager) Delete(ctx context.Context, component *k8sv1.
 		return &genericRequeueableError{"could not list Helm releases", err}
 	}
 
+	err = cdm.handleDependents(ctx, component, allReleases)
+	if err != nil {
+		return err
+	}
+
 	// Check if Helm Chart is still present before uninstalling; maybe someone has already removed it manually
 	for _, release := range allReleases {
 		if component.Spec.Name == release.Name {
@@ -69,3 +78,56 @@ func (cdm *componentDeleteManager) Delete(ctx context.Context, component *k8sv1.
 
 	return nil
 }
+
+// handleDependents prevents the deletion of components other installed components depend on. The deletion is only
+// continued if it is forced by the ForceDeleteAnnotation or if the dependents are deleted first because of the
+// CascadeDeleteAnnotation.
+func (cdm *componentDeleteManager) handleDependents(ctx context.Context, component *k8sv1.Component, allReleases []*release.Release) error {
+	logger := log.FromContext(ctx)
+
+	if annotations.IsTrue(component, annotations.CascadeDeleteAnnotation) {
+		return cdm.deleteDependents(ctx, component, allReleases)
+	}
+
+	dependents := helm.GetDependents(component.Spec.Name, allReleases)
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	if annotations.IsTrue(component, annotations.ForceDeleteAnnotation) {
+		logger.Info(fmt.Sprintf("Forcing deletion of component %s although the components %v depend on it.", component.Spec.Name, dependents))
+		return nil
+	}
+
+	err := fmt.Errorf("the installed components %v depend on it; set the annotation %s or %s to \"true\" to delete it anyway",
+		dependents, annotations.ForceDeleteAnnotation, annotations.CascadeDeleteAnnotation)
+	return &genericRequeueableError{fmt.Sprintf("cannot delete component %s", component.Spec.Name), err}
+}
+
+// deleteDependents uninstalls all components depending directly or transitively on the given component, starting
+// with the components no other component depends on.
+func (cdm *componentDeleteManager) deleteDependents(ctx context.Context, component *k8sv1.Component, allReleases []*release.Release) error {
+	logger := log.FromContext(ctx)
+
+	dependents, err := helm.GetDependentsInDeletionOrder(component.Spec.Name, allReleases)
+	if err != nil {
+		return fmt.Errorf("failed to determine deletion order of components depending on %s: %w", component.Spec.Name, err)
+	}
+
+	for _, dependent := range dependents {
+		logger.Info(fmt.Sprintf("Deleting component %s because it depends on component %s.", dependent, component.Spec.Name))
+
+		err = cdm.helmClient.Uninstall(dependent)
+		if err != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to uninstall dependent component %s", dependent), err}
+		}
+
+		// the component resource must be deleted as well, otherwise the dependent would be installed again
+		err = cdm.componentClient.Delete(ctx, dependent, v1.DeleteOptions{})
+		if client.IgnoreNotFound(err) != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to delete dependent component resource %s", dependent), err}
+		}
+	}
+
+	return nil
+}
@@ -5,13 +5,18 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
 
 	"helm.sh/helm/v3/pkg/release"
+	k8sErrs "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
 )
 
 func TestNewComponentDeleteManager(t *testing.T) {
@@ -181,3 +186,163 @@ func Test_componentDeleteManager_Delete(t *testing.T) {
 		assert.ErrorContains(t, err, "failed to remove finalizer for component testComponent:")
 	})
 }
+
+func Test_componentDeleteManager_Delete_dependents(t *testing.T) {
+	getEtcd := func(annotations map[string]string) *k8sv1.Component {
+		return &k8sv1.Component{
+			ObjectMeta: v1.ObjectMeta{Name: "k8s-etcd", Annotations: annotations},
+			Spec:       k8sv1.ComponentSpec{Namespace: "k8s", Name: "k8s-etcd", Version: "3.5.9-1"},
+			Status:     k8sv1.ComponentStatus{Status: "installed"},
+		}
+	}
+	releases := []*release.Release{
+		dependentRelease("k8s-etcd"),
+		dependentRelease("k8s-dogu-operator", "k8s-etcd"),
+		dependentRelease("k8s-service-discovery", "k8s-dogu-operator"),
+	}
+
+	t.Run("should block deletion if other components depend on the component", func(t *testing.T) {
+		// given
+		component := getEtcd(nil)
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusDeleting(testCtx, component).Return(component, nil)
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ListReleasesByStateMask(action.ListAll).Return(releases, nil)
+
+		sut := NewComponentDeleteManager(mockComponentClient, mockHelmClient)
+
+		// when
+		err := sut.Delete(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &genericRequeueableError{}, err)
+		assert.ErrorContains(t, err, "cannot delete component k8s-etcd: the installed components [k8s-dogu-operator] depend on it")
+	})
+	t.Run("should delete component with dependents if forced", func(t *testing.T) {
+		// given
+		component := getEtcd(map[string]string{annotations.ForceDeleteAnnotation: "true"})
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusDeleting(testCtx, component).Return(component, nil)
+		mockComponentClient.EXPECT().Get(testCtx, component.Name, v1.GetOptions{}).Return(component, nil)
+		mockComponentClient.EXPECT().RemoveFinalizer(testCtx, component, k8sv1.FinalizerName).Return(component, nil)
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ListReleasesByStateMask(action.ListAll).Return(releases, nil)
+		mockHelmClient.EXPECT().Uninstall("k8s-etcd").Return(nil)
+
+		sut := NewComponentDeleteManager(mockComponentClient, mockHelmClient)
+
+		// when
+		err := sut.Delete(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should delete dependents in reverse topological order on cascade", func(t *testing.T) {
+		// given
+		component := getEtcd(map[string]string{annotations.CascadeDeleteAnnotation: "true"})
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusDeleting(testCtx, component).Return(component, nil)
+		mockComponentClient.EXPECT().Get(testCtx, component.Name, v1.GetOptions{}).Return(component, nil)
+		mockComponentClient.EXPECT().RemoveFinalizer(testCtx, component, k8sv1.FinalizerName).Return(component, nil)
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ListReleasesByStateMask(action.ListAll).Return(releases, nil)
+
+		var uninstalled []string
+		mockHelmClient.EXPECT().Uninstall(mock.Anything).RunAndReturn(func(name string) error {
+			uninstalled = append(uninstalled, name)
+			return nil
+		}).Times(3)
+		mockComponentClient.EXPECT().Delete(testCtx, "k8s-service-discovery", v1.DeleteOptions{}).Return(nil)
+		mockComponentClient.EXPECT().Delete(testCtx, "k8s-dogu-operator", v1.DeleteOptions{}).Return(k8sErrs.NewNotFound(schema.GroupResource{}, "k8s-dogu-operator"))
+
+		sut := NewComponentDeleteManager(mockComponentClient, mockHelmClient)
+
+		// when
+		err := sut.Delete(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"k8s-service-discovery", "k8s-dogu-operator", "k8s-etcd"}, uninstalled)
+	})
+	t.Run("should fail to uninstall dependent on cascade", func(t *testing.T) {
+		// given
+		component := getEtcd(map[string]string{annotations.CascadeDeleteAnnotation: "true"})
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusDeleting(testCtx, component).Return(component, nil)
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ListReleasesByStateMask(action.ListAll).Return(releases, nil)
+		mockHelmClient.EXPECT().Uninstall("k8s-service-discovery").Return(assert.AnError)
+
+		sut := NewComponentDeleteManager(mockComponentClient, mockHelmClient)
+
+		// when
+		err := sut.Delete(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.IsType(t, &genericRequeueableError{}, err)
+		assert.ErrorContains(t, err, "failed to uninstall dependent component k8s-service-discovery")
+	})
+	t.Run("should fail to delete dependent component resource on cascade", func(t *testing.T) {
+		// given
+		component := getEtcd(map[string]string{annotations.CascadeDeleteAnnotation: "true"})
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusDeleting(testCtx, component).Return(component, nil)
+		mockComponentClient.EXPECT().Delete(testCtx, "k8s-service-discovery", v1.DeleteOptions{}).Return(assert.AnError)
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ListReleasesByStateMask(action.ListAll).Return(releases, nil)
+		mockHelmClient.EXPECT().Uninstall("k8s-service-discovery").Return(nil)
+
+		sut := NewComponentDeleteManager(mockComponentClient, mockHelmClient)
+
+		// when
+		err := sut.Delete(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to delete dependent component resource k8s-service-discovery")
+	})
+	t.Run("should fail on dependency cycle on cascade", func(t *testing.T) {
+		// given
+		component := getEtcd(map[string]string{annotations.CascadeDeleteAnnotation: "true"})
+		cyclicReleases := []*release.Release{
+			dependentRelease("k8s-etcd", "k8s-dogu-operator"),
+			dependentRelease("k8s-dogu-operator", "k8s-etcd"),
+		}
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusDeleting(testCtx, component).Return(component, nil)
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ListReleasesByStateMask(action.ListAll).Return(cyclicReleases, nil)
+
+		sut := NewComponentDeleteManager(mockComponentClient, mockHelmClient)
+
+		// when
+		err := sut.Delete(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to determine deletion order of components depending on k8s-etcd: found dependency cycle")
+	})
+}
+
+func dependentRelease(name string, dependencies ...string) *release.Release {
+	annotations := map[string]string{}
+	for _, dependency := range dependencies {
+		annotations["k8s.cloudogu.com/ces-dependency/"+dependency] = "1.x.x-0"
+	}
+
+	return &release.Release{
+		Name:  name,
+		Info:  &release.Info{Status: release.StatusDeployed},
+		Chart: &chart.Chart{Metadata: &chart.Metadata{Name: name, Version: "1.0.0", Annotations: annotations}},
+	}
+}
@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ComponentDowngradeManager is a central unit in the process of handling the downgrade process of a custom component resource.
+type ComponentDowngradeManager struct {
+	componentClient componentInterface
+	helmClient      helmClient
+	healthManager   healthManager
+	recorder        record.EventRecorder
+	timeout         time.Duration
+	reader          configMapRefReader
+}
+
+// NewComponentDowngradeManager creates a new instance of ComponentDowngradeManager.
+func NewComponentDowngradeManager(componentClient componentInterface, helmClient helmClient, healthManager healthManager, recorder record.EventRecorder, timeout time.Duration, reader configMapRefReader) *ComponentDowngradeManager {
+	return &ComponentDowngradeManager{
+		componentClient: componentClient,
+		helmClient:      helmClient,
+		healthManager:   healthManager,
+		recorder:        recorder,
+		timeout:         timeout,
+		reader:          reader,
+	}
+}
+
+// Downgrade downgrades a given component resource to its expected version.
+// A rollback to the latest helm revision with the expected version and the current values is preferred. If there is no
+// such revision or the rollback fails, the expected version is applied by a versioned helm upgrade.
+func (cdm *ComponentDowngradeManager) Downgrade(ctx context.Context, component *k8sv1.Component) error {
+	logger := log.FromContext(ctx)
+
+	targetVersion, err := resolveComponentVersion(cdm.helmClient, component, component.Status.InstalledVersion)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to resolve version for component %q", component.Spec.Name), err}
+	}
+	recordResolvedVersion(cdm.recorder, component, DowngradeEventReason, targetVersion)
+
+	err = updateResolvedVersion(ctx, cdm.componentClient, component, targetVersion)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to store resolved version for component %q", component.Spec.Name), err}
+	}
+	targetComponent := withVersion(component, targetVersion)
+
+	chartSpec, err := helm.GetHelmChartSpec(ctx, targetComponent, helm.HelmChartCreationOpts{
+		HelmClient:     cdm.helmClient,
+		Timeout:        cdm.timeout,
+		YamlSerializer: yaml.NewSerializer(),
+		Reader:         cdm.reader,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get helm chart spec: %w", err)
+	}
+
+	err = cdm.helmClient.SatisfiesDependencies(ctx, chartSpec)
+	if err != nil {
+		cdm.recorder.Eventf(component, corev1.EventTypeWarning, DowngradeEventReason, "Dependency check failed: %s", err.Error())
+		return &genericRequeueableError{errMsg: "failed to check dependencies", err: err}
+	}
+
+	err = checkDependents(ctx, cdm.helmClient, cdm.recorder, component, targetVersion, DowngradeEventReason)
+	if err != nil {
+		return err
+	}
+
+	if component.Status.Status != k8sv1.ComponentStatusUpgrading {
+		component, err = cdm.componentClient.UpdateStatusUpgrading(ctx, component)
+		if err != nil {
+			return &genericRequeueableError{errMsg: fmt.Sprintf("failed to update status-upgrading for component %s", component.Spec.Name), err: err}
+		}
+	}
+
+	// create a new context that does not get canceled immediately on SIGTERM
+	helmCtx := context.WithoutCancel(ctx)
+
+	err = cdm.downgradeRelease(helmCtx, targetComponent, chartSpec)
+	if err != nil {
+		return err
+	}
+
+	component, err = cdm.componentClient.UpdateStatusInstalled(helmCtx, component)
+	if err != nil {
+		return &genericRequeueableError{errMsg: fmt.Sprintf("failed to update status-installed for component %s", component.Spec.Name), err: err}
+	}
+
+	err = cdm.healthManager.UpdateComponentHealthWithInstalledVersion(helmCtx, component.Spec.Name, component.Namespace, targetVersion)
+	if err != nil {
+		return fmt.Errorf("failed to update health status for component %q: %w", component.Spec.Name, err)
+	}
+
+	logger.Info(fmt.Sprintf("Downgraded component %s to version %s.", component.Spec.Name, targetVersion))
+
+	return nil
+}
+
+// downgradeRelease rolls the helm release back to a revision with the expected version or falls back to a versioned upgrade.
+// A rollback restores the values of the old revision, so only revisions with the values of the chart spec are used.
+func (cdm *ComponentDowngradeManager) downgradeRelease(ctx context.Context, component *k8sv1.Component, chartSpec *client.ChartSpec) error {
+	logger := log.FromContext(ctx)
+
+	release, err := cdm.helmClient.GetRelease(component.Spec.Name)
+	if err != nil {
+		return &genericRequeueableError{"failed to get release for component " + component.Spec.Name, err}
+	}
+
+	if release.Info.Status.IsPending() {
+		err = handlePendingRelease(logger, component, ctx, cdm.helmClient, cdm.timeout)
+		if err != nil {
+			return &genericRequeueableError{errMsg: fmt.Sprintf("failed to handle pending helm release for component %s", component.Spec.Name), err: err}
+		}
+	}
+
+	revision, err := cdm.findRollbackRevision(component, chartSpec)
+	if err != nil {
+		logger.Error(err, "failed to find a helm revision to roll back to, falling back to versioned upgrade")
+	}
+
+	if revision > 0 {
+		logger.Info(fmt.Sprintf("Rolling back component %s to helm revision %d", component.Spec.Name, revision))
+		err = cdm.helmClient.RollbackRelease(chartSpec, revision)
+		if err == nil {
+			cdm.recorder.Eventf(component, corev1.EventTypeNormal, DowngradeEventReason, "Rolled back to helm revision %d with version %s.", revision, component.Spec.Version)
+			return nil
+		}
+
+		logger.Error(err, fmt.Sprintf("failed to roll back component %s to helm revision %d, falling back to versioned upgrade", component.Spec.Name, revision))
+		cdm.recorder.Eventf(component, corev1.EventTypeWarning, DowngradeEventReason, "Rollback to helm revision %d failed, falling back to versioned upgrade.", revision)
+	}
+
+	if err := cdm.helmClient.InstallOrUpgrade(ctx, chartSpec); err != nil {
+		return &genericRequeueableError{errMsg: fmt.Sprintf("failed to downgrade chart for component %s", component.Spec.Name), err: err}
+	}
+	cdm.recorder.Eventf(component, corev1.EventTypeNormal, DowngradeEventReason, "Applied version %s by helm upgrade.", component.Spec.Version)
+
+	return nil
+}
+
+// findRollbackRevision returns the newest revision of the component's release that was deployed with the expected
+// version and the values of the given chart spec. It returns 0 if there is no such revision.
+func (cdm *ComponentDowngradeManager) findRollbackRevision(component *k8sv1.Component, chartSpec *client.ChartSpec) (int, error) {
+	history, err := cdm.helmClient.GetReleaseHistory(component.Spec.Name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get release history for component %s: %w", component.Spec.Name, err)
+	}
+
+	values, err := cdm.helmClient.GetChartSpecValues(chartSpec)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get values of component %s: %w", component.Spec.Name, err)
+	}
+
+	revision := 0
+	for _, rel := range history {
+		if rel.Chart == nil || rel.Info == nil || rel.Chart.AppVersion() != component.Spec.Version {
+			continue
+		}
+
+		wasDeployed := rel.Info.Status == helmRelease.StatusSuperseded || rel.Info.Status == helmRelease.StatusDeployed
+		if wasDeployed && rel.Version > revision && equalValues(rel.Config, values) {
+			revision = rel.Version
+		}
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,434 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+func TestNewComponentDowngradeManager(t *testing.T) {
+	t.Run("should create new ComponentDowngradeManager", func(t *testing.T) {
+		mockComponentClient := newMockComponentInterface(t)
+		mockHelmClient := newMockHelmClient(t)
+
+		manager := NewComponentDowngradeManager(mockComponentClient, mockHelmClient, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		assert.NotNil(t, manager)
+		assert.Equal(t, mockHelmClient, manager.helmClient)
+		assert.Equal(t, mockComponentClient, manager.componentClient)
+	})
+}
+
+func Test_componentDowngradeManager_Downgrade(t *testing.T) {
+	component := &k8sv1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testComponent",
+			Namespace: "ecosystem",
+		},
+		Spec: k8sv1.ComponentSpec{
+			Namespace:       "ecosystem",
+			Name:            "testComponent",
+			Version:         "0.1.0",
+			ValuesConfigRef: &k8sv1.Reference{},
+		},
+		Status: k8sv1.ComponentStatus{Status: "installed"},
+	}
+	deployedRelease := &release.Release{
+		Info: &release.Info{Status: release.StatusDeployed},
+	}
+	history := []*release.Release{
+		historyRelease(1, "0.1.0", release.StatusSuperseded),
+		historyRelease(2, "0.1.0", release.StatusSuperseded),
+		historyRelease(3, "0.2.0", release.StatusFailed),
+		historyRelease(4, "0.2.0", release.StatusDeployed),
+	}
+
+	t.Run("should downgrade component by rollback", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, component).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(nil)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().GetChartSpecValues(spec).Return(map[string]interface{}{}, nil)
+		mockHelmClient.EXPECT().RollbackRelease(spec, 2).Return(nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Normal", "Downgrade", "Rolled back to helm revision %d with version %s.", 2, "0.1.0")
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, component.Spec.Name, "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(ctx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should downgrade component by upgrade if no matching revision exists", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, component).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(nil)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history[2:], nil)
+		mockHelmClient.EXPECT().GetChartSpecValues(spec).Return(map[string]interface{}{}, nil)
+		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Normal", "Downgrade", "Applied version %s by helm upgrade.", "0.1.0")
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, component.Spec.Name, "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(ctx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should roll back to older revision with the current values", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, component).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		changedValuesHistory := []*release.Release{
+			historyRelease(1, "0.1.0", release.StatusSuperseded),
+			historyReleaseWithValues(2, "0.1.0", release.StatusSuperseded, map[string]interface{}{"replicas": 2}),
+			historyRelease(3, "0.2.0", release.StatusDeployed),
+		}
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(nil)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(changedValuesHistory, nil)
+		mockHelmClient.EXPECT().GetChartSpecValues(spec).Return(map[string]interface{}{}, nil)
+		mockHelmClient.EXPECT().RollbackRelease(spec, 1).Return(nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Normal", "Downgrade", "Rolled back to helm revision %d with version %s.", 1, "0.1.0")
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, component.Spec.Name, "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(ctx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should downgrade component by upgrade if values of matching revisions differ", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, component).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(nil)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().GetChartSpecValues(spec).Return(map[string]interface{}{"replicas": 3}, nil)
+		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Normal", "Downgrade", "Applied version %s by helm upgrade.", "0.1.0")
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, component.Spec.Name, "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(ctx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fall back to upgrade if rollback fails", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, component).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(nil)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().GetChartSpecValues(spec).Return(map[string]interface{}{}, nil)
+		mockHelmClient.EXPECT().RollbackRelease(spec, 2).Return(assert.AnError)
+		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Downgrade", "Rollback to helm revision %d failed, falling back to versioned upgrade.", 2)
+		mockRecorder.EXPECT().Eventf(component, "Normal", "Downgrade", "Applied version %s by helm upgrade.", "0.1.0")
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, component.Spec.Name, "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(ctx, component)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if upgrade fails", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(nil)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(nil, assert.AnError)
+		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(assert.AnError)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(ctx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to downgrade chart for component testComponent")
+	})
+
+	t.Run("should fail if version does not satisfy dependent components", func(t *testing.T) {
+		// given
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(assert.AnError)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Downgrade", "Dependent components check failed: %s", assert.AnError.Error())
+
+		sut := &ComponentDowngradeManager{
+			helmClient: mockHelmClient,
+			recorder:   mockRecorder,
+			timeout:    defaultHelmClientTimeoutMins,
+			reader:     configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.IsType(t, err, &genericRequeueableError{})
+		assert.ErrorContains(t, err, "failed to check dependent components")
+	})
+
+	t.Run("should downgrade component with ignored dependent components check", func(t *testing.T) {
+		// given
+		ignoringComponent := component.DeepCopy()
+		ignoringComponent.Annotations = map[string]string{annotations.IgnoreDependentConstraintsAnnotation: "true"}
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(testCtx, ignoringComponent).Return(ignoringComponent, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, ignoringComponent).Return(ignoringComponent, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, ignoringComponent, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(assert.AnError)
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(deployedRelease, nil)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().GetChartSpecValues(spec).Return(map[string]interface{}{}, nil)
+		mockHelmClient.EXPECT().RollbackRelease(spec, 2).Return(nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(ignoringComponent, "Normal", "Downgrade", "Rolled back to helm revision %d with version %s.", 2, "0.1.0")
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, "testComponent", "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentDowngradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(testCtx, ignoringComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail on unsatisfied dependencies", func(t *testing.T) {
+		// given
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec := getTestChartSpec(t, component, mockHelmClient)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(assert.AnError)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Downgrade", "Dependency check failed: %s", assert.AnError.Error())
+
+		sut := &ComponentDowngradeManager{
+			helmClient: mockHelmClient,
+			recorder:   mockRecorder,
+			timeout:    defaultHelmClientTimeoutMins,
+			reader:     configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Downgrade(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to check dependencies")
+	})
+}
+
+func historyRelease(revision int, appVersion string, status release.Status) *release.Release {
+	return &release.Release{
+		Name:    "testComponent",
+		Version: revision,
+		Info:    &release.Info{Status: status},
+		Chart:   &chart.Chart{Metadata: &chart.Metadata{AppVersion: appVersion}},
+	}
+}
+
+func historyReleaseWithValues(revision int, appVersion string, status release.Status, values map[string]interface{}) *release.Release {
+	rel := historyRelease(revision, appVersion, status)
+	rel.Config = values
+	return rel
+}
+
+func getTestChartSpec(t *testing.T, component *k8sv1.Component, helmClient helmClient) *client.ChartSpec {
+	t.Helper()
+
+	reader := newMockConfigMapRefReader(t)
+	reader.EXPECT().GetValues(testCtx, component.Spec.ValuesConfigRef).Return("", nil)
+	spec, err := helm.GetHelmChartSpec(testCtx, component, helm.HelmChartCreationOpts{
+		HelmClient:     helmClient,
+		Timeout:        defaultHelmClientTimeoutMins,
+		YamlSerializer: yaml.NewSerializer(),
+		Reader:         reader,
+	})
+	require.NoError(t, err)
+
+	return spec
+}
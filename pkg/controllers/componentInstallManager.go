@@ -40,11 +40,17 @@ func NewComponentInstallManager(componentClient componentInterface, helmClient h
 }
 
 // Install installs a given Component Resource.
-// If no expected version is given in the component CR the latest version will be installed.
+// If no expected version is given in the component CR the latest version will be installed. Version ranges and channels
+// are resolved to the newest matching version. A deployed release of a component with the AdoptAnnotation is adopted
+// instead.
 func (cim *ComponentInstallManager) Install(ctx context.Context, component *k8sv1.Component) error {
 	logger := log.FromContext(ctx)
 
-	var err error
+	adopted, err := cim.adoptIfRequested(ctx, component)
+	if adopted || err != nil {
+		return err
+	}
+
 	// set the installed version in the component CR to use it for version-comparison in future upgrades
 	var version string
 	if component.Spec.Version == "" {
@@ -58,10 +64,19 @@ func (cim *ComponentInstallManager) Install(ctx context.Context, component *k8sv
 			return &genericRequeueableError{fmt.Sprintf("failed to update expected version for component %q", component.Spec.Name), err}
 		}
 	} else {
-		version = component.Spec.Version
+		version, err = resolveComponentVersion(cim.helmClient, component, component.Status.InstalledVersion)
+		if err != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to resolve version for component %q", component.Spec.Name), err}
+		}
+		recordResolvedVersion(cim.recorder, component, InstallEventReason, version)
+
+		err = updateResolvedVersion(ctx, cim.componentClient, component, version)
+		if err != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to store resolved version for component %q", component.Spec.Name), err}
+		}
 	}
 
-	chartSpec, err := helm.GetHelmChartSpec(ctx, component, helm.HelmChartCreationOpts{
+	chartSpec, err := helm.GetHelmChartSpec(ctx, withVersion(component, version), helm.HelmChartCreationOpts{
 		HelmClient:     cim.helmClient,
 		Timeout:        cim.timeout,
 		YamlSerializer: yaml.NewSerializer(),
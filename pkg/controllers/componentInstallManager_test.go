@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -539,6 +540,79 @@ func Test_componentInstallManager_Install(t *testing.T) {
 		assert.IsType(t, err, &genericRequeueableError{})
 		assert.ErrorContains(t, err, "failed to update expected version for component \"dogu-op\"")
 	})
+
+	t.Run("should install resolved version of version range and store it in the status", func(t *testing.T) {
+		// given
+		componentWithRange := getComponent(namespace, "k8s", "", "dogu-op", "~4.8")
+		componentWithRange.Spec.ValuesConfigRef = &k8sv1.Reference{}
+		resolvedComponent := componentWithRange.DeepCopy()
+		resolvedComponent.Spec.Version = "4.8.3"
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusInstalling(testCtx, componentWithRange).Return(componentWithRange, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(ctxWithoutCancel, componentWithRange).Return(componentWithRange, nil)
+		mockComponentClient.EXPECT().AddFinalizer(testCtx, componentWithRange, "component-finalizer").Return(componentWithRange, nil)
+		mockComponentClient.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(componentWithRange.DeepCopy(), nil)
+		mockComponentClient.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(returnUpdatedComponent)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ResolveVersion("k8s/dogu-op", mock.Anything, "").Return("4.8.3", nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+		spec, _ := helm.GetHelmChartSpec(testCtx, resolvedComponent, helm.HelmChartCreationOpts{
+			HelmClient:     mockHelmClient,
+			YamlSerializer: yaml.NewSerializer(),
+			Timeout:        defaultHelmClientTimeoutMins,
+			Reader:         configMapRefReaderMock,
+		})
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().InstallOrUpgrade(ctxWithoutCancel, spec).Return(nil)
+		mockHelmClient.EXPECT().GetRelease(component.Name).Return(nil, driver.ErrReleaseNotFound)
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(testCtx, component.Spec.Name, namespace, "4.8.3").Return(nil)
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(componentWithRange, "Normal", InstallEventReason, "Resolved version %s to %s.", "~4.8", "4.8.3").Return()
+
+		sut := ComponentInstallManager{
+			componentClient: mockComponentClient,
+			healthManager:   mockHealthManager,
+			helmClient:      mockHelmClient,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Install(testCtx, componentWithRange)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "~4.8", componentWithRange.Spec.Version)
+		assert.Equal(t, "4.8.3", componentWithRange.Status.ResolvedVersion)
+	})
+
+	t.Run("should fail on error while resolving version range", func(t *testing.T) {
+		// given
+		componentWithRange := getComponent(namespace, "k8s", "", "dogu-op", "~4.8")
+		mockComponentClient := newMockComponentInterface(t)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().ResolveVersion("k8s/dogu-op", mock.Anything, "").Return("", assert.AnError)
+
+		sut := ComponentInstallManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			timeout:         defaultHelmClientTimeoutMins,
+		}
+
+		// when
+		err := sut.Install(testCtx, componentWithRange)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.IsType(t, err, &genericRequeueableError{})
+		assert.ErrorContains(t, err, "failed to resolve version for component \"dogu-op\"")
+	})
 }
 
 func TestComponentInstallManager_handlePendingRelease_MarkFailedError(t *testing.T) {
@@ -0,0 +1,580 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+// The steps of a migration which are stored in the migration status of the component.
+const (
+	// migrationStepStarted is the first step of a migration. The source and target namespace are known.
+	migrationStepStarted = "Started"
+	// migrationStepPrepared means that the listed secrets were copied and the listed volumes are retained.
+	migrationStepPrepared = "Prepared"
+	// migrationStepUninstalled means that the release was uninstalled from the source namespace.
+	migrationStepUninstalled = "Uninstalled"
+	// migrationStepTransferred means that the listed volumes are bound to claims in the target namespace.
+	migrationStepTransferred = "Transferred"
+	// migrationStepInstalled means that the release was installed into the target namespace.
+	migrationStepInstalled = "Installed"
+)
+
+const (
+	migrationResourceSecret = "secret"
+	migrationResourcePVC    = "pvc"
+
+	helmManagedByLabel             = "app.kubernetes.io/managed-by"
+	helmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+)
+
+var errClaimNotDeleted = errors.New("claim still exists")
+
+// ComponentMigrateManager moves installed components to another deploy namespace.
+type ComponentMigrateManager struct {
+	componentClient componentInterface
+	coreV1Client    coreV1Interface
+	helmClient      helmClient
+	healthManager   healthManager
+	recorder        record.EventRecorder
+	timeout         time.Duration
+	reader          configMapRefReader
+}
+
+// NewComponentMigrateManager creates a new instance of ComponentMigrateManager.
+func NewComponentMigrateManager(componentClient componentInterface, coreV1Client coreV1Interface, helmClient helmClient, healthManager healthManager, recorder record.EventRecorder, timeout time.Duration, reader configMapRefReader) *ComponentMigrateManager {
+	return &ComponentMigrateManager{
+		componentClient: componentClient,
+		coreV1Client:    coreV1Client,
+		helmClient:      helmClient,
+		healthManager:   healthManager,
+		recorder:        recorder,
+		timeout:         timeout,
+		reader:          reader,
+	}
+}
+
+// Migrate moves the release of the component from its current namespace to the deploy namespace of the component.
+// The release is uninstalled from the old namespace and installed into the new one. Secrets and persistent volume
+// claims listed in the MigrateResourcesAnnotation are taken along. Every finished step is stored in the migration status
+// of the component, so that the migration resumes with the next step after a restart or a failed step.
+//
+// Removing the MigrateNamespaceAnnotation aborts the migration as long as the release has not been uninstalled from the
+// source namespace.
+func (cmm *ComponentMigrateManager) Migrate(ctx context.Context, component *k8sv1.Component) error {
+	var err error
+	state := component.Status.Migration.DeepCopy()
+	if state != nil && !annotations.IsTrue(component, annotations.MigrateNamespaceAnnotation) {
+		if isMigrationAbortable(state) {
+			return cmm.abort(context.WithoutCancel(ctx), component, state)
+		}
+
+		cmm.recorder.Eventf(component, corev1.EventTypeWarning, MigrationEventReason,
+			"Migration cannot be aborted because the release was already uninstalled from namespace %q.", state.SourceNamespace)
+	}
+
+	if state == nil {
+		component, state, err = cmm.start(ctx, component)
+		if err != nil {
+			return err
+		}
+	}
+
+	if component.Status.Status != k8sv1.ComponentStatusUpgrading {
+		component, err = cmm.componentClient.UpdateStatusUpgrading(ctx, component)
+		if err != nil {
+			return &genericRequeueableError{errMsg: fmt.Sprintf("failed to update status-upgrading for component %s", component.Spec.Name), err: err}
+		}
+	}
+
+	// create a new context that does not get canceled immediately on SIGTERM
+	helmCtx := context.WithoutCancel(ctx)
+
+	for {
+		var nextStep string
+		switch state.Step {
+		case migrationStepStarted:
+			nextStep, err = migrationStepPrepared, cmm.prepare(helmCtx, component, state)
+		case migrationStepPrepared:
+			nextStep, err = migrationStepUninstalled, cmm.uninstall(component)
+		case migrationStepUninstalled:
+			nextStep, err = migrationStepTransferred, cmm.transferVolumes(helmCtx, component, state)
+		case migrationStepTransferred:
+			nextStep, err = migrationStepInstalled, cmm.install(helmCtx, component)
+		case migrationStepInstalled:
+			return cmm.finish(helmCtx, component, state)
+		default:
+			return fmt.Errorf("unknown migration step %q of component %q", state.Step, component.Spec.Name)
+		}
+		if err != nil {
+			return err
+		}
+
+		state.Step = nextStep
+		component, err = cmm.storeMigrationState(helmCtx, component, state)
+		if err != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to store migration step %s of component %q", nextStep, component.Spec.Name), err}
+		}
+		cmm.recorder.Eventf(component, corev1.EventTypeNormal, MigrationEventReason, "Migration step %s finished.", nextStep)
+	}
+}
+
+// start determines the namespace the release is currently deployed to and stores the first migration step.
+func (cmm *ComponentMigrateManager) start(ctx context.Context, component *k8sv1.Component) (*k8sv1.Component, *k8sv1.MigrationStatus, error) {
+	release, err := cmm.helmClient.GetRelease(component.Spec.Name)
+	if err != nil {
+		return nil, nil, &genericRequeueableError{"failed to get release to migrate for component " + component.Spec.Name, err}
+	}
+
+	state := &k8sv1.MigrationStatus{
+		SourceNamespace: release.Namespace,
+		TargetNamespace: getDeployNamespace(component),
+		Step:            migrationStepStarted,
+	}
+	if state.SourceNamespace == state.TargetNamespace {
+		return nil, nil, fmt.Errorf("component %q is already deployed to namespace %q", component.Spec.Name, state.TargetNamespace)
+	}
+
+	component, err = cmm.storeMigrationState(ctx, component, state)
+	if err != nil {
+		return nil, nil, &genericRequeueableError{fmt.Sprintf("failed to store migration state of component %q", component.Spec.Name), err}
+	}
+
+	log.FromContext(ctx).Info(fmt.Sprintf("Migrating component %q from namespace %q to %q", component.Spec.Name, state.SourceNamespace, state.TargetNamespace))
+	cmm.recorder.Eventf(component, corev1.EventTypeNormal, MigrationEventReason, "Migrating from namespace %q to %q.", state.SourceNamespace, state.TargetNamespace)
+
+	return component, state, nil
+}
+
+// prepare copies the listed secrets to the target namespace and retains the volumes of the listed claims so that they
+// survive the deletion of their claims. Volumes which were recorded by a previous attempt are kept.
+func (cmm *ComponentMigrateManager) prepare(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus) error {
+	secrets, claims, err := parseMigrationResources(component.Annotations[annotations.MigrateResourcesAnnotation])
+	if err != nil {
+		return err
+	}
+	if len(secrets) == 0 && len(claims) == 0 {
+		return nil
+	}
+
+	err = cmm.createNamespace(ctx, state.TargetNamespace)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range secrets {
+		err = cmm.copySecret(ctx, component, state, secret)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range claims {
+		err = cmm.retainVolume(ctx, component, state, claim)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmm *ComponentMigrateManager) createNamespace(ctx context.Context, namespace string) error {
+	_, err := cmm.coreV1Client.Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return &genericRequeueableError{fmt.Sprintf("failed to create target namespace %q", namespace), err}
+	}
+
+	return nil
+}
+
+func (cmm *ComponentMigrateManager) copySecret(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus, name string) error {
+	secret, err := cmm.coreV1Client.Secrets(state.SourceNamespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to get secret %q in namespace %q", name, state.SourceNamespace), err}
+	}
+
+	copiedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        secret.Name,
+			Namespace:   state.TargetNamespace,
+			Labels:      maps.Clone(secret.Labels),
+			Annotations: maps.Clone(secret.Annotations),
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+	setHelmOwnership(&copiedSecret.ObjectMeta, component.Spec.Name, state.TargetNamespace)
+
+	_, err = cmm.coreV1Client.Secrets(state.TargetNamespace).Create(ctx, copiedSecret, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return &genericRequeueableError{fmt.Sprintf("failed to copy secret %q to namespace %q", name, state.TargetNamespace), err}
+	}
+
+	return nil
+}
+
+// retainVolume sets the reclaim policy of the volume bound to the claim to Retain. The volume is recorded in the
+// migration status with its original reclaim policy before the policy is changed, so that a retry does not record
+// Retain as the original policy.
+func (cmm *ComponentMigrateManager) retainVolume(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus, claimName string) error {
+	index := slices.IndexFunc(state.Volumes, func(migrated k8sv1.MigratedVolume) bool {
+		return migrated.Claim == claimName
+	})
+	if index < 0 {
+		migrated, err := cmm.getMigratedVolume(ctx, state, claimName)
+		if err != nil {
+			return err
+		}
+
+		state.Volumes = append(state.Volumes, migrated)
+		_, err = cmm.storeMigrationState(ctx, component, state)
+		if err != nil {
+			return &genericRequeueableError{fmt.Sprintf("failed to store persistent volume %q of component %q", migrated.Volume, component.Spec.Name), err}
+		}
+		index = len(state.Volumes) - 1
+	}
+
+	return cmm.setReclaimPolicy(ctx, state.Volumes[index].Volume, corev1.PersistentVolumeReclaimRetain)
+}
+
+func (cmm *ComponentMigrateManager) getMigratedVolume(ctx context.Context, state *k8sv1.MigrationStatus, claimName string) (k8sv1.MigratedVolume, error) {
+	claim, err := cmm.coreV1Client.PersistentVolumeClaims(state.SourceNamespace).Get(ctx, claimName, metav1.GetOptions{})
+	if err != nil {
+		return k8sv1.MigratedVolume{}, &genericRequeueableError{fmt.Sprintf("failed to get persistent volume claim %q in namespace %q", claimName, state.SourceNamespace), err}
+	}
+	if claim.Spec.VolumeName == "" {
+		return k8sv1.MigratedVolume{}, fmt.Errorf("persistent volume claim %q in namespace %q is not bound", claimName, state.SourceNamespace)
+	}
+
+	volume, err := cmm.coreV1Client.PersistentVolumes().Get(ctx, claim.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return k8sv1.MigratedVolume{}, &genericRequeueableError{fmt.Sprintf("failed to get persistent volume %q", claim.Spec.VolumeName), err}
+	}
+
+	return k8sv1.MigratedVolume{
+		Claim:         claim.Name,
+		Volume:        volume.Name,
+		ReclaimPolicy: volume.Spec.PersistentVolumeReclaimPolicy,
+		Labels:        claim.Labels,
+		Spec:          claim.Spec,
+	}, nil
+}
+
+func (cmm *ComponentMigrateManager) setReclaimPolicy(ctx context.Context, volumeName string, reclaimPolicy corev1.PersistentVolumeReclaimPolicy) error {
+	err := retry.OnConflict(func() error {
+		volume, err := cmm.coreV1Client.PersistentVolumes().Get(ctx, volumeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if volume.Spec.PersistentVolumeReclaimPolicy == reclaimPolicy {
+			return nil
+		}
+
+		volume.Spec.PersistentVolumeReclaimPolicy = reclaimPolicy
+		_, err = cmm.coreV1Client.PersistentVolumes().Update(ctx, volume, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to set reclaim policy of persistent volume %q to %s", volumeName, reclaimPolicy), err}
+	}
+
+	return nil
+}
+
+// reserveVolume sets the claim reference of the volume to the new claim so that no other claim can bind it.
+func (cmm *ComponentMigrateManager) reserveVolume(ctx context.Context, volumeName string, namespace string, claimName string) error {
+	err := retry.OnConflict(func() error {
+		volume, err := cmm.coreV1Client.PersistentVolumes().Get(ctx, volumeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		volume.Spec.ClaimRef = &corev1.ObjectReference{Kind: "PersistentVolumeClaim", APIVersion: "v1", Namespace: namespace, Name: claimName}
+		_, err = cmm.coreV1Client.PersistentVolumes().Update(ctx, volume, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to reserve persistent volume %q for claim %q in namespace %q", volumeName, claimName, namespace), err}
+	}
+
+	return nil
+}
+
+func (cmm *ComponentMigrateManager) uninstall(component *k8sv1.Component) error {
+	err := cmm.helmClient.Uninstall(component.Spec.Name)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return &genericRequeueableError{"failed to uninstall release of component " + component.Spec.Name, err}
+	}
+
+	return nil
+}
+
+// transferVolumes binds the retained volumes to new claims with the same name in the target namespace. The new claims
+// are marked as owned by the release so that helm takes them over on installation.
+func (cmm *ComponentMigrateManager) transferVolumes(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus) error {
+	for _, migrated := range state.Volumes {
+		err := cmm.deleteSourceClaim(ctx, state, migrated.Claim)
+		if err != nil {
+			return err
+		}
+
+		err = cmm.reserveVolume(ctx, migrated.Volume, state.TargetNamespace, migrated.Claim)
+		if err != nil {
+			return err
+		}
+
+		claim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: migrated.Claim, Namespace: state.TargetNamespace, Labels: maps.Clone(migrated.Labels)},
+			Spec:       migrated.Spec,
+		}
+		claim.Spec.VolumeName = migrated.Volume
+		setHelmOwnership(&claim.ObjectMeta, component.Spec.Name, state.TargetNamespace)
+
+		_, err = cmm.coreV1Client.PersistentVolumeClaims(state.TargetNamespace).Create(ctx, claim, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return &genericRequeueableError{fmt.Sprintf("failed to create persistent volume claim %q in namespace %q", migrated.Claim, state.TargetNamespace), err}
+		}
+	}
+
+	return nil
+}
+
+// deleteSourceClaim deletes the claim in the source namespace. Claims which are still in use are not deleted
+// immediately, so the migration waits until the claim is gone.
+func (cmm *ComponentMigrateManager) deleteSourceClaim(ctx context.Context, state *k8sv1.MigrationStatus, name string) error {
+	claimClient := cmm.coreV1Client.PersistentVolumeClaims(state.SourceNamespace)
+	claim, err := claimClient.Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to get persistent volume claim %q in namespace %q", name, state.SourceNamespace), err}
+	}
+
+	if claim.DeletionTimestamp == nil {
+		err = claimClient.Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return &genericRequeueableError{fmt.Sprintf("failed to delete persistent volume claim %q in namespace %q", name, state.SourceNamespace), err}
+		}
+	}
+
+	return &genericRequeueableError{fmt.Sprintf("waiting for deletion of persistent volume claim %q in namespace %q", name, state.SourceNamespace), errClaimNotDeleted}
+}
+
+func (cmm *ComponentMigrateManager) install(ctx context.Context, component *k8sv1.Component) error {
+	targetVersion, err := cmm.getTargetVersion(ctx, component)
+	if err != nil {
+		return err
+	}
+
+	chartSpec, err := helm.GetHelmChartSpec(ctx, withVersion(component, targetVersion), helm.HelmChartCreationOpts{
+		HelmClient:     cmm.helmClient,
+		Timeout:        cmm.timeout,
+		YamlSerializer: yaml.NewSerializer(),
+		Reader:         cmm.reader,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get helm chart spec: %w", err)
+	}
+
+	err = cmm.helmClient.InstallOrUpgrade(ctx, chartSpec)
+	if err != nil {
+		return &genericRequeueableError{"failed to install chart for component " + component.Spec.Name, err}
+	}
+
+	return nil
+}
+
+func (cmm *ComponentMigrateManager) getTargetVersion(ctx context.Context, component *k8sv1.Component) (string, error) {
+	if component.Spec.Version == "" {
+		return component.Status.InstalledVersion, nil
+	}
+
+	targetVersion, err := resolveComponentVersion(cmm.helmClient, component, component.Status.InstalledVersion)
+	if err != nil {
+		return "", &genericRequeueableError{fmt.Sprintf("failed to resolve version for component %q", component.Spec.Name), err}
+	}
+
+	err = updateResolvedVersion(ctx, cmm.componentClient, component, targetVersion)
+	if err != nil {
+		return "", &genericRequeueableError{fmt.Sprintf("failed to store resolved version for component %q", component.Spec.Name), err}
+	}
+
+	return targetVersion, nil
+}
+
+// finish restores the reclaim policies of the transferred volumes and removes the migration status and annotations.
+func (cmm *ComponentMigrateManager) finish(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus) error {
+	for _, migrated := range state.Volumes {
+		err := cmm.restoreReclaimPolicy(ctx, migrated)
+		if err != nil {
+			return err
+		}
+	}
+
+	targetVersion, err := cmm.getTargetVersion(ctx, component)
+	if err != nil {
+		return err
+	}
+
+	component, err = cmm.componentClient.UpdateStatusInstalled(ctx, component)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to update status-installed for component %q", component.Spec.Name), err}
+	}
+
+	err = cmm.healthManager.UpdateComponentHealthWithInstalledVersion(ctx, component.Spec.Name, component.Namespace, targetVersion)
+	if err != nil {
+		return fmt.Errorf("failed to update health status and installed version for component %q: %w", component.Spec.Name, err)
+	}
+
+	_, err = cmm.storeMigrationState(ctx, component, nil)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to remove migration status of component %q", component.Spec.Name), err}
+	}
+
+	err = retry.OnConflict(func() error {
+		updatedComponent, err := cmm.componentClient.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		delete(updatedComponent.Annotations, annotations.MigrateNamespaceAnnotation)
+		delete(updatedComponent.Annotations, annotations.MigrateResourcesAnnotation)
+		_, err = cmm.componentClient.Update(ctx, updatedComponent, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to remove migration annotations of component %q", component.Spec.Name), err}
+	}
+
+	cmm.recorder.Eventf(component, corev1.EventTypeNormal, MigrationEventReason, "Migrated from namespace %q to %q.", state.SourceNamespace, state.TargetNamespace)
+
+	return nil
+}
+
+func (cmm *ComponentMigrateManager) restoreReclaimPolicy(ctx context.Context, migrated k8sv1.MigratedVolume) error {
+	if migrated.ReclaimPolicy == corev1.PersistentVolumeReclaimRetain || migrated.ReclaimPolicy == "" {
+		return nil
+	}
+
+	return cmm.setReclaimPolicy(ctx, migrated.Volume, migrated.ReclaimPolicy)
+}
+
+// isMigrationAbortable returns true if the release has not been uninstalled from the source namespace yet.
+func isMigrationAbortable(state *k8sv1.MigrationStatus) bool {
+	return state.Step == migrationStepStarted || state.Step == migrationStepPrepared
+}
+
+// abort cancels a migration whose release is still deployed to the source namespace. The original reclaim policies of
+// the retained volumes are restored and the migration status is removed. Copied secrets are kept in the target
+// namespace. The returned error marks the migration as failed.
+func (cmm *ComponentMigrateManager) abort(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus) error {
+	for _, migrated := range state.Volumes {
+		err := cmm.restoreReclaimPolicy(ctx, migrated)
+		if err != nil {
+			return err
+		}
+	}
+
+	component, err := cmm.storeMigrationState(ctx, component, nil)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to remove migration status of component %q", component.Spec.Name), err}
+	}
+
+	_, err = cmm.componentClient.UpdateStatusInstalled(ctx, component)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to update status-installed for component %q", component.Spec.Name), err}
+	}
+
+	return fmt.Errorf("migration of component %q from namespace %q to %q was aborted because the annotation %s was removed",
+		component.Spec.Name, state.SourceNamespace, state.TargetNamespace, annotations.MigrateNamespaceAnnotation)
+}
+
+// storeMigrationState writes the migration state into the status of the component. A nil state removes it.
+func (cmm *ComponentMigrateManager) storeMigrationState(ctx context.Context, component *k8sv1.Component, state *k8sv1.MigrationStatus) (*k8sv1.Component, error) {
+	var updatedComponent *k8sv1.Component
+	err := retry.OnConflict(func() error {
+		currentComponent, err := cmm.componentClient.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		currentComponent.Status.Migration = state.DeepCopy()
+		updatedComponent, err = cmm.componentClient.UpdateStatus(ctx, currentComponent, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return component, err
+	}
+
+	return updatedComponent, nil
+}
+
+// parseMigrationResources parses a comma-separated list of resources like "secret/credentials,pvc/data".
+func parseMigrationResources(rawResources string) (secrets []string, claims []string, err error) {
+	for _, resource := range strings.Split(rawResources, ",") {
+		resource = strings.TrimSpace(resource)
+		if resource == "" {
+			continue
+		}
+
+		kind, name, found := strings.Cut(resource, "/")
+		if !found || name == "" {
+			return nil, nil, fmt.Errorf("invalid resource %q in annotation %s: expected <kind>/<name>", resource, annotations.MigrateResourcesAnnotation)
+		}
+
+		switch strings.ToLower(kind) {
+		case migrationResourceSecret:
+			secrets = append(secrets, name)
+		case migrationResourcePVC:
+			claims = append(claims, name)
+		default:
+			return nil, nil, fmt.Errorf("unsupported kind %q of resource %q in annotation %s: supported kinds are %s and %s",
+				kind, resource, annotations.MigrateResourcesAnnotation, migrationResourceSecret, migrationResourcePVC)
+		}
+	}
+
+	return secrets, claims, nil
+}
+
+// setHelmOwnership marks the resource as owned by the given release so that helm takes it over instead of failing
+// because the resource already exists.
+func setHelmOwnership(objectMeta *metav1.ObjectMeta, releaseName string, releaseNamespace string) {
+	if objectMeta.Labels == nil {
+		objectMeta.Labels = map[string]string{}
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = map[string]string{}
+	}
+
+	objectMeta.Labels[helmManagedByLabel] = "Helm"
+	objectMeta.Annotations[helmReleaseNameAnnotation] = releaseName
+	objectMeta.Annotations[helmReleaseNamespaceAnnotation] = releaseNamespace
+}
+
+func getDeployNamespace(component *k8sv1.Component) string {
+	if component.Spec.DeployNamespace != "" {
+		return component.Spec.DeployNamespace
+	}
+
+	return component.Namespace
+}
@@ -0,0 +1,622 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+)
+
+func getMigratingComponent(state *k8sv1.MigrationStatus) *k8sv1.Component {
+	component := getComponent("ecosystem", "k8s", "longhorn-system", "dogu-op", "")
+	component.Annotations = map[string]string{annotations.MigrateNamespaceAnnotation: "true"}
+	component.Status = k8sv1.ComponentStatus{Status: k8sv1.ComponentStatusInstalled, InstalledVersion: "0.1.0"}
+
+	if state != nil {
+		component.Status.Migration = state
+		component.Status.Status = k8sv1.ComponentStatusUpgrading
+	}
+
+	return component
+}
+
+// expectStoredComponent lets the component client mock behave like the api server for Get, Update and UpdateStatus.
+// Update is optional because only finished migrations update the resource.
+func expectStoredComponent(componentClientMock *mockComponentInterface, component *k8sv1.Component) *k8sv1.Component {
+	stored := component.DeepCopy()
+	componentClientMock.EXPECT().Get(mock.Anything, "dogu-op", metav1.GetOptions{}).RunAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions) (*k8sv1.Component, error) {
+		return stored.DeepCopy(), nil
+	})
+	componentClientMock.EXPECT().Update(mock.Anything, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, c *k8sv1.Component, _ metav1.UpdateOptions) (*k8sv1.Component, error) {
+		// the update of the resource ignores the status
+		status := stored.Status
+		*stored = *c.DeepCopy()
+		stored.Status = status
+		return stored.DeepCopy(), nil
+	}).Maybe()
+	componentClientMock.EXPECT().UpdateStatus(mock.Anything, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, c *k8sv1.Component, _ metav1.UpdateOptions) (*k8sv1.Component, error) {
+		stored.Status = c.DeepCopy().Status
+		return stored.DeepCopy(), nil
+	})
+
+	return stored
+}
+
+func expectMigrationInstall(t *testing.T, helmClientMock *mockHelmClient, componentClientMock *mockComponentInterface, healthManagerMock *mockHealthManager, readerMock *mockConfigMapRefReader) {
+	readerMock.EXPECT().GetValues(ctxWithoutCancel, mock.Anything).Return("", nil)
+	helmClientMock.EXPECT().InstallOrUpgrade(ctxWithoutCancel, mock.Anything).RunAndReturn(func(_ context.Context, spec *client.ChartSpec) error {
+		assert.Equal(t, "dogu-op", spec.ReleaseName)
+		assert.Equal(t, "k8s/dogu-op", spec.ChartName)
+		assert.Equal(t, "longhorn-system", spec.Namespace)
+		assert.Equal(t, "0.1.0", spec.Version)
+		return nil
+	})
+	componentClientMock.EXPECT().UpdateStatusInstalled(ctxWithoutCancel, mock.Anything).RunAndReturn(func(_ context.Context, c *k8sv1.Component) (*k8sv1.Component, error) {
+		return c, nil
+	})
+	healthManagerMock.EXPECT().UpdateComponentHealthWithInstalledVersion(ctxWithoutCancel, "dogu-op", "ecosystem", "0.1.0").Return(nil)
+}
+
+func TestNewComponentMigrateManager(t *testing.T) {
+	// when
+	sut := NewComponentMigrateManager(nil, nil, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+	// then
+	require.NotNil(t, sut)
+}
+
+func TestComponentMigrateManager_Migrate(t *testing.T) {
+	t.Run("should migrate component without resources", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+
+		componentClientMock := newMockComponentInterface(t)
+		stored := expectStoredComponent(componentClientMock, component)
+		componentClientMock.EXPECT().UpdateStatusUpgrading(testCtx, mock.Anything).RunAndReturn(func(_ context.Context, c *k8sv1.Component) (*k8sv1.Component, error) {
+			c.Status.Status = k8sv1.ComponentStatusUpgrading
+			return c, nil
+		})
+
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(&release.Release{Name: "dogu-op", Namespace: "ecosystem"}, nil)
+		helmClientMock.EXPECT().Uninstall("dogu-op").Return(nil)
+
+		healthManagerMock := newMockHealthManager(t)
+		readerMock := newMockConfigMapRefReader(t)
+		expectMigrationInstall(t, helmClientMock, componentClientMock, healthManagerMock, readerMock)
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migrating from namespace %q to %q.", "ecosystem", "longhorn-system")
+		for _, step := range []string{migrationStepPrepared, migrationStepUninstalled, migrationStepTransferred, migrationStepInstalled} {
+			recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migration step %s finished.", step).Once()
+		}
+		recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migrated from namespace %q to %q.", "ecosystem", "longhorn-system")
+
+		sut := NewComponentMigrateManager(componentClientMock, nil, helmClientMock, healthManagerMock, recorderMock, defaultHelmClientTimeoutMins, readerMock)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, stored.Status.Migration)
+		assert.NotContains(t, stored.Annotations, annotations.MigrateNamespaceAnnotation)
+	})
+
+	t.Run("should resume migration and transfer volumes", func(t *testing.T) {
+		// given
+		claimSpec := corev1.PersistentVolumeClaimSpec{VolumeName: "pv-data", StorageClassName: ptr("longhorn")}
+		component := getMigratingComponent(&k8sv1.MigrationStatus{
+			SourceNamespace: "ecosystem",
+			TargetNamespace: "longhorn-system",
+			Step:            migrationStepUninstalled,
+			Volumes:         []k8sv1.MigratedVolume{{Claim: "data", Volume: "pv-data", ReclaimPolicy: corev1.PersistentVolumeReclaimDelete, Spec: claimSpec}},
+		})
+
+		componentClientMock := newMockComponentInterface(t)
+		stored := expectStoredComponent(componentClientMock, component)
+
+		volume := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}, Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain}}
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(ctxWithoutCancel, "pv-data", metav1.GetOptions{}).RunAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions) (*corev1.PersistentVolume, error) {
+			return volume.DeepCopy(), nil
+		})
+		volumeMock.EXPECT().Update(ctxWithoutCancel, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, pv *corev1.PersistentVolume, _ metav1.UpdateOptions) (*corev1.PersistentVolume, error) {
+			volume = pv
+			return pv, nil
+		})
+
+		sourceClaimMock := newMockPersistentVolumeClaimInterface(t)
+		sourceClaimMock.EXPECT().Get(ctxWithoutCancel, "data", metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumeclaims"}, "data"))
+		targetClaimMock := newMockPersistentVolumeClaimInterface(t)
+		targetClaimMock.EXPECT().Create(ctxWithoutCancel, mock.Anything, metav1.CreateOptions{}).RunAndReturn(func(_ context.Context, claim *corev1.PersistentVolumeClaim, _ metav1.CreateOptions) (*corev1.PersistentVolumeClaim, error) {
+			assert.Equal(t, "data", claim.Name)
+			assert.Equal(t, "longhorn-system", claim.Namespace)
+			assert.Equal(t, "pv-data", claim.Spec.VolumeName)
+			assert.Equal(t, "Helm", claim.Labels[helmManagedByLabel])
+			assert.Equal(t, "dogu-op", claim.Annotations[helmReleaseNameAnnotation])
+			assert.Equal(t, "longhorn-system", claim.Annotations[helmReleaseNamespaceAnnotation])
+			return claim, nil
+		})
+
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+		coreV1Mock.EXPECT().PersistentVolumeClaims("ecosystem").Return(sourceClaimMock)
+		coreV1Mock.EXPECT().PersistentVolumeClaims("longhorn-system").Return(targetClaimMock)
+
+		helmClientMock := newMockHelmClient(t)
+		healthManagerMock := newMockHealthManager(t)
+		readerMock := newMockConfigMapRefReader(t)
+		expectMigrationInstall(t, helmClientMock, componentClientMock, healthManagerMock, readerMock)
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migration step %s finished.", migrationStepTransferred).Once()
+		recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migration step %s finished.", migrationStepInstalled).Once()
+		recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migrated from namespace %q to %q.", "ecosystem", "longhorn-system")
+
+		sut := NewComponentMigrateManager(componentClientMock, coreV1Mock, helmClientMock, healthManagerMock, recorderMock, defaultHelmClientTimeoutMins, readerMock)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, volume.Spec.ClaimRef)
+		assert.Equal(t, "longhorn-system", volume.Spec.ClaimRef.Namespace)
+		assert.Equal(t, "data", volume.Spec.ClaimRef.Name)
+		assert.Equal(t, corev1.PersistentVolumeReclaimDelete, volume.Spec.PersistentVolumeReclaimPolicy)
+		assert.Nil(t, stored.Status.Migration)
+	})
+
+	t.Run("should abort migration if the annotation is removed before the uninstallation", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(&k8sv1.MigrationStatus{
+			SourceNamespace: "ecosystem",
+			TargetNamespace: "longhorn-system",
+			Step:            migrationStepPrepared,
+			Volumes:         []k8sv1.MigratedVolume{{Claim: "data", Volume: "pv-data", ReclaimPolicy: corev1.PersistentVolumeReclaimDelete}},
+		})
+		delete(component.Annotations, annotations.MigrateNamespaceAnnotation)
+
+		componentClientMock := newMockComponentInterface(t)
+		stored := expectStoredComponent(componentClientMock, component)
+		componentClientMock.EXPECT().UpdateStatusInstalled(ctxWithoutCancel, mock.Anything).RunAndReturn(func(_ context.Context, c *k8sv1.Component) (*k8sv1.Component, error) {
+			stored.Status.Status = k8sv1.ComponentStatusInstalled
+			return stored.DeepCopy(), nil
+		})
+
+		volume := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}, Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain}}
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(ctxWithoutCancel, "pv-data", metav1.GetOptions{}).Return(volume.DeepCopy(), nil)
+		volumeMock.EXPECT().Update(ctxWithoutCancel, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, pv *corev1.PersistentVolume, _ metav1.UpdateOptions) (*corev1.PersistentVolume, error) {
+			volume = pv
+			return pv, nil
+		})
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+
+		sut := NewComponentMigrateManager(componentClientMock, coreV1Mock, newMockHelmClient(t), newMockHealthManager(t), newMockEventRecorder(t), defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "migration of component \"dogu-op\" from namespace \"ecosystem\" to \"longhorn-system\" was aborted because the annotation k8s.cloudogu.com/migrate-namespace was removed")
+		var requeueableErr *genericRequeueableError
+		assert.False(t, errors.As(err, &requeueableErr))
+		assert.Equal(t, corev1.PersistentVolumeReclaimDelete, volume.Spec.PersistentVolumeReclaimPolicy)
+		assert.Nil(t, stored.Status.Migration)
+		assert.Equal(t, k8sv1.ComponentStatusInstalled, stored.Status.Status)
+	})
+
+	t.Run("should continue migration if the annotation is removed after the uninstallation", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(&k8sv1.MigrationStatus{SourceNamespace: "ecosystem", TargetNamespace: "longhorn-system", Step: migrationStepInstalled})
+		delete(component.Annotations, annotations.MigrateNamespaceAnnotation)
+
+		componentClientMock := newMockComponentInterface(t)
+		stored := expectStoredComponent(componentClientMock, component)
+		componentClientMock.EXPECT().UpdateStatusInstalled(ctxWithoutCancel, mock.Anything).RunAndReturn(func(_ context.Context, c *k8sv1.Component) (*k8sv1.Component, error) {
+			return c, nil
+		})
+		healthManagerMock := newMockHealthManager(t)
+		healthManagerMock.EXPECT().UpdateComponentHealthWithInstalledVersion(ctxWithoutCancel, "dogu-op", "ecosystem", "0.1.0").Return(nil)
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeWarning, MigrationEventReason, "Migration cannot be aborted because the release was already uninstalled from namespace %q.", "ecosystem")
+		recorderMock.EXPECT().Eventf(mock.Anything, corev1.EventTypeNormal, MigrationEventReason, "Migrated from namespace %q to %q.", "ecosystem", "longhorn-system")
+
+		sut := NewComponentMigrateManager(componentClientMock, nil, newMockHelmClient(t), healthManagerMock, recorderMock, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, stored.Status.Migration)
+	})
+
+	t.Run("should wait for deletion of the source claim", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(&k8sv1.MigrationStatus{
+			SourceNamespace: "ecosystem",
+			TargetNamespace: "longhorn-system",
+			Step:            migrationStepUninstalled,
+			Volumes:         []k8sv1.MigratedVolume{{Claim: "data", Volume: "pv-data"}},
+		})
+
+		sourceClaimMock := newMockPersistentVolumeClaimInterface(t)
+		sourceClaimMock.EXPECT().Get(ctxWithoutCancel, "data", metav1.GetOptions{}).Return(&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data"}}, nil)
+		sourceClaimMock.EXPECT().Delete(ctxWithoutCancel, "data", metav1.DeleteOptions{}).Return(nil)
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().PersistentVolumeClaims("ecosystem").Return(sourceClaimMock)
+
+		sut := NewComponentMigrateManager(newMockComponentInterface(t), coreV1Mock, newMockHelmClient(t), newMockHealthManager(t), newMockEventRecorder(t), defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errClaimNotDeleted)
+		assert.ErrorContains(t, err, "waiting for deletion of persistent volume claim \"data\" in namespace \"ecosystem\"")
+		var requeueableErr *genericRequeueableError
+		assert.ErrorAs(t, err, &requeueableErr)
+	})
+
+	t.Run("should ignore missing release on uninstall", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(&k8sv1.MigrationStatus{SourceNamespace: "ecosystem", TargetNamespace: "longhorn-system", Step: migrationStepPrepared})
+
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(ctxWithoutCancel, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(ctxWithoutCancel, mock.Anything, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().Uninstall("dogu-op").Return(driver.ErrReleaseNotFound)
+
+		sut := NewComponentMigrateManager(componentClientMock, nil, helmClientMock, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to store migration step Uninstalled of component \"dogu-op\"")
+	})
+
+	t.Run("should fail if component is already deployed to the target namespace", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(&release.Release{Name: "dogu-op", Namespace: "longhorn-system"}, nil)
+
+		sut := NewComponentMigrateManager(nil, nil, helmClientMock, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "component \"dogu-op\" is already deployed to namespace \"longhorn-system\"")
+	})
+
+	t.Run("should fail to get release", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, assert.AnError)
+
+		sut := NewComponentMigrateManager(nil, nil, helmClientMock, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get release to migrate for component dogu-op")
+	})
+
+	t.Run("should fail on unknown migration step", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(&k8sv1.MigrationStatus{SourceNamespace: "ecosystem", TargetNamespace: "longhorn-system", Step: "Unknown"})
+
+		sut := NewComponentMigrateManager(nil, nil, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.Migrate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "unknown migration step \"Unknown\" of component \"dogu-op\"")
+	})
+}
+
+func TestComponentMigrateManager_prepare(t *testing.T) {
+	state := func() *k8sv1.MigrationStatus {
+		return &k8sv1.MigrationStatus{SourceNamespace: "ecosystem", TargetNamespace: "longhorn-system", Step: migrationStepStarted}
+	}
+
+	t.Run("should do nothing without resources", func(t *testing.T) {
+		// given
+		sut := NewComponentMigrateManager(nil, nil, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.prepare(testCtx, getMigratingComponent(nil), state())
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should copy secrets and retain volumes", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		component.Annotations[annotations.MigrateResourcesAnnotation] = "secret/credentials, pvc/data"
+		actualState := state()
+
+		namespaceMock := newMockNamespaceInterface(t)
+		namespaceMock.EXPECT().Create(testCtx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "longhorn-system"}}, metav1.CreateOptions{}).
+			Return(nil, k8serrors.NewAlreadyExists(schema.GroupResource{Resource: "namespaces"}, "longhorn-system"))
+
+		sourceSecretMock := newMockSecretInterface(t)
+		sourceSecretMock.EXPECT().Get(testCtx, "credentials", metav1.GetOptions{}).Return(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "ecosystem", Labels: map[string]string{"app": "dogu-op"}},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"password": []byte("secret")},
+		}, nil)
+		targetSecretMock := newMockSecretInterface(t)
+		targetSecretMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).RunAndReturn(func(_ context.Context, secret *corev1.Secret, _ metav1.CreateOptions) (*corev1.Secret, error) {
+			assert.Equal(t, "credentials", secret.Name)
+			assert.Equal(t, "longhorn-system", secret.Namespace)
+			assert.Equal(t, []byte("secret"), secret.Data["password"])
+			assert.Equal(t, map[string]string{"app": "dogu-op", helmManagedByLabel: "Helm"}, secret.Labels)
+			assert.Equal(t, "dogu-op", secret.Annotations[helmReleaseNameAnnotation])
+			return secret, nil
+		})
+
+		claimMock := newMockPersistentVolumeClaimInterface(t)
+		claimMock.EXPECT().Get(testCtx, "data", metav1.GetOptions{}).Return(&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Labels: map[string]string{"app": "dogu-op"}},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-data"},
+		}, nil)
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(testCtx, "pv-data", metav1.GetOptions{}).Return(&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-data"},
+			Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+		}, nil)
+		volumeMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, pv *corev1.PersistentVolume, _ metav1.UpdateOptions) (*corev1.PersistentVolume, error) {
+			assert.Equal(t, corev1.PersistentVolumeReclaimRetain, pv.Spec.PersistentVolumeReclaimPolicy)
+			return pv, nil
+		})
+
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().Namespaces().Return(namespaceMock)
+		coreV1Mock.EXPECT().Secrets("ecosystem").Return(sourceSecretMock)
+		coreV1Mock.EXPECT().Secrets("longhorn-system").Return(targetSecretMock)
+		coreV1Mock.EXPECT().PersistentVolumeClaims("ecosystem").Return(claimMock)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+
+		componentClientMock := newMockComponentInterface(t)
+		stored := expectStoredComponent(componentClientMock, component)
+
+		sut := NewComponentMigrateManager(componentClientMock, coreV1Mock, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.prepare(testCtx, component, actualState)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, actualState.Volumes, 1)
+		assert.Equal(t, "data", actualState.Volumes[0].Claim)
+		assert.Equal(t, "pv-data", actualState.Volumes[0].Volume)
+		assert.Equal(t, corev1.PersistentVolumeReclaimDelete, actualState.Volumes[0].ReclaimPolicy)
+		assert.Equal(t, map[string]string{"app": "dogu-op"}, actualState.Volumes[0].Labels)
+		require.NotNil(t, stored.Status.Migration)
+		assert.Equal(t, actualState.Volumes, stored.Status.Migration.Volumes)
+	})
+
+	t.Run("should keep recorded volumes on retry", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		component.Annotations[annotations.MigrateResourcesAnnotation] = "pvc/data"
+		actualState := state()
+		actualState.Volumes = []k8sv1.MigratedVolume{{Claim: "data", Volume: "pv-data", ReclaimPolicy: corev1.PersistentVolumeReclaimDelete}}
+
+		namespaceMock := newMockNamespaceInterface(t)
+		namespaceMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).Return(nil, nil)
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(testCtx, "pv-data", metav1.GetOptions{}).Return(&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-data"},
+			Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain},
+		}, nil)
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().Namespaces().Return(namespaceMock)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+
+		sut := NewComponentMigrateManager(nil, coreV1Mock, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.prepare(testCtx, component, actualState)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []k8sv1.MigratedVolume{{Claim: "data", Volume: "pv-data", ReclaimPolicy: corev1.PersistentVolumeReclaimDelete}}, actualState.Volumes)
+	})
+
+	t.Run("should fail to store volume before retaining it", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		component.Annotations[annotations.MigrateResourcesAnnotation] = "pvc/data"
+
+		namespaceMock := newMockNamespaceInterface(t)
+		namespaceMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).Return(nil, nil)
+		claimMock := newMockPersistentVolumeClaimInterface(t)
+		claimMock.EXPECT().Get(testCtx, "data", metav1.GetOptions{}).Return(&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data"},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-data"},
+		}, nil)
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(testCtx, "pv-data", metav1.GetOptions{}).Return(&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}}, nil)
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().Namespaces().Return(namespaceMock)
+		coreV1Mock.EXPECT().PersistentVolumeClaims("ecosystem").Return(claimMock)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(nil, assert.AnError)
+
+		sut := NewComponentMigrateManager(componentClientMock, coreV1Mock, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.prepare(testCtx, component, state())
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to store persistent volume \"pv-data\" of component \"dogu-op\"")
+	})
+
+	t.Run("should fail for unbound claim", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		component.Annotations[annotations.MigrateResourcesAnnotation] = "pvc/data"
+
+		namespaceMock := newMockNamespaceInterface(t)
+		namespaceMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).Return(nil, nil)
+		claimMock := newMockPersistentVolumeClaimInterface(t)
+		claimMock.EXPECT().Get(testCtx, "data", metav1.GetOptions{}).Return(&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data"}}, nil)
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().Namespaces().Return(namespaceMock)
+		coreV1Mock.EXPECT().PersistentVolumeClaims("ecosystem").Return(claimMock)
+
+		sut := NewComponentMigrateManager(nil, coreV1Mock, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.prepare(testCtx, component, state())
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "persistent volume claim \"data\" in namespace \"ecosystem\" is not bound")
+	})
+
+	t.Run("should fail to create namespace", func(t *testing.T) {
+		// given
+		component := getMigratingComponent(nil)
+		component.Annotations[annotations.MigrateResourcesAnnotation] = "secret/credentials"
+
+		namespaceMock := newMockNamespaceInterface(t)
+		namespaceMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).Return(nil, assert.AnError)
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().Namespaces().Return(namespaceMock)
+
+		sut := NewComponentMigrateManager(nil, coreV1Mock, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+
+		// when
+		err := sut.prepare(testCtx, component, state())
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to create target namespace \"longhorn-system\"")
+	})
+}
+
+func TestComponentMigrateManager_reserveVolume(t *testing.T) {
+	t.Run("should retry on conflict", func(t *testing.T) {
+		// given
+		volume := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}}
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(testCtx, "pv-data", metav1.GetOptions{}).RunAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions) (*corev1.PersistentVolume, error) {
+			return volume.DeepCopy(), nil
+		}).Twice()
+		volumeMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).
+			Return(nil, k8serrors.NewConflict(schema.GroupResource{Resource: "persistentvolumes"}, "pv-data", assert.AnError)).Once()
+		volumeMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, pv *corev1.PersistentVolume, _ metav1.UpdateOptions) (*corev1.PersistentVolume, error) {
+			volume = pv
+			return pv, nil
+		}).Once()
+
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+
+		sut := &ComponentMigrateManager{coreV1Client: coreV1Mock}
+
+		// when
+		err := sut.reserveVolume(testCtx, "pv-data", "longhorn-system", "data")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, volume.Spec.ClaimRef)
+		assert.Equal(t, "longhorn-system", volume.Spec.ClaimRef.Namespace)
+		assert.Equal(t, "data", volume.Spec.ClaimRef.Name)
+	})
+	t.Run("should fail to get volume", func(t *testing.T) {
+		// given
+		volumeMock := newMockPersistentVolumeInterface(t)
+		volumeMock.EXPECT().Get(testCtx, "pv-data", metav1.GetOptions{}).Return(nil, assert.AnError)
+
+		coreV1Mock := newMockCoreV1Interface(t)
+		coreV1Mock.EXPECT().PersistentVolumes().Return(volumeMock)
+
+		sut := &ComponentMigrateManager{coreV1Client: coreV1Mock}
+
+		// when
+		err := sut.reserveVolume(testCtx, "pv-data", "longhorn-system", "data")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to reserve persistent volume \"pv-data\" for claim \"data\" in namespace \"longhorn-system\"")
+	})
+}
+
+func Test_parseMigrationResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		resources   string
+		wantSecrets []string
+		wantClaims  []string
+		wantErr     string
+	}{
+		{name: "empty", resources: ""},
+		{name: "secrets and claims", resources: "secret/a, PVC/b,,secret/c", wantSecrets: []string{"a", "c"}, wantClaims: []string{"b"}},
+		{name: "missing name", resources: "secret/", wantErr: "invalid resource \"secret/\""},
+		{name: "missing kind", resources: "credentials", wantErr: "invalid resource \"credentials\""},
+		{name: "unsupported kind", resources: "configmap/values", wantErr: "unsupported kind \"configmap\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			secrets, claims, err := parseMigrationResources(tt.resources)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSecrets, secrets)
+			assert.Equal(t, tt.wantClaims, claims)
+		})
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
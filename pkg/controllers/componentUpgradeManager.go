@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
+	"github.com/cloudogu/retry-lib/retry"
 	"github.com/go-errors/errors"
 	helmRelease "helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
@@ -15,6 +17,7 @@ import (
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -27,17 +30,25 @@ type ComponentUpgradeManager struct {
 	recorder        record.EventRecorder
 	timeout         time.Duration
 	reader          configMapRefReader
+	// verificationTimeout is the maximum time to wait for the upgraded component to become available. The
+	// verification is skipped if it is not positive.
+	verificationTimeout time.Duration
 }
 
+// upgradeVerificationInterval is the interval in which the availability of an upgraded component is checked by
+// requeueing the component.
+const upgradeVerificationInterval = 5 * time.Second
+
 // NewComponentUpgradeManager creates a new instance of ComponentUpgradeManager.
-func NewComponentUpgradeManager(componentClient componentInterface, helmClient helmClient, healthManager healthManager, recorder record.EventRecorder, timeout time.Duration, reader configMapRefReader) *ComponentUpgradeManager {
+func NewComponentUpgradeManager(componentClient componentInterface, helmClient helmClient, healthManager healthManager, recorder record.EventRecorder, timeout time.Duration, reader configMapRefReader, verificationTimeout time.Duration) *ComponentUpgradeManager {
 	return &ComponentUpgradeManager{
-		componentClient: componentClient,
-		helmClient:      helmClient,
-		healthManager:   healthManager,
-		recorder:        recorder,
-		timeout:         timeout,
-		reader:          reader,
+		componentClient:     componentClient,
+		helmClient:          helmClient,
+		healthManager:       healthManager,
+		recorder:            recorder,
+		timeout:             timeout,
+		reader:              reader,
+		verificationTimeout: verificationTimeout,
 	}
 }
 
@@ -51,7 +62,16 @@ func (cupm *ComponentUpgradeManager) Upgrade(ctx context.Context, component *k8s
 		return fmt.Errorf("failed to get component version: %w", err)
 	}
 
-	chartSpec, err := helm.GetHelmChartSpec(ctx, component, helm.HelmChartCreationOpts{
+	// create a new context that does not get canceled immediately on SIGTERM
+	// this allows self-upgrades
+	helmCtx := context.WithoutCancel(ctx)
+
+	if isVerifyingUpgrade(component, version) {
+		logger.Info(fmt.Sprintf("Continuing verification of version %s of component %s...", version, component.Spec.Name))
+		return cupm.finishUpgrade(helmCtx, component, version)
+	}
+
+	chartSpec, err := helm.GetHelmChartSpec(ctx, withVersion(component, version), helm.HelmChartCreationOpts{
 		HelmClient:     cupm.helmClient,
 		Timeout:        cupm.timeout,
 		YamlSerializer: yaml.NewSerializer(),
@@ -67,6 +87,11 @@ func (cupm *ComponentUpgradeManager) Upgrade(ctx context.Context, component *k8s
 		return &genericRequeueableError{errMsg: "failed to check dependencies", err: err}
 	}
 
+	err = checkDependents(ctx, cupm.helmClient, cupm.recorder, component, chartSpec.Version, UpgradeEventReason)
+	if err != nil {
+		return err
+	}
+
 	if component.Status.Status != k8sv1.ComponentStatusUpgrading {
 		component, err = cupm.componentClient.UpdateStatusUpgrading(ctx, component)
 		if err != nil {
@@ -76,32 +101,290 @@ func (cupm *ComponentUpgradeManager) Upgrade(ctx context.Context, component *k8s
 
 	logger.Info("Upgrade helm chart...")
 
-	// create a new context that does not get canceled immediately on SIGTERM
-	// this allows self-upgrades
-	helmCtx := context.WithoutCancel(ctx)
-
 	release, err := cupm.helmClient.GetRelease(component.Spec.Name)
 
 	if err := cupm.handleHelmRelease(helmCtx, component, chartSpec, release, err); err != nil {
 		return err
 	}
 
-	component, err = cupm.componentClient.UpdateStatusInstalled(helmCtx, component)
+	if cupm.verificationTimeout > 0 {
+		component, err = cupm.startVerification(helmCtx, component, version)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cupm.finishUpgrade(helmCtx, component, version)
+}
+
+// finishUpgrade marks the component as installed with the given version as soon as the verification of the upgrade
+// succeeded.
+func (cupm *ComponentUpgradeManager) finishUpgrade(ctx context.Context, component *k8sv1.Component, version string) error {
+	component, err := cupm.verifyUpgrade(ctx, component, version)
+	if err != nil {
+		return err
+	}
+
+	component, err = cupm.componentClient.UpdateStatusInstalled(ctx, component)
 	if err != nil {
 		return &genericRequeueableError{errMsg: fmt.Sprintf("failed to update status-installed for component %s", component.Spec.Name), err: err}
 	}
 
-	err = cupm.healthManager.UpdateComponentHealthWithInstalledVersion(helmCtx, component.Spec.Name, component.Namespace, version)
+	err = cupm.healthManager.UpdateComponentHealthWithInstalledVersion(ctx, component.Spec.Name, component.Namespace, version)
 	if err != nil {
 		return fmt.Errorf("failed to update health status for component %q: %w", component.Spec.Name, err)
 	}
 
-	logger.Info(fmt.Sprintf("Upgraded component %s.", component.Spec.Name))
+	log.FromContext(ctx).Info(fmt.Sprintf("Upgraded component %s.", component.Spec.Name))
 
 	return nil
 }
 
-// updateComponentVersion updates the component version in the component CR with the latest version
+// upgradeVerificationPendingError indicates that the upgraded component is not available yet. The verification is
+// continued by a later reconciliation, so that the reconciler is not blocked while waiting.
+type upgradeVerificationPendingError struct {
+	component string
+	version   string
+	deadline  time.Time
+}
+
+// Error returns the string representation of the pending verification.
+func (e *upgradeVerificationPendingError) Error() string {
+	return fmt.Sprintf("waiting until %s for component %s to become available in version %s", e.deadline.Format(time.RFC3339), e.component, e.version)
+}
+
+func isUpgradeVerificationPending(err error) bool {
+	var pendingErr *upgradeVerificationPendingError
+	return errors.As(err, &pendingErr)
+}
+
+// upgradeRolledBackError indicates that an upgrade was rolled back because the component did not become available.
+// The upgrade is not requeued.
+type upgradeRolledBackError struct {
+	component string
+	version   string
+	revision  int
+	err       error
+}
+
+// Error returns the string representation of the rolled back upgrade.
+func (e *upgradeRolledBackError) Error() string {
+	return fmt.Sprintf("upgrade of component %s to version %s was rolled back to helm revision %d: %s", e.component, e.version, e.revision, e.err.Error())
+}
+
+// Unwrap returns the error of the failed verification.
+func (e *upgradeRolledBackError) Unwrap() error {
+	return e.err
+}
+
+func isUpgradeRolledBack(err error) bool {
+	var rolledBackErr *upgradeRolledBackError
+	return errors.As(err, &rolledBackErr)
+}
+
+// isUpgradeVerificationRunning checks if the component waits for an upgraded version to become available.
+func isUpgradeVerificationRunning(component *k8sv1.Component) bool {
+	verification := component.Status.UpgradeVerification
+	return verification != nil && !verification.RolledBack
+}
+
+// isVerifyingUpgrade checks if the upgrade of the component to the given version was already performed and only waits
+// for the component to become available.
+func isVerifyingUpgrade(component *k8sv1.Component, version string) bool {
+	return isUpgradeVerificationRunning(component) && component.Status.Status != k8sv1.ComponentStatusInstalled &&
+		component.Status.UpgradeVerification.Version == version
+}
+
+// isRejectedVersion checks if an upgrade of the component to the given version was rolled back because the version did
+// not become available. The version is rejected until the spec of the component changes.
+func isRejectedVersion(component *k8sv1.Component, version string) bool {
+	verification := component.Status.UpgradeVerification
+	return verification != nil && verification.RolledBack && verification.Version == version &&
+		verification.ObservedGeneration == component.Generation
+}
+
+// startVerification records the start of the verification of the upgraded component in its status.
+func (cupm *ComponentUpgradeManager) startVerification(ctx context.Context, component *k8sv1.Component, version string) (*k8sv1.Component, error) {
+	updatedComponent, err := cupm.updateStatus(ctx, component, func(status *k8sv1.ComponentStatus) {
+		status.UpgradeVerification = &k8sv1.UpgradeVerificationStatus{
+			Version:            version,
+			StartedAt:          metav1.Now(),
+			ObservedGeneration: component.Generation,
+		}
+	})
+	if err != nil {
+		return nil, &genericRequeueableError{fmt.Sprintf("failed to start verification of component %s", component.Spec.Name), err}
+	}
+
+	cupm.recorder.Eventf(updatedComponent, corev1.EventTypeNormal, UpgradeEventReason, "Verifying that version %s becomes available within %s.", version, cupm.verificationTimeout)
+	return updatedComponent, nil
+}
+
+// verifyUpgrade checks once if all applications of the upgraded component are available. While the verification
+// timeout is not exceeded, an upgradeVerificationPendingError is returned so that the check is repeated by a later
+// reconciliation. Afterwards, the release is rolled back to the previous revision and the upgrade fails without being
+// requeued. Components without a running verification are not verified.
+func (cupm *ComponentUpgradeManager) verifyUpgrade(ctx context.Context, component *k8sv1.Component, version string) (*k8sv1.Component, error) {
+	if component.Status.UpgradeVerification == nil {
+		return component, nil
+	}
+	if !isUpgradeVerificationRunning(component) {
+		// the record of a rolled back upgrade is obsolete after another version was upgraded successfully
+		return cupm.finishVerification(ctx, component)
+	}
+
+	deployNamespace := component.Spec.DeployNamespace
+	if deployNamespace == "" {
+		deployNamespace = component.Namespace
+	}
+
+	available, availabilityErr := cupm.healthManager.IsComponentAvailable(ctx, component.Spec.Name, deployNamespace)
+	if availabilityErr == nil && available {
+		return cupm.finishVerification(ctx, component)
+	}
+	if availabilityErr != nil {
+		log.FromContext(ctx).Error(availabilityErr, fmt.Sprintf("failed to check availability of component %s", component.Spec.Name))
+	}
+
+	deadline := component.Status.UpgradeVerification.StartedAt.Add(cupm.verificationTimeout)
+	if time.Now().Before(deadline) {
+		return nil, &upgradeVerificationPendingError{component: component.Spec.Name, version: version, deadline: deadline}
+	}
+
+	verificationErr := errors.Join(fmt.Errorf("component did not become available within %s", cupm.verificationTimeout), availabilityErr)
+	cupm.recorder.Eventf(component, corev1.EventTypeWarning, UpgradeEventReason, "Verification of version %s failed: %s", version, verificationErr.Error())
+
+	return nil, cupm.rollbackUpgrade(ctx, component, version, verificationErr)
+}
+
+// finishVerification removes the verification from the status of the component.
+func (cupm *ComponentUpgradeManager) finishVerification(ctx context.Context, component *k8sv1.Component) (*k8sv1.Component, error) {
+	updatedComponent, err := cupm.updateStatus(ctx, component, func(status *k8sv1.ComponentStatus) {
+		status.UpgradeVerification = nil
+	})
+	if err != nil {
+		return nil, &genericRequeueableError{fmt.Sprintf("failed to finish verification of component %s", component.Spec.Name), err}
+	}
+
+	return updatedComponent, nil
+}
+
+// rollbackUpgrade rolls the release back to the revision before the failed upgrade. The status of the component is
+// set to tryToUpgrade, so that the installed version still refers to the previous version. The version is marked as
+// rolled back, so that it is not upgraded to again until the spec of the component changes.
+func (cupm *ComponentUpgradeManager) rollbackUpgrade(ctx context.Context, component *k8sv1.Component, version string, verificationErr error) error {
+	logger := log.FromContext(ctx)
+
+	revision, err := cupm.findPreviousRevision(component)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to find previous helm revision of component %s", component.Spec.Name), errors.Join(verificationErr, err)}
+	}
+	if revision == 0 {
+		return &genericRequeueableError{fmt.Sprintf("failed to verify upgrade of component %s without previous helm revision to roll back to", component.Spec.Name), verificationErr}
+	}
+
+	deployNamespace := component.Spec.DeployNamespace
+	if deployNamespace == "" {
+		deployNamespace = component.Namespace
+	}
+
+	logger.Info(fmt.Sprintf("Rolling back component %s to helm revision %d", component.Spec.Name, revision))
+	rollbackSpec := &client.ChartSpec{ReleaseName: component.Spec.Name, Namespace: deployNamespace, Timeout: cupm.timeout}
+	err = cupm.helmClient.RollbackRelease(rollbackSpec, revision)
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to roll back component %s to helm revision %d", component.Spec.Name, revision), errors.Join(verificationErr, err)}
+	}
+	cupm.recorder.Eventf(component, corev1.EventTypeWarning, UpgradeEventReason, "Rolled back to helm revision %d.", revision)
+
+	rejectedVersion := &k8sv1.UpgradeVerificationStatus{
+		Version:            version,
+		StartedAt:          component.Status.UpgradeVerification.StartedAt,
+		RolledBack:         true,
+		ObservedGeneration: component.Generation,
+	}
+	_, err = cupm.updateStatus(ctx, component, func(status *k8sv1.ComponentStatus) {
+		status.Status = k8sv1.ComponentStatusTryToUpgrade
+		status.UpgradeVerification = rejectedVersion
+	})
+	if err != nil {
+		return &genericRequeueableError{fmt.Sprintf("failed to update status-tryToUpgrade for component %s", component.Spec.Name), err}
+	}
+
+	err = cupm.healthManager.UpdateComponentHealth(ctx, component.Spec.Name, component.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to update health status for component %q: %w", component.Spec.Name, err)
+	}
+
+	return &upgradeRolledBackError{component: component.Spec.Name, version: version, revision: revision, err: verificationErr}
+}
+
+// updateStatus applies the status changes to the current resource version of the component.
+func (cupm *ComponentUpgradeManager) updateStatus(ctx context.Context, component *k8sv1.Component, changeStatus func(*k8sv1.ComponentStatus)) (*k8sv1.Component, error) {
+	var updatedComponent *k8sv1.Component
+	err := retry.OnConflict(func() error {
+		currentComponent, err := cupm.componentClient.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		changeStatus(&currentComponent.Status)
+		updatedComponent, err = cupm.componentClient.UpdateStatus(ctx, currentComponent, metav1.UpdateOptions{})
+		return err
+	})
+
+	return updatedComponent, err
+}
+
+// findPreviousRevision returns the newest superseded revision before the current revision of the component's release.
+// It returns 0 if there is no such revision.
+func (cupm *ComponentUpgradeManager) findPreviousRevision(component *k8sv1.Component) (int, error) {
+	history, err := cupm.helmClient.GetReleaseHistory(component.Spec.Name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get release history for component %s: %w", component.Spec.Name, err)
+	}
+
+	currentRevision := 0
+	for _, rel := range history {
+		if rel.Version > currentRevision {
+			currentRevision = rel.Version
+		}
+	}
+
+	revision := 0
+	for _, rel := range history {
+		if rel.Info == nil || rel.Info.Status != helmRelease.StatusSuperseded {
+			continue
+		}
+
+		if rel.Version < currentRevision && rel.Version > revision {
+			revision = rel.Version
+		}
+	}
+
+	return revision, nil
+}
+
+// checkDependents prevents upgrades and downgrades to versions which do not satisfy the version requirements of
+// installed components depending on the component. The check can be skipped by the IgnoreDependentConstraintsAnnotation.
+func checkDependents(ctx context.Context, helmClient helmClient, recorder record.EventRecorder, component *k8sv1.Component, version string, eventReason string) error {
+	logger := log.FromContext(ctx)
+
+	err := helmClient.SatisfiesDependents(ctx, component.Spec.Name, version)
+	if err == nil {
+		return nil
+	}
+
+	if annotations.IsTrue(component, annotations.IgnoreDependentConstraintsAnnotation) {
+		logger.Info(fmt.Sprintf("Ignoring failed check of dependent components: %s", err.Error()))
+		return nil
+	}
+
+	recorder.Eventf(component, corev1.EventTypeWarning, eventReason, "Dependent components check failed: %s", err.Error())
+	return &genericRequeueableError{errMsg: "failed to check dependent components", err: err}
+}
+
+// updateComponentVersion updates the component version in the component CR with the latest version if no version is
+// given. Version ranges and channels are resolved to the newest matching version without changing the component CR.
 func (cupm *ComponentUpgradeManager) updateComponentVersion(ctx context.Context, component *k8sv1.Component) (string, *k8sv1.Component, error) {
 	var version string
 	if component.Spec.Version == "" {
@@ -115,7 +398,17 @@ func (cupm *ComponentUpgradeManager) updateComponentVersion(ctx context.Context,
 			return "", nil, &genericRequeueableError{fmt.Sprintf("failed to update expected version for component %q", component.Spec.Name), err}
 		}
 	} else {
-		version = component.Spec.Version
+		resolvedVersion, err := resolveComponentVersion(cupm.helmClient, component, component.Status.InstalledVersion)
+		if err != nil {
+			return "", nil, &genericRequeueableError{fmt.Sprintf("failed to resolve version for component %q", component.Spec.Name), err}
+		}
+		recordResolvedVersion(cupm.recorder, component, UpgradeEventReason, resolvedVersion)
+
+		err = updateResolvedVersion(ctx, cupm.componentClient, component, resolvedVersion)
+		if err != nil {
+			return "", nil, &genericRequeueableError{fmt.Sprintf("failed to store resolved version for component %q", component.Spec.Name), err}
+		}
+		version = resolvedVersion
 	}
 	return version, component, nil
 }
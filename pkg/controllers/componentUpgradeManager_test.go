@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
@@ -24,7 +26,7 @@ func TestNewComponentUpgradeManager(t *testing.T) {
 		mockComponentClient := newMockComponentInterface(t)
 		mockHelmClient := newMockHelmClient(t)
 
-		manager := NewComponentUpgradeManager(mockComponentClient, mockHelmClient, nil, nil, defaultHelmClientTimeoutMins, nil)
+		manager := NewComponentUpgradeManager(mockComponentClient, mockHelmClient, nil, nil, defaultHelmClientTimeoutMins, nil, time.Minute)
 
 		assert.NotNil(t, manager)
 		assert.Equal(t, mockHelmClient, manager.helmClient)
@@ -68,6 +70,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		}
 		mockHelmClient.EXPECT().GetRelease("testComponent").Return(rel, nil)
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
 
 		mockHealthManager := newMockHealthManager(t)
@@ -121,6 +124,87 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		assert.ErrorContains(t, err, "failed to check dependencies")
 	})
 
+	t.Run("dependent components check failed", func(t *testing.T) {
+		// given
+		mockComponentClient := newMockComponentInterface(t)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec, _ := helm.GetHelmChartSpec(testCtx, component, helm.HelmChartCreationOpts{
+			HelmClient:     mockHelmClient,
+			Timeout:        defaultHelmClientTimeoutMins,
+			YamlSerializer: yaml.NewSerializer(),
+			Reader:         configMapRefReaderMock,
+		})
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(assert.AnError)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Dependent components check failed: %s", assert.AnError.Error()).Return()
+
+		sut := ComponentUpgradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			recorder:        mockRecorder,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Upgrade(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.IsType(t, err, &genericRequeueableError{})
+		assert.ErrorContains(t, err, "failed to check dependent components")
+	})
+
+	t.Run("should upgrade component with ignored dependent components check", func(t *testing.T) {
+		// given
+		ignoringComponent := component.DeepCopy()
+		ignoringComponent.Annotations = map[string]string{annotations.IgnoreDependentConstraintsAnnotation: "true"}
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(testCtx, ignoringComponent).Return(ignoringComponent, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, ignoringComponent).Return(ignoringComponent, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec, _ := helm.GetHelmChartSpec(testCtx, ignoringComponent, helm.HelmChartCreationOpts{
+			HelmClient:     mockHelmClient,
+			Timeout:        defaultHelmClientTimeoutMins,
+			YamlSerializer: yaml.NewSerializer(),
+			Reader:         configMapRefReaderMock,
+		})
+		rel := &release.Release{
+			Info: &release.Info{Status: release.StatusDeployed},
+		}
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(rel, nil)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", "0.1.0").Return(assert.AnError)
+		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, ignoringComponent.Spec.Name, "ecosystem", "0.1.0").Return(nil)
+
+		sut := &ComponentUpgradeManager{
+			componentClient: mockComponentClient,
+			helmClient:      mockHelmClient,
+			healthManager:   mockHealthManager,
+			timeout:         defaultHelmClientTimeoutMins,
+			reader:          configMapRefReaderMock,
+		}
+
+		// when
+		err := sut.Upgrade(testCtx, ignoringComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+
 	t.Run("should fail to upgrade component on error while setting upgrading status", func(t *testing.T) {
 		ctx := context.Background()
 		component := &k8sv1.Component{
@@ -147,6 +231,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 			Reader:         configMapRefReaderMock,
 		})
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 
 		manager := &ComponentUpgradeManager{
 			componentClient: mockComponentClient,
@@ -191,6 +276,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		}
 		mockHelmClient.EXPECT().GetRelease("testComponent").Return(rel, nil)
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(assert.AnError)
 
 		manager := &ComponentUpgradeManager{
@@ -234,6 +320,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 
 		mockHelmClient.EXPECT().GetRelease("testComponent").Return(nil, assert.AnError)
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 
 		manager := &ComponentUpgradeManager{
 			componentClient: mockComponentClient,
@@ -275,6 +362,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		})
 		mockHelmClient.EXPECT().GetRelease("testComponent").Return(nil, driver.ErrReleaseNotFound)
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(assert.AnError)
 
 		manager := &ComponentUpgradeManager{
@@ -323,6 +411,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		}
 		mockHelmClient.EXPECT().GetRelease("testComponent").Return(rel, nil)
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
 
 		mockHealthManager := newMockHealthManager(t)
@@ -376,6 +465,7 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		}
 		mockHelmClient.EXPECT().GetRelease("testComponent").Return(rel, nil)
 		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
 		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
 
 		mockHealthManager := newMockHealthManager(t)
@@ -394,6 +484,98 @@ func Test_componentUpgradeManager_Upgrade(t *testing.T) {
 		require.ErrorIs(t, err, assert.AnError)
 		assert.ErrorContains(t, err, "failed to update health status for component")
 	})
+
+	t.Run("should start verification after upgrading chart", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		component := &k8sv1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "testComponent", Namespace: "ecosystem"},
+			Spec:       k8sv1.ComponentSpec{Namespace: "ecosystem", Name: "testComponent", Version: "0.1.0", ValuesConfigRef: &k8sv1.Reference{}},
+			Status:     k8sv1.ComponentStatus{Status: "installed"},
+		}
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().UpdateStatusUpgrading(ctx, component).Return(component, nil)
+		mockComponentClient.EXPECT().Get(mock.Anything, "testComponent", metav1.GetOptions{}).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatus(mock.Anything, component, metav1.UpdateOptions{}).Return(component, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		spec, _ := helm.GetHelmChartSpec(testCtx, component, helm.HelmChartCreationOpts{
+			HelmClient:     mockHelmClient,
+			Timeout:        defaultHelmClientTimeoutMins,
+			YamlSerializer: yaml.NewSerializer(),
+			Reader:         configMapRefReaderMock,
+		})
+		mockHelmClient.EXPECT().GetRelease("testComponent").Return(&release.Release{Info: &release.Info{Status: release.StatusDeployed}}, nil)
+		mockHelmClient.EXPECT().SatisfiesDependencies(testCtx, spec).Return(nil)
+		mockHelmClient.EXPECT().SatisfiesDependents(testCtx, "testComponent", spec.Version).Return(nil)
+		mockHelmClient.EXPECT().InstallOrUpgrade(mock.Anything, spec).Return(nil)
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(mock.Anything, "testComponent", "ecosystem").Return(false, nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Normal", "Upgrade", "Verifying that version %s becomes available within %s.", "0.1.0", time.Minute)
+
+		manager := &ComponentUpgradeManager{
+			componentClient:     mockComponentClient,
+			helmClient:          mockHelmClient,
+			healthManager:       mockHealthManager,
+			recorder:            mockRecorder,
+			timeout:             defaultHelmClientTimeoutMins,
+			reader:              configMapRefReaderMock,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		err := manager.Upgrade(ctx, component)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, isUpgradeVerificationPending(err))
+		require.NotNil(t, component.Status.UpgradeVerification)
+		assert.Equal(t, "0.1.0", component.Status.UpgradeVerification.Version)
+		assert.False(t, component.Status.UpgradeVerification.StartedAt.IsZero())
+		assert.False(t, component.Status.UpgradeVerification.RolledBack)
+	})
+
+	t.Run("should continue verification without upgrading chart again", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		component := &k8sv1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "testComponent", Namespace: "ecosystem"},
+			Spec:       k8sv1.ComponentSpec{Namespace: "ecosystem", Name: "testComponent", Version: "0.1.0"},
+			Status: k8sv1.ComponentStatus{Status: "tryToUpgrade", UpgradeVerification: &k8sv1.UpgradeVerificationStatus{
+				Version:   "0.1.0",
+				StartedAt: metav1.Now(),
+			}},
+		}
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().Get(mock.Anything, "testComponent", metav1.GetOptions{}).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatus(mock.Anything, component, metav1.UpdateOptions{}).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatusInstalled(mock.Anything, component).Return(component, nil)
+
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(mock.Anything, "testComponent", "ecosystem").Return(true, nil)
+		mockHealthManager.EXPECT().UpdateComponentHealthWithInstalledVersion(mock.Anything, "testComponent", "ecosystem", "0.1.0").Return(nil)
+
+		manager := &ComponentUpgradeManager{
+			componentClient:     mockComponentClient,
+			helmClient:          newMockHelmClient(t),
+			healthManager:       mockHealthManager,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		err := manager.Upgrade(ctx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, component.Status.UpgradeVerification)
+	})
 }
 
 func TestComponentUpgradeManager_handlePendingRelease(t *testing.T) {
@@ -546,3 +728,271 @@ func TestComponentUpgradeManager_updateComponentVersion(t *testing.T) {
 		assert.Nil(t, updatedComp)
 	})
 }
+
+func Test_componentUpgradeManager_verifyUpgrade(t *testing.T) {
+	newComponent := func(startedAt time.Time) *k8sv1.Component {
+		return &k8sv1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "testComponent", Namespace: "ecosystem", Generation: 3},
+			Spec:       k8sv1.ComponentSpec{Namespace: "k8s", Name: "testComponent", Version: "0.2.0", DeployNamespace: "longhorn-system"},
+			Status: k8sv1.ComponentStatus{Status: "upgrading", InstalledVersion: "0.1.0", UpgradeVerification: &k8sv1.UpgradeVerificationStatus{
+				Version:            "0.2.0",
+				StartedAt:          metav1.NewTime(startedAt),
+				ObservedGeneration: 3,
+			}},
+		}
+	}
+	rollbackSpec := &client.ChartSpec{ReleaseName: "testComponent", Namespace: "longhorn-system", Timeout: time.Minute}
+	history := []*release.Release{
+		historyRelease(1, "0.1.0", release.StatusSuperseded),
+		historyRelease(2, "0.1.0", release.StatusSuperseded),
+		historyRelease(3, "0.2.0", release.StatusDeployed),
+	}
+
+	t.Run("should skip verification if it was not started", func(t *testing.T) {
+		// given
+		component := &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Name: "testComponent"}}
+		sut := &ComponentUpgradeManager{}
+
+		// when
+		actual, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.NoError(t, err)
+		assert.Same(t, component, actual)
+	})
+
+	t.Run("should finish verification when component is available", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now())
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(true, nil)
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().Get(testCtx, "testComponent", metav1.GetOptions{}).Return(newComponent(time.Now()), nil)
+		mockComponentClient.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, c *k8sv1.Component, _ metav1.UpdateOptions) (*k8sv1.Component, error) {
+				assert.Nil(t, c.Status.UpgradeVerification)
+				return c, nil
+			})
+
+		sut := &ComponentUpgradeManager{componentClient: mockComponentClient, healthManager: mockHealthManager, verificationTimeout: time.Minute}
+
+		// when
+		actual, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, actual.Status.UpgradeVerification)
+	})
+
+	t.Run("should wait for component within verification timeout", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now())
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(false, assert.AnError)
+
+		sut := &ComponentUpgradeManager{healthManager: mockHealthManager, verificationTimeout: time.Minute}
+
+		// when
+		_, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, isUpgradeVerificationPending(err))
+		assert.ErrorContains(t, err, "for component testComponent to become available in version 0.2.0")
+	})
+
+	t.Run("should remove record of rolled back upgrade", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now())
+		component.Status.UpgradeVerification.RolledBack = true
+
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().Get(testCtx, "testComponent", metav1.GetOptions{}).Return(component, nil)
+		mockComponentClient.EXPECT().UpdateStatus(testCtx, component, metav1.UpdateOptions{}).Return(component, nil)
+
+		sut := &ComponentUpgradeManager{componentClient: mockComponentClient}
+
+		// when
+		actual, err := sut.verifyUpgrade(testCtx, component, "0.3.0")
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, actual.Status.UpgradeVerification)
+	})
+
+	t.Run("should roll back to previous revision if component does not become available", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now().Add(-2 * time.Minute))
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(false, nil)
+		mockHealthManager.EXPECT().UpdateComponentHealth(testCtx, "testComponent", "ecosystem").Return(nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().RollbackRelease(rollbackSpec, 2).Return(nil)
+
+		currentComponent := newComponent(time.Now())
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().Get(testCtx, "testComponent", metav1.GetOptions{}).Return(currentComponent, nil)
+		mockComponentClient.EXPECT().UpdateStatus(testCtx, currentComponent, metav1.UpdateOptions{}).Return(currentComponent, nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Verification of version %s failed: %s", "0.2.0", "component did not become available within 1m0s")
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Rolled back to helm revision %d.", 2)
+
+		sut := &ComponentUpgradeManager{
+			componentClient:     mockComponentClient,
+			helmClient:          mockHelmClient,
+			healthManager:       mockHealthManager,
+			recorder:            mockRecorder,
+			timeout:             time.Minute,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		_, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "upgrade of component testComponent to version 0.2.0 was rolled back to helm revision 2: component did not become available within 1m0s")
+		assert.True(t, isUpgradeRolledBack(err))
+		requeueable, _ := shouldRequeue(err)
+		assert.False(t, requeueable)
+		assert.Equal(t, "tryToUpgrade", currentComponent.Status.Status)
+		require.NotNil(t, currentComponent.Status.UpgradeVerification)
+		assert.True(t, currentComponent.Status.UpgradeVerification.RolledBack)
+		assert.Equal(t, "0.2.0", currentComponent.Status.UpgradeVerification.Version)
+		assert.Equal(t, int64(3), currentComponent.Status.UpgradeVerification.ObservedGeneration)
+		assert.True(t, isRejectedVersion(currentComponent, "0.2.0"))
+		assert.Equal(t, "0.1.0", currentComponent.Status.InstalledVersion)
+	})
+
+	t.Run("should requeue if status cannot be updated after rollback", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now().Add(-2 * time.Minute))
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(false, nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().RollbackRelease(rollbackSpec, 2).Return(nil)
+
+		currentComponent := newComponent(time.Now())
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().Get(testCtx, "testComponent", metav1.GetOptions{}).Return(currentComponent, nil)
+		mockComponentClient.EXPECT().UpdateStatus(testCtx, currentComponent, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Verification of version %s failed: %s", "0.2.0", mock.Anything)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Rolled back to helm revision %d.", 2)
+
+		sut := &ComponentUpgradeManager{
+			componentClient:     mockComponentClient,
+			helmClient:          mockHelmClient,
+			healthManager:       mockHealthManager,
+			recorder:            mockRecorder,
+			timeout:             time.Minute,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		_, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to update status-tryToUpgrade for component testComponent")
+		requeueable, _ := shouldRequeue(err)
+		assert.True(t, requeueable)
+	})
+
+	t.Run("should requeue if there is no previous revision", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now().Add(-2 * time.Minute))
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(false, nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history[2:], nil)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Verification of version %s failed: %s", "0.2.0", mock.Anything)
+
+		sut := &ComponentUpgradeManager{
+			helmClient:          mockHelmClient,
+			healthManager:       mockHealthManager,
+			recorder:            mockRecorder,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		_, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to verify upgrade of component testComponent without previous helm revision to roll back to")
+		requeueable, _ := shouldRequeue(err)
+		assert.True(t, requeueable)
+	})
+
+	t.Run("should requeue if rollback fails", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now().Add(-2 * time.Minute))
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(false, nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(history, nil)
+		mockHelmClient.EXPECT().RollbackRelease(rollbackSpec, 2).Return(assert.AnError)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Verification of version %s failed: %s", "0.2.0", mock.Anything)
+
+		sut := &ComponentUpgradeManager{
+			helmClient:          mockHelmClient,
+			healthManager:       mockHealthManager,
+			recorder:            mockRecorder,
+			timeout:             time.Minute,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		_, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to roll back component testComponent to helm revision 2")
+		requeueable, _ := shouldRequeue(err)
+		assert.True(t, requeueable)
+	})
+
+	t.Run("should fail to get release history", func(t *testing.T) {
+		// given
+		component := newComponent(time.Now().Add(-2 * time.Minute))
+		mockHealthManager := newMockHealthManager(t)
+		mockHealthManager.EXPECT().IsComponentAvailable(testCtx, "testComponent", "longhorn-system").Return(false, nil)
+
+		mockHelmClient := newMockHelmClient(t)
+		mockHelmClient.EXPECT().GetReleaseHistory("testComponent").Return(nil, assert.AnError)
+
+		mockRecorder := newMockEventRecorder(t)
+		mockRecorder.EXPECT().Eventf(component, "Warning", "Upgrade", "Verification of version %s failed: %s", "0.2.0", mock.Anything)
+
+		sut := &ComponentUpgradeManager{
+			helmClient:          mockHelmClient,
+			healthManager:       mockHealthManager,
+			recorder:            mockRecorder,
+			verificationTimeout: time.Minute,
+		}
+
+		// when
+		_, err := sut.verifyUpgrade(testCtx, component, "0.2.0")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to find previous helm revision of component testComponent")
+	})
+}
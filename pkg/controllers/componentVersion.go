@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+	"github.com/cloudogu/retry-lib/retry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// resolveComponentVersion resolves the version range or channel in the spec of the component to a concrete version.
+// Exact versions are returned unchanged without accessing the registry. The installed version is only required for
+// the patch-only channel and may be empty.
+func resolveComponentVersion(helmClient helmClient, component *k8sv1.Component, installedVersion string) (string, error) {
+	selector, err := version.ParseSelector(component.Spec.Version)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse component version %s from %s: %w", component.Spec.Version, component.Spec.Name, err)
+	}
+
+	if selector.IsExact() {
+		return component.Spec.Version, nil
+	}
+
+	resolvedVersion, err := helmClient.ResolveVersion(helm.GetHelmChartName(component), selector, installedVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve version %s of component %s: %w", component.Spec.Version, component.Spec.Name, err)
+	}
+
+	return resolvedVersion, nil
+}
+
+// updateResolvedVersion stores the concrete version a version range or channel in the spec of the component was
+// resolved to in its status. The resolved version is cleared for exact versions. The status is only updated if the
+// resolved version changed; the given component is updated with the result.
+func updateResolvedVersion(ctx context.Context, componentClient componentInterface, component *k8sv1.Component, resolvedVersion string) error {
+	if component.Spec.Version == resolvedVersion {
+		resolvedVersion = ""
+	}
+	if component.Status.ResolvedVersion == resolvedVersion {
+		return nil
+	}
+
+	err := retry.OnConflict(func() error {
+		currentComponent, err := componentClient.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		currentComponent.Status.ResolvedVersion = resolvedVersion
+		updatedComponent, err := componentClient.UpdateStatus(ctx, currentComponent, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		*component = *updatedComponent
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update resolved version of component %s: %w", component.Spec.Name, err)
+	}
+
+	return nil
+}
+
+// withVersion returns a copy of the component with the given concrete version. The copy is only used to create the
+// helm chart spec and must never be written back to the cluster, so that a version range is resolved again on every
+// reconciliation. The component itself is returned if it already has the given version.
+func withVersion(component *k8sv1.Component, resolvedVersion string) *k8sv1.Component {
+	if component.Spec.Version == resolvedVersion {
+		return component
+	}
+
+	resolvedComponent := component.DeepCopy()
+	resolvedComponent.Spec.Version = resolvedVersion
+
+	return resolvedComponent
+}
+
+// recordResolvedVersion emits an event if the version of the component was resolved from a range or channel.
+func recordResolvedVersion(recorder record.EventRecorder, component *k8sv1.Component, eventReason string, resolvedVersion string) {
+	if component.Spec.Version == resolvedVersion {
+		return
+	}
+
+	recorder.Eventf(component, corev1.EventTypeNormal, eventReason, "Resolved version %s to %s.", component.Spec.Version, resolvedVersion)
+}
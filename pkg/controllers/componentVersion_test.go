@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+func returnUpdatedComponent(_ context.Context, component *k8sv1.Component, _ metav1.UpdateOptions) (*k8sv1.Component, error) {
+	return component, nil
+}
+
+func Test_updateResolvedVersion(t *testing.T) {
+	t.Run("should store resolved version of version range", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "~1.5")
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(returnUpdatedComponent)
+
+		// when
+		err := updateResolvedVersion(testCtx, componentClientMock, component, "1.5.3")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.5.3", component.Status.ResolvedVersion)
+	})
+	t.Run("should clear resolved version for exact version", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "1.5.3")
+		component.Status.ResolvedVersion = "1.5.2"
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(returnUpdatedComponent)
+
+		// when
+		err := updateResolvedVersion(testCtx, componentClientMock, component, "1.5.3")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Status.ResolvedVersion)
+	})
+	t.Run("should not update unchanged resolved version", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "~1.5")
+		component.Status.ResolvedVersion = "1.5.3"
+
+		// when
+		err := updateResolvedVersion(testCtx, newMockComponentInterface(t), component, "1.5.3")
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail to update status", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "~1.5")
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+
+		// when
+		err := updateResolvedVersion(testCtx, componentClientMock, component, "1.5.3")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to update resolved version of component dogu-op")
+		assert.Empty(t, component.Status.ResolvedVersion)
+	})
+}
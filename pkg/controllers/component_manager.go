@@ -14,19 +14,23 @@ import (
 // DefaultComponentManager is a central unit in the process of handling component custom resources.
 // The DefaultComponentManager creates, updates and deletes components.
 type DefaultComponentManager struct {
-	installManager installManager
-	deleteManager  deleteManager
-	upgradeManager upgradeManager
-	recorder       eventRecorder
+	installManager   installManager
+	deleteManager    deleteManager
+	upgradeManager   upgradeManager
+	downgradeManager downgradeManager
+	migrateManager   migrateManager
+	recorder         eventRecorder
 }
 
 // NewComponentManager creates a new instance of DefaultComponentManager.
-func NewComponentManager(clientset componentInterface, helmClient helmClient, healthManager healthManager, recorder record.EventRecorder, timeout time.Duration, reader configMapRefReader) *DefaultComponentManager {
+func NewComponentManager(clientset componentInterface, coreV1Client coreV1Interface, helmClient helmClient, healthManager healthManager, recorder record.EventRecorder, timeout time.Duration, reader configMapRefReader, upgradeVerificationTimeout time.Duration) *DefaultComponentManager {
 	return &DefaultComponentManager{
-		installManager: NewComponentInstallManager(clientset, helmClient, healthManager, recorder, timeout, reader),
-		deleteManager:  NewComponentDeleteManager(clientset, helmClient),
-		upgradeManager: NewComponentUpgradeManager(clientset, helmClient, healthManager, recorder, timeout, reader),
-		recorder:       recorder,
+		installManager:   NewComponentInstallManager(clientset, helmClient, healthManager, recorder, timeout, reader),
+		deleteManager:    NewComponentDeleteManager(clientset, helmClient),
+		upgradeManager:   NewComponentUpgradeManager(clientset, helmClient, healthManager, recorder, timeout, reader, upgradeVerificationTimeout),
+		downgradeManager: NewComponentDowngradeManager(clientset, helmClient, healthManager, recorder, timeout, reader),
+		migrateManager:   NewComponentMigrateManager(clientset, coreV1Client, helmClient, healthManager, recorder, timeout, reader),
+		recorder:         recorder,
 	}
 }
 
@@ -48,20 +52,38 @@ func (m *DefaultComponentManager) Upgrade(ctx context.Context, component *k8sv1.
 	return m.upgradeManager.Upgrade(ctx, component)
 }
 
+// Downgrade downgrades the given component resource.
+func (m *DefaultComponentManager) Downgrade(ctx context.Context, component *k8sv1.Component) error {
+	m.recorder.Event(component, corev1.EventTypeNormal, DowngradeEventReason, "Starting downgrade...")
+	return m.downgradeManager.Downgrade(ctx, component)
+}
+
+// Migrate migrates the given component resource to its deploy namespace.
+func (m *DefaultComponentManager) Migrate(ctx context.Context, component *k8sv1.Component) error {
+	m.recorder.Event(component, corev1.EventTypeNormal, MigrationEventReason, "Starting migration...")
+	return m.migrateManager.Migrate(ctx, component)
+}
+
 type defaultComponentManagerFactory struct {
 	namespace string
 	clientSet componentEcosystemInterface
 	recorder  record.EventRecorder
 	timeout   time.Duration
+	// upgradeVerificationTimeout is the maximum time to wait for upgraded components to become available.
+	upgradeVerificationTimeout time.Duration
+	// journal records the helm actions of the component managers.
+	journal operationJournal
 }
 
 func (d *defaultComponentManagerFactory) NewComponentManager(helmClient helmClient) ComponentManager {
 	return NewComponentManager(
 		d.clientSet.ComponentV1Alpha1().Components(d.namespace),
-		helmClient,
+		d.clientSet.CoreV1(),
+		newJournaledHelmClient(helmClient, d.journal),
 		health.NewManager(d.namespace, d.clientSet),
 		d.recorder,
 		d.timeout,
 		configref.NewConfigMapRefReader(d.clientSet.CoreV1().ConfigMaps(d.namespace)),
+		d.upgradeVerificationTimeout,
 	)
 }
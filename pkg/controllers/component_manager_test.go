@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"testing"
+	"time"
 
 	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
 	"github.com/stretchr/testify/assert"
@@ -13,7 +14,7 @@ import (
 func TestNewComponentManager(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// when
-		sut := NewComponentManager(nil, nil, nil, nil, defaultHelmClientTimeoutMins, nil)
+		sut := NewComponentManager(nil, nil, nil, nil, nil, defaultHelmClientTimeoutMins, nil, time.Minute)
 
 		// then
 		require.NotNil(t, sut)
@@ -54,6 +55,8 @@ func Test_defaultComponentManagerFactory_NewComponentManager(t *testing.T) {
 		assert.NotNil(t, defaultManager.installManager)
 		assert.NotNil(t, defaultManager.deleteManager)
 		assert.NotNil(t, defaultManager.upgradeManager)
+		assert.NotNil(t, defaultManager.downgradeManager)
+		assert.NotNil(t, defaultManager.migrateManager)
 		assert.Same(t, recorderMock, defaultManager.recorder)
 	})
 }
@@ -101,6 +104,48 @@ func Test_componentManager_Upgrade(t *testing.T) {
 	})
 }
 
+func Test_componentManager_Downgrade(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.1.0")
+		downgradeManagerMock := newMockDowngradeManager(t)
+		downgradeManagerMock.EXPECT().Downgrade(context.TODO(), component).Return(nil)
+		eventRecorderMock := newMockEventRecorder(t)
+		eventRecorderMock.EXPECT().Event(component, "Normal", "Downgrade", "Starting downgrade...")
+
+		sut := &DefaultComponentManager{
+			downgradeManager: downgradeManagerMock,
+			recorder:         eventRecorderMock,
+		}
+		// when
+		err := sut.Downgrade(context.TODO(), component)
+
+		// then
+		require.Nil(t, err)
+	})
+}
+
+func Test_componentManager_Migrate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "longhorn-system", "dogu-op", "0.1.0")
+		migrateManagerMock := newMockMigrateManager(t)
+		migrateManagerMock.EXPECT().Migrate(context.TODO(), component).Return(nil)
+		eventRecorderMock := newMockEventRecorder(t)
+		eventRecorderMock.EXPECT().Event(component, "Normal", "Migration", "Starting migration...")
+
+		sut := &DefaultComponentManager{
+			migrateManager: migrateManagerMock,
+			recorder:       eventRecorderMock,
+		}
+		// when
+		err := sut.Migrate(context.TODO(), component)
+
+		// then
+		require.Nil(t, err)
+	})
+}
+
 func Test_componentManager_Delete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// given
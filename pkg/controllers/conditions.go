@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+)
+
+func newCondition(conditionType string, status metav1.ConditionStatus, reason string, message string) metav1.Condition {
+	return metav1.Condition{Type: conditionType, Status: status, Reason: reason, Message: message}
+}
+
+// operationStartedConditions returns the conditions of a component whose operation with the given event reason starts.
+func operationStartedConditions(eventReason string) []metav1.Condition {
+	return []metav1.Condition{
+		newCondition(conditions.TypeProgressing, metav1.ConditionTrue, eventReason, fmt.Sprintf("%s in progress", eventReason)),
+	}
+}
+
+// operationFinishedConditions returns the conditions of a component whose operation with the given event reason
+// finished with the given error. Dependencies and values are only marked as invalid if the error was caused by them.
+func operationFinishedConditions(eventReason string, operationError error) []metav1.Condition {
+	if operationError == nil {
+		message := fmt.Sprintf("%s successful", eventReason)
+		return []metav1.Condition{
+			newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonSucceeded, message),
+			newCondition(conditions.TypeFailed, metav1.ConditionFalse, conditions.ReasonSucceeded, message),
+			newCondition(conditions.TypeDependenciesSatisfied, metav1.ConditionTrue, conditions.ReasonDependenciesInstalled, "All dependencies are installed"),
+			newCondition(conditions.TypeValuesValid, metav1.ConditionTrue, conditions.ReasonValuesApplied, "Values are applied"),
+		}
+	}
+
+	failedReason := eventReason + "Failed"
+	if isUpgradeRolledBack(operationError) {
+		failedReason = conditions.ReasonRolledBack
+	}
+	message := operationError.Error()
+	result := []metav1.Condition{newCondition(conditions.TypeFailed, metav1.ConditionTrue, failedReason, message)}
+
+	if requeue, _ := shouldRequeue(operationError); requeue {
+		result = append(result, newCondition(conditions.TypeProgressing, metav1.ConditionTrue, conditions.ReasonRetrying, message))
+	} else {
+		result = append(result, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, failedReason, message))
+	}
+
+	if helm.IsDependencyUnsatisfiedError(operationError) {
+		result = append(result, newCondition(conditions.TypeDependenciesSatisfied, metav1.ConditionFalse, conditions.ReasonDependenciesUnsatisfied, message))
+	}
+
+	if helm.IsValuesError(operationError) {
+		result = append(result, newCondition(conditions.TypeValuesValid, metav1.ConditionFalse, conditions.ReasonInvalidValues, message))
+	}
+
+	return result
+}
+
+// invalidValuesConditions returns the conditions of a component whose values could not be read or parsed.
+func invalidValuesConditions(err error) []metav1.Condition {
+	return []metav1.Condition{
+		newCondition(conditions.TypeValuesValid, metav1.ConditionFalse, conditions.ReasonInvalidValues, err.Error()),
+		newCondition(conditions.TypeFailed, metav1.ConditionTrue, conditions.ReasonInvalidValues, err.Error()),
+	}
+}
+
+// updateConditions persists the given conditions of the component. Errors are only logged because conditions only
+// report the state of the component and must not prevent operations.
+func (r *ComponentReconciler) updateConditions(ctx context.Context, component *k8sv1.Component, newConditions []metav1.Condition) {
+	err := r.conditionWriter.Update(ctx, component, newConditions)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.FromContext(ctx).Error(err, fmt.Sprintf("failed to update conditions of component %s", component.Spec.Name))
+	}
+}
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+)
+
+// newConditionWriterMock returns a condition writer mock which accepts any condition update.
+func newConditionWriterMock(t *testing.T) *mockConditionWriter {
+	conditionWriterMock := newMockConditionWriter(t)
+	conditionWriterMock.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return conditionWriterMock
+}
+
+func Test_operationStartedConditions(t *testing.T) {
+	// when
+	actual := operationStartedConditions(UpgradeEventReason)
+
+	// then
+	require.Len(t, actual, 1)
+	assert.Equal(t, conditions.TypeProgressing, actual[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, actual[0].Status)
+	assert.Equal(t, UpgradeEventReason, actual[0].Reason)
+	assert.Equal(t, "Upgrade in progress", actual[0].Message)
+}
+
+func Test_operationFinishedConditions(t *testing.T) {
+	t.Run("should mark successful operation", func(t *testing.T) {
+		// when
+		actual := operationFinishedConditions(InstallEventReason, nil)
+
+		// then
+		progressing := findCondition(actual, conditions.TypeProgressing)
+		assert.Equal(t, metav1.ConditionFalse, progressing.Status)
+		assert.Equal(t, conditions.ReasonSucceeded, progressing.Reason)
+		assert.Equal(t, "Installation successful", progressing.Message)
+		assert.Equal(t, metav1.ConditionFalse, findCondition(actual, conditions.TypeFailed).Status)
+		assert.Equal(t, metav1.ConditionTrue, findCondition(actual, conditions.TypeDependenciesSatisfied).Status)
+		assert.Equal(t, metav1.ConditionTrue, findCondition(actual, conditions.TypeValuesValid).Status)
+	})
+	t.Run("should mark failed operation as retrying", func(t *testing.T) {
+		// given
+		operationError := &genericRequeueableError{"failed to install", assert.AnError}
+
+		// when
+		actual := operationFinishedConditions(InstallEventReason, operationError)
+
+		// then
+		failed := findCondition(actual, conditions.TypeFailed)
+		assert.Equal(t, metav1.ConditionTrue, failed.Status)
+		assert.Equal(t, "InstallationFailed", failed.Reason)
+		assert.Equal(t, operationError.Error(), failed.Message)
+		progressing := findCondition(actual, conditions.TypeProgressing)
+		assert.Equal(t, metav1.ConditionTrue, progressing.Status)
+		assert.Equal(t, conditions.ReasonRetrying, progressing.Reason)
+		assert.Nil(t, findCondition(actual, conditions.TypeDependenciesSatisfied))
+		assert.Nil(t, findCondition(actual, conditions.TypeValuesValid))
+	})
+	t.Run("should mark failed operation as not progressing if it is not retried", func(t *testing.T) {
+		// when
+		actual := operationFinishedConditions(DowngradeEventReason, assert.AnError)
+
+		// then
+		assert.Equal(t, metav1.ConditionTrue, findCondition(actual, conditions.TypeFailed).Status)
+		progressing := findCondition(actual, conditions.TypeProgressing)
+		assert.Equal(t, metav1.ConditionFalse, progressing.Status)
+		assert.Equal(t, "DowngradeFailed", progressing.Reason)
+	})
+	t.Run("should mark rolled back upgrade", func(t *testing.T) {
+		// given
+		operationError := &upgradeRolledBackError{component: "dogu-op", version: "0.2.0", revision: 2, err: assert.AnError}
+
+		// when
+		actual := operationFinishedConditions(UpgradeEventReason, operationError)
+
+		// then
+		failed := findCondition(actual, conditions.TypeFailed)
+		assert.Equal(t, metav1.ConditionTrue, failed.Status)
+		assert.Equal(t, conditions.ReasonRolledBack, failed.Reason)
+		assert.Equal(t, "upgrade of component dogu-op to version 0.2.0 was rolled back to helm revision 2: "+assert.AnError.Error(), failed.Message)
+		progressing := findCondition(actual, conditions.TypeProgressing)
+		assert.Equal(t, metav1.ConditionFalse, progressing.Status)
+		assert.Equal(t, conditions.ReasonRolledBack, progressing.Reason)
+	})
+}
+
+func Test_invalidValuesConditions(t *testing.T) {
+	// when
+	actual := invalidValuesConditions(assert.AnError)
+
+	// then
+	valuesValid := findCondition(actual, conditions.TypeValuesValid)
+	assert.Equal(t, metav1.ConditionFalse, valuesValid.Status)
+	assert.Equal(t, conditions.ReasonInvalidValues, valuesValid.Reason)
+	assert.Equal(t, assert.AnError.Error(), valuesValid.Message)
+	assert.Equal(t, metav1.ConditionTrue, findCondition(actual, conditions.TypeFailed).Status)
+}
+
+func TestComponentReconciler_updateConditions(t *testing.T) {
+	t.Run("should update conditions", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		newConditions := operationStartedConditions(InstallEventReason)
+		conditionWriterMock := newMockConditionWriter(t)
+		conditionWriterMock.EXPECT().Update(testCtx, component, newConditions).Return(nil)
+		sut := &ComponentReconciler{conditionWriter: conditionWriterMock}
+
+		// when
+		sut.updateConditions(testCtx, component, newConditions)
+	})
+	t.Run("should ignore errors", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		conditionWriterMock := newMockConditionWriter(t)
+		conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).Return(assert.AnError).Once()
+		conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).
+			Return(k8serrors.NewNotFound(schema.GroupResource{Resource: "components"}, "dogu-op")).Once()
+		sut := &ComponentReconciler{conditionWriter: conditionWriterMock}
+
+		// when
+		sut.updateConditions(testCtx, component, operationStartedConditions(InstallEventReason))
+		sut.updateConditions(testCtx, component, operationFinishedConditions(DeinstallationEventReason, nil))
+	})
+}
+
+func TestComponentReconciler_awaitUpgradeVerification(t *testing.T) {
+	// given
+	component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+	pendingErr := &upgradeVerificationPendingError{component: "dogu-op", version: "0.1.0", deadline: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	conditionWriterMock := newMockConditionWriter(t)
+	conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ *k8sv1.Component, newConditions []metav1.Condition) error {
+			require.Len(t, newConditions, 1)
+			assert.Equal(t, conditions.TypeProgressing, newConditions[0].Type)
+			assert.Equal(t, metav1.ConditionTrue, newConditions[0].Status)
+			assert.Equal(t, conditions.ReasonVerifying, newConditions[0].Reason)
+			assert.Equal(t, "waiting until 2026-01-01T12:00:00Z for component dogu-op to become available in version 0.1.0", newConditions[0].Message)
+			return nil
+		})
+	sut := &ComponentReconciler{conditionWriter: conditionWriterMock}
+
+	// when
+	result, err := sut.awaitUpgradeVerification(testCtx, component, pendingErr)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, upgradeVerificationInterval, result.RequeueAfter)
+}
+
+func findCondition(list []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range list {
+		if list[i].Type == conditionType {
+			return &list[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// dependencyWaitIndex keeps track of components which wait for their dependencies to be installed.
+// It is only held in memory because waiting components are requeued anyway and register themselves again.
+type dependencyWaitIndex struct {
+	mu sync.Mutex
+	// dependents maps the name of a dependency to the components waiting for it.
+	dependents map[string]map[types.NamespacedName]struct{}
+	// dependencies maps a waiting component to the names of the dependencies it waits for.
+	dependencies map[types.NamespacedName][]string
+}
+
+func newDependencyWaitIndex() *dependencyWaitIndex {
+	return &dependencyWaitIndex{
+		dependents:   map[string]map[types.NamespacedName]struct{}{},
+		dependencies: map[types.NamespacedName][]string{},
+	}
+}
+
+// setWaiting registers the component as waiting for the given dependencies and replaces previously registered ones.
+// An empty list of dependencies removes the component from the index.
+func (i *dependencyWaitIndex) setWaiting(component types.NamespacedName, dependencies []string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.removeLocked(component)
+	if len(dependencies) == 0 {
+		return
+	}
+
+	i.dependencies[component] = dependencies
+	for _, dependency := range dependencies {
+		if i.dependents[dependency] == nil {
+			i.dependents[dependency] = map[types.NamespacedName]struct{}{}
+		}
+		i.dependents[dependency][component] = struct{}{}
+	}
+}
+
+// remove removes the component from the index.
+func (i *dependencyWaitIndex) remove(component types.NamespacedName) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.removeLocked(component)
+}
+
+// popWaiting returns all components waiting for the given dependency and removes them from the index.
+func (i *dependencyWaitIndex) popWaiting(dependency string) []types.NamespacedName {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var components []types.NamespacedName
+	for component := range i.dependents[dependency] {
+		components = append(components, component)
+	}
+
+	for _, component := range components {
+		i.removeLocked(component)
+	}
+
+	return components
+}
+
+func (i *dependencyWaitIndex) removeLocked(component types.NamespacedName) {
+	for _, dependency := range i.dependencies[component] {
+		delete(i.dependents[dependency], component)
+		if len(i.dependents[dependency]) == 0 {
+			delete(i.dependents, dependency)
+		}
+	}
+	delete(i.dependencies, component)
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	testDoguOperator = types.NamespacedName{Namespace: testNamespace, Name: "k8s-dogu-operator"}
+	testServiceDisc  = types.NamespacedName{Namespace: testNamespace, Name: "k8s-service-discovery"}
+)
+
+func Test_dependencyWaitIndex_setWaiting(t *testing.T) {
+	t.Run("should register component for all dependencies", func(t *testing.T) {
+		// given
+		sut := newDependencyWaitIndex()
+
+		// when
+		sut.setWaiting(testDoguOperator, []string{"k8s-etcd", "k8s-longhorn"})
+
+		// then
+		assert.Equal(t, []types.NamespacedName{testDoguOperator}, sut.popWaiting("k8s-longhorn"))
+		assert.Empty(t, sut.popWaiting("k8s-etcd"))
+	})
+	t.Run("should replace previous dependencies", func(t *testing.T) {
+		// given
+		sut := newDependencyWaitIndex()
+		sut.setWaiting(testDoguOperator, []string{"k8s-etcd"})
+
+		// when
+		sut.setWaiting(testDoguOperator, []string{"k8s-longhorn"})
+
+		// then
+		assert.Empty(t, sut.popWaiting("k8s-etcd"))
+		assert.Equal(t, []types.NamespacedName{testDoguOperator}, sut.popWaiting("k8s-longhorn"))
+	})
+	t.Run("should remove component without dependencies", func(t *testing.T) {
+		// given
+		sut := newDependencyWaitIndex()
+		sut.setWaiting(testDoguOperator, []string{"k8s-etcd"})
+
+		// when
+		sut.setWaiting(testDoguOperator, nil)
+
+		// then
+		assert.Empty(t, sut.dependents)
+		assert.Empty(t, sut.dependencies)
+	})
+}
+
+func Test_dependencyWaitIndex_remove(t *testing.T) {
+	// given
+	sut := newDependencyWaitIndex()
+	sut.setWaiting(testDoguOperator, []string{"k8s-etcd"})
+	sut.setWaiting(testServiceDisc, []string{"k8s-etcd"})
+
+	// when
+	sut.remove(testDoguOperator)
+
+	// then
+	assert.Equal(t, []types.NamespacedName{testServiceDisc}, sut.popWaiting("k8s-etcd"))
+}
+
+func Test_dependencyWaitIndex_popWaiting(t *testing.T) {
+	t.Run("should return all waiting components", func(t *testing.T) {
+		// given
+		sut := newDependencyWaitIndex()
+		sut.setWaiting(testDoguOperator, []string{"k8s-etcd"})
+		sut.setWaiting(testServiceDisc, []string{"k8s-etcd"})
+
+		// when
+		actual := sut.popWaiting("k8s-etcd")
+
+		// then
+		assert.ElementsMatch(t, []types.NamespacedName{testDoguOperator, testServiceDisc}, actual)
+		assert.Empty(t, sut.dependents)
+		assert.Empty(t, sut.dependencies)
+	})
+	t.Run("should return nothing for unknown dependency", func(t *testing.T) {
+		sut := newDependencyWaitIndex()
+
+		assert.Empty(t, sut.popWaiting("k8s-etcd"))
+	})
+}
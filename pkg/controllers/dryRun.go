@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+const (
+	// DryRunEventReason is the reason of events about dry-runs of component operations.
+	DryRunEventReason = "DryRun"
+
+	dryRunConfigMapSuffix     = "-dry-run"
+	dryRunOperationKey        = "operation"
+	dryRunVersionKey          = "version"
+	dryRunInstalledVersionKey = "installedVersion"
+	dryRunSummaryKey          = "summary"
+	dryRunDiffKey             = "diff"
+	componentKind             = "Component"
+)
+
+// isDryRun returns true if the given operation of the component must only be rendered with a dry-run.
+func isDryRun(component *k8sv1.Component, op operation) bool {
+	if op != Install && op != Upgrade && op != Downgrade {
+		return false
+	}
+
+	return annotations.IsTrue(component, annotations.DryRunAnnotation)
+}
+
+// performDryRun renders the chart of the component with a server-side helm dry-run instead of performing the given
+// operation. The per-resource diff to the deployed release is stored in a config map which is referenced in the
+// status of the component. Neither the release nor the installation status of the component are changed.
+func (r *ComponentReconciler) performDryRun(ctx context.Context, component *k8sv1.Component, hc helmClient, op operation) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info(fmt.Sprintf("Performing dry-run of %s for component %s", op, component.Spec.Name))
+
+	resultData, err := r.renderDryRun(ctx, component, hc, op)
+	if err != nil {
+		r.recorder.Eventf(component, corev1.EventTypeWarning, DryRunEventReason, "Dry-run of %s failed: %s", op, err.Error())
+		return requeueWithError(fmt.Errorf("failed to perform dry-run of %s for component %s: %w", op, component.Spec.Name, err))
+	}
+
+	configMapName := component.Name + dryRunConfigMapSuffix
+	err = r.storeDryRunResult(ctx, component, configMapName, resultData)
+	if err != nil {
+		return requeueWithError(fmt.Errorf("failed to store dry-run result of component %s: %w", component.Spec.Name, err))
+	}
+
+	err = r.updateDryRunResult(ctx, component, configMapName)
+	if err != nil {
+		return requeueWithError(fmt.Errorf("failed to link dry-run result of component %s: %w", component.Spec.Name, err))
+	}
+
+	r.recorder.Eventf(component, corev1.EventTypeNormal, DryRunEventReason, "Dry-run of %s to version %s: %s. See config map %s.",
+		op, resultData[dryRunVersionKey], resultData[dryRunSummaryKey], configMapName)
+
+	return finishOperation()
+}
+
+// renderDryRun renders the chart like the given operation would do and returns the data of the result config map.
+func (r *ComponentReconciler) renderDryRun(ctx context.Context, component *k8sv1.Component, hc helmClient, op operation) (map[string]string, error) {
+	version, err := getDryRunVersion(hc, component)
+	if err != nil {
+		return nil, err
+	}
+
+	chartSpec, err := helm.GetHelmChartSpec(ctx, withVersion(component, version), helm.HelmChartCreationOpts{
+		HelmClient:     hc,
+		Timeout:        r.timeout,
+		YamlSerializer: r.yamlSerializer,
+		Reader:         r.reader,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get helm chart spec: %w", err)
+	}
+
+	// create a new context that does not get canceled immediately on SIGTERM
+	helmCtx := context.WithoutCancel(ctx)
+
+	renderedManifest, err := hc.DryRunInstallOrUpgrade(helmCtx, chartSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	deployedManifest := ""
+	deployedRelease, err := hc.GetRelease(component.Spec.Name)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, fmt.Errorf("failed to get deployed release: %w", err)
+	}
+	if deployedRelease != nil {
+		deployedManifest = deployedRelease.Manifest
+	}
+
+	diff, err := helm.DiffManifests(deployedManifest, renderedManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff manifests: %w", err)
+	}
+
+	return map[string]string{
+		dryRunOperationKey:        string(op),
+		dryRunVersionKey:          version,
+		dryRunInstalledVersionKey: component.Status.InstalledVersion,
+		dryRunSummaryKey:          diff.Summary(),
+		dryRunDiffKey:             diff.String(),
+	}, nil
+}
+
+// getDryRunVersion resolves the version which the operation would install. The latest version is used if the
+// component does not specify one.
+func getDryRunVersion(hc helmClient, component *k8sv1.Component) (string, error) {
+	if component.Spec.Version == "" {
+		version, err := hc.GetLatestVersion(helm.GetHelmChartName(component))
+		if err != nil {
+			return "", fmt.Errorf("failed to get latest version for component %q: %w", component.Spec.Name, err)
+		}
+
+		return version, nil
+	}
+
+	return resolveComponentVersion(hc, component, component.Status.InstalledVersion)
+}
+
+// updateDryRunResult references the config map with the result of the last dry-run in the status of the component.
+func (r *ComponentReconciler) updateDryRunResult(ctx context.Context, component *k8sv1.Component, configMapName string) error {
+	if component.Status.DryRunResult == configMapName {
+		return nil
+	}
+
+	componentClient := r.clientSet.ComponentV1Alpha1().Components(component.Namespace)
+	return retry.OnConflict(func() error {
+		currentComponent, err := componentClient.Get(ctx, component.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		currentComponent.Status.DryRunResult = configMapName
+		updatedComponent, err := componentClient.UpdateStatus(ctx, currentComponent, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		*component = *updatedComponent
+		return nil
+	})
+}
+
+// storeDryRunResult creates or updates the config map with the dry-run result. The config map is owned by the
+// component so that it is removed together with it. An existing config map which is not owned by the component is
+// never overwritten.
+func (r *ComponentReconciler) storeDryRunResult(ctx context.Context, component *k8sv1.Component, configMapName string, data map[string]string) error {
+	return retry.OnConflict(func() error {
+		configMap, err := r.configMapInterface.Get(ctx, configMapName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			_, err = r.configMapInterface.Create(ctx, newDryRunConfigMap(component, configMapName, data), metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if !isOwnedBy(configMap, component) {
+			return fmt.Errorf("config map %s already exists and is not owned by component %s", configMapName, component.Spec.Name)
+		}
+
+		configMap.Data = data
+		_, err = r.configMapInterface.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func newDryRunConfigMap(component *k8sv1.Component, configMapName string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   configMapName,
+			Labels: map[string]string{k8sv1.ComponentNameLabelKey: component.Spec.Name},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: k8sv1.GroupVersion.String(),
+				Kind:       componentKind,
+				Name:       component.Name,
+				UID:        component.UID,
+			}},
+		},
+		Data: data,
+	}
+}
+
+func isOwnedBy(configMap *corev1.ConfigMap, component *k8sv1.Component) bool {
+	for _, ownerReference := range configMap.OwnerReferences {
+		if ownerReference.UID == component.UID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+)
+
+const (
+	testDeployedManifest = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  level: info\n"
+	testRenderedManifest = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  level: debug\n"
+)
+
+func Test_isDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   operation
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "should dry-run installation", operation: Install, annotations: map[string]string{annotations.DryRunAnnotation: "true"}, want: true},
+		{name: "should dry-run upgrade", operation: Upgrade, annotations: map[string]string{annotations.DryRunAnnotation: "true"}, want: true},
+		{name: "should dry-run downgrade", operation: Downgrade, annotations: map[string]string{annotations.DryRunAnnotation: "true"}, want: true},
+		{name: "should not dry-run deletion", operation: Delete, annotations: map[string]string{annotations.DryRunAnnotation: "true"}, want: false},
+		{name: "should not dry-run ignored component", operation: Ignore, annotations: map[string]string{annotations.DryRunAnnotation: "true"}, want: false},
+		{name: "should not dry-run without annotation", operation: Upgrade, want: false},
+		{name: "should not dry-run with disabled annotation", operation: Upgrade, annotations: map[string]string{annotations.DryRunAnnotation: "false"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0")
+			component.Annotations = tt.annotations
+
+			assert.Equal(t, tt.want, isDryRun(component, tt.operation))
+		})
+	}
+}
+
+func newDryRunComponent() *k8sv1.Component {
+	component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0")
+	component.UID = "uid"
+	component.Annotations = map[string]string{annotations.DryRunAnnotation: "true"}
+	component.Status.Status = k8sv1.ComponentStatusInstalled
+	component.Status.InstalledVersion = "0.1.0"
+	return component
+}
+
+func newDryRunReconciler(t *testing.T) (*ComponentReconciler, *mockHelmClient, *mockConfigMapInterface, *mockEventRecorder) {
+	readerMock := newMockConfigMapRefReader(t)
+	readerMock.EXPECT().GetValues(testCtx, (*k8sv1.Reference)(nil)).Return("", nil).Maybe()
+	configMapMock := newMockConfigMapInterface(t)
+	recorderMock := newMockEventRecorder(t)
+
+	sut := &ComponentReconciler{
+		recorder:           recorderMock,
+		reader:             readerMock,
+		configMapInterface: configMapMock,
+	}
+
+	return sut, newMockHelmClient(t), configMapMock, recorderMock
+}
+
+func TestComponentReconciler_performDryRun(t *testing.T) {
+	t.Run("should store diff of upgrade in new config map", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		sut, helmClientMock, configMapMock, recorderMock := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.MatchedBy(func(spec *client.ChartSpec) bool {
+			return spec.ReleaseName == "dogu-op" && spec.Version == "0.2.0" && spec.PostRenderer != nil
+		})).Return(testRenderedManifest, nil)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(&release.Release{Manifest: testDeployedManifest}, nil)
+
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-dry-run", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "dogu-op-dry-run"))
+		configMapMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).RunAndReturn(func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.CreateOptions) (*corev1.ConfigMap, error) {
+			assert.Equal(t, "dogu-op-dry-run", configMap.Name)
+			assert.Equal(t, "Component", configMap.OwnerReferences[0].Kind)
+			assert.Equal(t, "dogu-op", configMap.OwnerReferences[0].Name)
+			assert.Equal(t, "Upgrade", configMap.Data["operation"])
+			assert.Equal(t, "0.2.0", configMap.Data["version"])
+			assert.Equal(t, "0.1.0", configMap.Data["installedVersion"])
+			assert.Equal(t, "0 added, 1 changed, 0 removed, 0 unchanged", configMap.Data["summary"])
+			assert.Contains(t, configMap.Data["diff"], "-  level: info\n+  level: debug\n")
+			return configMap, nil
+		})
+
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(component.DeepCopy(), nil)
+		componentClientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(returnUpdatedComponent)
+		sut.clientSet = newComponentClientSetMock(t, componentClientMock)
+
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeNormal, DryRunEventReason, "Dry-run of %s to version %s: %s. See config map %s.",
+			Upgrade, "0.2.0", "0 added, 1 changed, 0 removed, 0 unchanged", "dogu-op-dry-run")
+
+		// when
+		result, err := sut.performDryRun(testCtx, component, helmClientMock, Upgrade)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+		assert.Equal(t, "dogu-op-dry-run", component.Status.DryRunResult)
+	})
+	t.Run("should update existing config map with diff of installation", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		component.Status.Status = k8sv1.ComponentStatusNotInstalled
+		component.Status.InstalledVersion = ""
+		component.Status.DryRunResult = "dogu-op-dry-run"
+		sut, helmClientMock, configMapMock, recorderMock := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.Anything).Return(testRenderedManifest, nil)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, driver.ErrReleaseNotFound)
+
+		existingConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "dogu-op-dry-run", OwnerReferences: []metav1.OwnerReference{{UID: "uid"}}},
+			Data:       map[string]string{"summary": "old"},
+		}
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-dry-run", metav1.GetOptions{}).Return(existingConfigMap, nil)
+		configMapMock.EXPECT().Update(testCtx, existingConfigMap, metav1.UpdateOptions{}).Return(existingConfigMap, nil)
+
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeNormal, DryRunEventReason, "Dry-run of %s to version %s: %s. See config map %s.",
+			Install, "0.2.0", "1 added, 0 changed, 0 removed, 0 unchanged", "dogu-op-dry-run")
+
+		// when
+		_, err := sut.performDryRun(testCtx, component, helmClientMock, Install)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1 added, 0 changed, 0 removed, 0 unchanged", existingConfigMap.Data["summary"])
+		assert.Equal(t, "Install", existingConfigMap.Data["operation"])
+	})
+	t.Run("should not overwrite config map which is not owned by the component", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		sut, helmClientMock, configMapMock, _ := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.Anything).Return(testRenderedManifest, nil)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(&release.Release{Manifest: testDeployedManifest}, nil)
+
+		foreignConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "dogu-op-dry-run", OwnerReferences: []metav1.OwnerReference{{UID: "other"}}},
+			Data:       map[string]string{"level": "info"},
+		}
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-dry-run", metav1.GetOptions{}).Return(foreignConfigMap, nil)
+
+		// when
+		_, err := sut.performDryRun(testCtx, component, helmClientMock, Upgrade)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "config map dogu-op-dry-run already exists and is not owned by component dogu-op")
+		assert.Equal(t, map[string]string{"level": "info"}, foreignConfigMap.Data)
+	})
+	t.Run("should fail to link dry-run result", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		sut, helmClientMock, configMapMock, _ := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.Anything).Return(testRenderedManifest, nil)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(&release.Release{Manifest: testDeployedManifest}, nil)
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-dry-run", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "dogu-op-dry-run"))
+		configMapMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).RunAndReturn(func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.CreateOptions) (*corev1.ConfigMap, error) {
+			return configMap, nil
+		})
+
+		componentClientMock := newMockComponentInterface(t)
+		componentClientMock.EXPECT().Get(testCtx, "dogu-op", metav1.GetOptions{}).Return(nil, assert.AnError)
+		sut.clientSet = newComponentClientSetMock(t, componentClientMock)
+
+		// when
+		_, err := sut.performDryRun(testCtx, component, helmClientMock, Upgrade)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to link dry-run result of component dogu-op")
+	})
+	t.Run("should fail to render chart", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		sut, helmClientMock, _, recorderMock := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.Anything).Return("", assert.AnError)
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeWarning, DryRunEventReason, "Dry-run of %s failed: %s", Upgrade, assert.AnError.Error())
+
+		// when
+		_, err := sut.performDryRun(testCtx, component, helmClientMock, Upgrade)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to perform dry-run of Upgrade for component dogu-op")
+	})
+	t.Run("should fail to get deployed release", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		sut, helmClientMock, _, recorderMock := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.Anything).Return(testRenderedManifest, nil)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, assert.AnError)
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeWarning, DryRunEventReason, "Dry-run of %s failed: %s", Upgrade, mock.Anything)
+
+		// when
+		_, err := sut.performDryRun(testCtx, component, helmClientMock, Upgrade)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get deployed release")
+	})
+	t.Run("should fail to store result", func(t *testing.T) {
+		// given
+		component := newDryRunComponent()
+		sut, helmClientMock, configMapMock, _ := newDryRunReconciler(t)
+
+		helmClientMock.EXPECT().DryRunInstallOrUpgrade(mock.Anything, mock.Anything).Return(testRenderedManifest, nil)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(&release.Release{Manifest: testDeployedManifest}, nil)
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-dry-run", metav1.GetOptions{}).Return(nil, assert.AnError)
+
+		// when
+		_, err := sut.performDryRun(testCtx, component, helmClientMock, Upgrade)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to store dry-run result of component dogu-op")
+	})
+}
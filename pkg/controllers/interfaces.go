@@ -7,6 +7,7 @@ import (
 	"github.com/cloudogu/k8s-component-operator/pkg/health"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	appsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
@@ -17,6 +18,8 @@ import (
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
 	componentClient "github.com/cloudogu/k8s-component-lib/client"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
 )
 
 // installManager includes functionality to install components in the cluster.
@@ -37,6 +40,24 @@ type upgradeManager interface {
 	Upgrade(ctx context.Context, component *k8sv1.Component) error
 }
 
+// downgradeManager includes functionality to downgrade components in the cluster.
+type downgradeManager interface {
+	// Downgrade downgrades a component resource.
+	Downgrade(ctx context.Context, component *k8sv1.Component) error
+}
+
+// migrateManager includes functionality to move components to another deploy namespace.
+type migrateManager interface {
+	// Migrate migrates a component resource to its deploy namespace.
+	Migrate(ctx context.Context, component *k8sv1.Component) error
+}
+
+// conditionWriter persists the conditions of components.
+type conditionWriter interface {
+	// Update sets the given conditions on the component and updates it in the cluster if any of them changed.
+	Update(ctx context.Context, component *k8sv1.Component, conditions []metav1.Condition) error
+}
+
 type healthManager interface {
 	health.ComponentManager
 }
@@ -61,12 +82,19 @@ type componentManagerFactory interface {
 type helmClient interface {
 	// InstallOrUpgrade takes a helmChart and applies it.
 	InstallOrUpgrade(ctx context.Context, chart *client.ChartSpec) error
+	// DryRunInstallOrUpgrade renders the given helmChart with a server-side dry-run and returns the rendered manifest.
+	// The release itself is not changed.
+	DryRunInstallOrUpgrade(ctx context.Context, chart *client.ChartSpec) (string, error)
 	// Uninstall removes the helmRelease for the given name
 	Uninstall(releaseName string) error
 	// ListDeployedReleases returns all deployed helm releases
 	ListDeployedReleases() ([]*release.Release, error)
 	ListReleasesByStateMask(action.ListStates) ([]*release.Release, error)
 	GetRelease(name string) (*release.Release, error)
+	// GetReleaseHistory returns all stored revisions of the release with the given name.
+	GetReleaseHistory(name string) ([]*release.Release, error)
+	// RollbackRelease rolls the release of the given chart back to the given revision.
+	RollbackRelease(chart *client.ChartSpec, revision int) error
 	// GetReleaseValues returns the (optionally, all computed) values for the specified release.
 	GetReleaseValues(name string, allValues bool) (map[string]interface{}, error)
 	// GetReleaseVersion returns the version for the specified release (if the release exists).
@@ -77,13 +105,32 @@ type helmClient interface {
 	// indicates that all dependencies (if any) meet the requirements, so that the client may conduct an installation or
 	// upgrade.
 	SatisfiesDependencies(ctx context.Context, chart *client.ChartSpec) error
+	// SatisfiesDependents validates that the given version of the component satisfies the version requirements of all
+	// installed components depending on it. A nil error indicates that the component can be upgraded to this version.
+	SatisfiesDependents(ctx context.Context, componentName string, version string) error
 	// GetLatestVersion tries to get the latest version identifier for the chart with the given name.
 	GetLatestVersion(chartName string) (string, error)
+	// ResolveVersion returns the newest version of the chart with the given name matching the selector.
+	ResolveVersion(chartName string, selector version.Selector, installedVersion string) (string, error)
 	// GetChart returns the helm chart for a chart spec
 	GetChart(ctx context.Context, spec *client.ChartSpec) (*chart.Chart, error)
+	// PullChart pulls the chart and returns the sha256 digest of its archive. The chart name of the spec is replaced by
+	// the path of the archive, so that InstallOrUpgrade applies this archive without pulling the chart again.
+	PullChart(ctx context.Context, spec *client.ChartSpec) (string, error)
 	MarkReleaseAsFailed(name string, reason string) error
 }
 
+// operationJournal persists the helm actions in flight so that actions interrupted by a crash of the operator can be
+// recovered.
+type operationJournal interface {
+	// Begin records that a helm action for the given component is about to start.
+	Begin(ctx context.Context, component string, entry journal.Entry) error
+	// Complete removes the entry of the given component after its helm action finished.
+	Complete(ctx context.Context, component string) error
+	// Get returns the entry of the given component or nil if the component has no helm action in flight.
+	Get(ctx context.Context, component string) (*journal.Entry, error)
+}
+
 // eventRecorder embeds the record.EventRecorder interface for usage in this package.
 type eventRecorder interface {
 	record.EventRecorder
@@ -125,8 +172,6 @@ type configMapRefReader interface {
 	GetValues(ctx context.Context, configMapReference *k8sv1.Reference) (string, error)
 }
 
-//nolint:unused
-//goland:noinspection GoUnusedType
 type coreV1Interface interface {
 	corev1.CoreV1Interface
 }
@@ -136,3 +181,27 @@ type coreV1Interface interface {
 type configMapInterface interface {
 	corev1.ConfigMapInterface
 }
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type namespaceInterface interface {
+	corev1.NamespaceInterface
+}
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type secretInterface interface {
+	corev1.SecretInterface
+}
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type persistentVolumeClaimInterface interface {
+	corev1.PersistentVolumeClaimInterface
+}
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type persistentVolumeInterface interface {
+	corev1.PersistentVolumeInterface
+}
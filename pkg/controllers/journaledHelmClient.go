@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	helmRelease "helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+// journaledHelmClient records every helm action changing a release in the operation journal before the action is
+// started and removes the entry after the action finished. An entry which remains in the journal therefore belongs to
+// an action which was interrupted, e.g. by a crash of the operator.
+type journaledHelmClient struct {
+	helmClient
+	journal operationJournal
+	now     func() time.Time
+}
+
+func newJournaledHelmClient(helmClient helmClient, journal operationJournal) *journaledHelmClient {
+	return &journaledHelmClient{
+		helmClient: helmClient,
+		journal:    journal,
+		now:        time.Now,
+	}
+}
+
+// InstallOrUpgrade pulls the chart and records the installation, upgrade or downgrade with the digest of the pulled
+// archive before applying it.
+func (c *journaledHelmClient) InstallOrUpgrade(ctx context.Context, chart *client.ChartSpec) error {
+	entry, release, err := c.newEntry(chart.ReleaseName, chart.Namespace)
+	if err != nil {
+		return err
+	}
+
+	entry.Operation = getInstallOperation(getDeployedVersion(release), chart.Version)
+	entry.TargetVersion = chart.Version
+	chartName := chart.ChartName
+	entry.ChartDigest, err = c.PullChart(ctx, chart)
+	if err != nil {
+		return fmt.Errorf("failed to pull chart %s: %w", chartName, err)
+	}
+
+	return c.run(ctx, chart.ReleaseName, entry, func() error {
+		return c.helmClient.InstallOrUpgrade(ctx, chart)
+	})
+}
+
+// RollbackRelease records the rollback to the given revision before rolling back.
+func (c *journaledHelmClient) RollbackRelease(chart *client.ChartSpec, revision int) error {
+	entry, _, err := c.newEntry(chart.ReleaseName, chart.Namespace)
+	if err != nil {
+		return err
+	}
+
+	entry.Operation = journal.OperationRollback
+	entry.TargetVersion = chart.Version
+	entry.TargetRevision = revision
+
+	// the helm client does not support a context for rollbacks
+	return c.run(context.Background(), chart.ReleaseName, entry, func() error {
+		return c.helmClient.RollbackRelease(chart, revision)
+	})
+}
+
+// Uninstall records the uninstallation before uninstalling the release.
+func (c *journaledHelmClient) Uninstall(releaseName string) error {
+	entry, _, err := c.newEntry(releaseName, "")
+	if err != nil {
+		return err
+	}
+
+	entry.Operation = journal.OperationUninstall
+
+	// the helm client does not support a context for uninstallations
+	return c.run(context.Background(), releaseName, entry, func() error {
+		return c.helmClient.Uninstall(releaseName)
+	})
+}
+
+// newEntry creates a journal entry with the current revision of the release. The release is nil if it does not exist.
+func (c *journaledHelmClient) newEntry(releaseName string, namespace string) (journal.Entry, *helmRelease.Release, error) {
+	entry := journal.Entry{
+		Namespace: namespace,
+		StartedAt: metav1.NewTime(c.now()),
+	}
+
+	release, err := c.GetRelease(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return entry, nil, nil
+	}
+	if err != nil {
+		return entry, nil, &genericRequeueableError{fmt.Sprintf("failed to get release %s before recording it in the journal", releaseName), err}
+	}
+
+	entry.Revision = release.Version
+	if entry.Namespace == "" {
+		entry.Namespace = release.Namespace
+	}
+
+	return entry, release, nil
+}
+
+// getDeployedVersion returns the chart version of the release or an empty string if the release is not installed.
+func getDeployedVersion(release *helmRelease.Release) string {
+	if release == nil || release.Info == nil || release.Info.Status == helmRelease.StatusUninstalled || release.Chart == nil || release.Chart.Metadata == nil {
+		return ""
+	}
+
+	return release.Chart.Metadata.Version
+}
+
+// getInstallOperation determines whether applying the target version installs, upgrades or downgrades the release.
+func getInstallOperation(installedVersion string, targetVersion string) journal.Operation {
+	if installedVersion == "" {
+		return journal.OperationInstall
+	}
+
+	installed, err := version.Parse(installedVersion)
+	if err != nil {
+		return journal.OperationUpgrade
+	}
+
+	target, err := version.Parse(targetVersion)
+	if err == nil && target.IsOlderThan(installed) {
+		return journal.OperationDowngrade
+	}
+
+	return journal.OperationUpgrade
+}
+
+// run records the entry, executes the helm action and removes the entry afterward. A failure to remove the entry is
+// only logged as the recovery detects finished actions by the release revision.
+func (c *journaledHelmClient) run(ctx context.Context, releaseName string, entry journal.Entry, action func() error) error {
+	err := c.journal.Begin(ctx, releaseName, entry)
+	if err != nil {
+		return err
+	}
+
+	actionErr := action()
+
+	err = c.journal.Complete(ctx, releaseName)
+	if err != nil {
+		log.FromContext(ctx).Error(err, fmt.Sprintf("failed to complete %s of release %s in journal", entry.Operation, releaseName))
+	}
+
+	return actionErr
+}
@@ -0,0 +1,233 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
+)
+
+var testJournalTime = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func getDeployedRevision(revision int, version string) *release.Release {
+	return &release.Release{
+		Name:      "dogu-op",
+		Namespace: testNamespace,
+		Version:   revision,
+		Info:      &release.Info{Status: release.StatusDeployed},
+		Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "dogu-op", Version: version}},
+	}
+}
+
+func newTestJournaledHelmClient(helmClientMock *mockHelmClient, journalMock *mockOperationJournal) *journaledHelmClient {
+	sut := newJournaledHelmClient(helmClientMock, journalMock)
+	sut.now = func() time.Time { return testJournalTime }
+	return sut
+}
+
+func Test_journaledHelmClient_InstallOrUpgrade(t *testing.T) {
+	tests := []struct {
+		name          string
+		release       *release.Release
+		targetVersion string
+		wantOperation journal.Operation
+		wantRevision  int
+	}{
+		{name: "should record installation", targetVersion: "0.2.0", wantOperation: journal.OperationInstall},
+		{name: "should record upgrade", release: getDeployedRevision(3, "0.1.0"), targetVersion: "0.2.0", wantOperation: journal.OperationUpgrade, wantRevision: 3},
+		{name: "should record reinstallation as upgrade", release: getDeployedRevision(3, "0.2.0"), targetVersion: "0.2.0", wantOperation: journal.OperationUpgrade, wantRevision: 3},
+		{name: "should record downgrade", release: getDeployedRevision(3, "0.3.0"), targetVersion: "0.2.0", wantOperation: journal.OperationDowngrade, wantRevision: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Namespace: testNamespace, Version: tt.targetVersion}
+
+			helmClientMock := newMockHelmClient(t)
+			if tt.release == nil {
+				helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, driver.ErrReleaseNotFound)
+			} else {
+				helmClientMock.EXPECT().GetRelease("dogu-op").Return(tt.release, nil)
+			}
+			helmClientMock.EXPECT().PullChart(testCtx, chartSpec).Return("sha256:abc", nil)
+
+			journalMock := newMockOperationJournal(t)
+			journalMock.EXPECT().Begin(testCtx, "dogu-op", journal.Entry{
+				Operation:     tt.wantOperation,
+				Namespace:     testNamespace,
+				TargetVersion: tt.targetVersion,
+				ChartDigest:   "sha256:abc",
+				StartedAt:     metav1.NewTime(testJournalTime),
+				Revision:      tt.wantRevision,
+			}).Return(nil)
+			beginCall := journalMock.Mock.ExpectedCalls[0]
+			helmClientMock.EXPECT().InstallOrUpgrade(testCtx, chartSpec).Return(nil).NotBefore(beginCall)
+			journalMock.EXPECT().Complete(testCtx, "dogu-op").Return(nil)
+
+			sut := newTestJournaledHelmClient(helmClientMock, journalMock)
+
+			// when
+			err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+			// then
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("should complete entry if helm action fails", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "0.2.0"}
+
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getDeployedRevision(3, "0.1.0"), nil)
+		helmClientMock.EXPECT().PullChart(testCtx, chartSpec).Return("sha256:abc", nil)
+		helmClientMock.EXPECT().InstallOrUpgrade(testCtx, chartSpec).Return(assert.AnError)
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Begin(testCtx, "dogu-op", mock.Anything).Return(nil)
+		journalMock.EXPECT().Complete(testCtx, "dogu-op").Return(nil)
+
+		sut := newTestJournaledHelmClient(helmClientMock, journalMock)
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should ignore failure to complete entry", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "0.2.0"}
+
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getDeployedRevision(3, "0.1.0"), nil)
+		helmClientMock.EXPECT().PullChart(testCtx, chartSpec).Return("sha256:abc", nil)
+		helmClientMock.EXPECT().InstallOrUpgrade(testCtx, chartSpec).Return(nil)
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Begin(testCtx, "dogu-op", mock.Anything).Return(nil)
+		journalMock.EXPECT().Complete(testCtx, "dogu-op").Return(assert.AnError)
+
+		sut := newTestJournaledHelmClient(helmClientMock, journalMock)
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should not start helm action if entry cannot be recorded", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "0.2.0"}
+
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getDeployedRevision(3, "0.1.0"), nil)
+		helmClientMock.EXPECT().PullChart(testCtx, chartSpec).Return("sha256:abc", nil)
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Begin(testCtx, "dogu-op", mock.Anything).Return(assert.AnError)
+
+		sut := newTestJournaledHelmClient(helmClientMock, journalMock)
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should fail to pull chart", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "0.2.0"}
+
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, driver.ErrReleaseNotFound)
+		helmClientMock.EXPECT().PullChart(testCtx, chartSpec).Return("", assert.AnError)
+
+		sut := newTestJournaledHelmClient(helmClientMock, newMockOperationJournal(t))
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to pull chart k8s/dogu-op")
+	})
+
+	t.Run("should fail to get release", func(t *testing.T) {
+		// given
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, assert.AnError)
+
+		sut := newTestJournaledHelmClient(helmClientMock, newMockOperationJournal(t))
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, &client.ChartSpec{ReleaseName: "dogu-op"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get release dogu-op before recording it in the journal")
+	})
+}
+
+func Test_journaledHelmClient_RollbackRelease(t *testing.T) {
+	// given
+	chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", Namespace: testNamespace, Version: "0.1.0"}
+
+	helmClientMock := newMockHelmClient(t)
+	helmClientMock.EXPECT().GetRelease("dogu-op").Return(getDeployedRevision(4, "0.2.0"), nil)
+	helmClientMock.EXPECT().RollbackRelease(chartSpec, 3).Return(nil)
+	journalMock := newMockOperationJournal(t)
+	journalMock.EXPECT().Begin(mock.Anything, "dogu-op", journal.Entry{
+		Operation:      journal.OperationRollback,
+		Namespace:      testNamespace,
+		TargetVersion:  "0.1.0",
+		StartedAt:      metav1.NewTime(testJournalTime),
+		Revision:       4,
+		TargetRevision: 3,
+	}).Return(nil)
+	journalMock.EXPECT().Complete(mock.Anything, "dogu-op").Return(nil)
+
+	sut := newTestJournaledHelmClient(helmClientMock, journalMock)
+
+	// when
+	err := sut.RollbackRelease(chartSpec, 3)
+
+	// then
+	require.NoError(t, err)
+}
+
+func Test_journaledHelmClient_Uninstall(t *testing.T) {
+	// given
+	helmClientMock := newMockHelmClient(t)
+	helmClientMock.EXPECT().GetRelease("dogu-op").Return(getDeployedRevision(4, "0.2.0"), nil)
+	helmClientMock.EXPECT().Uninstall("dogu-op").Return(nil)
+	journalMock := newMockOperationJournal(t)
+	journalMock.EXPECT().Begin(mock.Anything, "dogu-op", journal.Entry{
+		Operation: journal.OperationUninstall,
+		Namespace: testNamespace,
+		StartedAt: metav1.NewTime(testJournalTime),
+		Revision:  4,
+	}).Return(nil)
+	journalMock.EXPECT().Complete(mock.Anything, "dogu-op").Return(nil)
+
+	sut := newTestJournaledHelmClient(helmClientMock, journalMock)
+
+	// when
+	err := sut.Uninstall("dogu-op")
+
+	// then
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
+)
+
+// ScheduledEventReason is the reason of events about operations waiting for the maintenance window.
+const ScheduledEventReason = "Scheduled"
+
+// getScheduledStart returns the next start of the maintenance window if the given operation must wait for it.
+// Only upgrades, downgrades, migrations and deletions of installed components are held. Installations, operations which have
+// already started and components with the EmergencyOperationAnnotation are never held.
+func (r *ComponentReconciler) getScheduledStart(component *k8sv1.Component, op operation) (*time.Time, error) {
+	if op != Upgrade && op != Downgrade && op != Delete && op != Migrate {
+		return nil, nil
+	}
+
+	if component.Status.Status != k8sv1.ComponentStatusInstalled {
+		return nil, nil
+	}
+
+	if annotations.IsTrue(component, annotations.EmergencyOperationAnnotation) {
+		return nil, nil
+	}
+
+	window, err := maintenance.GetWindow(component, r.maintenanceWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	if window.IsAlwaysOpen() {
+		return nil, nil
+	}
+
+	now := r.now()
+	if window.IsOpen(now) {
+		return nil, nil
+	}
+
+	nextStart := window.NextStart(now)
+	return &nextStart, nil
+}
+
+// scheduleOperation sets the progressing condition with the reason scheduled and the next start of the maintenance
+// window in its message and requeues the component for that time. The status of the component stays installed.
+func (r *ComponentReconciler) scheduleOperation(ctx context.Context, component *k8sv1.Component, op operation, nextStart time.Time) (ctrl.Result, error) {
+	scheduledAt := nextStart.Format(time.RFC3339)
+	log.FromContext(ctx).Info(fmt.Sprintf("%s of component %s is scheduled for the maintenance window starting at %s", op, component.Spec.Name, scheduledAt))
+
+	message := fmt.Sprintf("%s is scheduled for the maintenance window starting at %s", op, scheduledAt)
+	if progressing := conditions.Find(component, conditions.TypeProgressing); progressing == nil ||
+		progressing.Reason != conditions.ReasonScheduled || progressing.Message != message {
+		r.recorder.Eventf(component, corev1.EventTypeNormal, ScheduledEventReason, "%s is scheduled for the maintenance window starting at %s.", op, scheduledAt)
+	}
+
+	err := r.conditionWriter.Update(ctx, component, []metav1.Condition{
+		newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonScheduled, message),
+	})
+	if err != nil {
+		return requeueWithError(fmt.Errorf("failed to schedule %s of component %s: %w", op, component.Spec.Name, err))
+	}
+
+	return requeueOrFinishOperation(ctrl.Result{RequeueAfter: nextStart.Sub(r.now())})
+}
+
+// clearSchedule cancels the schedule of the component if no operation is required anymore. Other operations replace
+// the scheduled progressing condition themselves.
+func (r *ComponentReconciler) clearSchedule(ctx context.Context, component *k8sv1.Component, op operation) error {
+	if op != Ignore {
+		return nil
+	}
+
+	progressing := conditions.Find(component, conditions.TypeProgressing)
+	if progressing == nil || progressing.Reason != conditions.ReasonScheduled {
+		return nil
+	}
+
+	err := r.conditionWriter.Update(ctx, component, []metav1.Condition{
+		newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonCanceled, "The scheduled operation is no longer required"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clear schedule of component %s: %w", component.Spec.Name, err)
+	}
+
+	return nil
+}
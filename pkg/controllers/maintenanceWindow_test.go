@@ -0,0 +1,265 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
+)
+
+// 2026-10-16 is a Friday.
+var testMaintenanceNow = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+var testNextWindowStart = time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+func newMaintenanceWindowReconciler(t *testing.T, rawWindow string) *ComponentReconciler {
+	window, err := maintenance.ParseWindow(rawWindow)
+	require.NoError(t, err)
+
+	return &ComponentReconciler{
+		maintenanceWindow: window,
+		now:               func() time.Time { return testMaintenanceNow },
+	}
+}
+
+func newComponentClientSetMock(t *testing.T, componentClient componentInterface) *mockComponentEcosystemInterface {
+	componentClientGetterMock := newMockComponentV1Alpha1Interface(t)
+	componentClientGetterMock.EXPECT().Components(testNamespace).Return(componentClient)
+	clientSetMock := newMockComponentEcosystemInterface(t)
+	clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
+	return clientSetMock
+}
+
+func TestComponentReconciler_getScheduledStart(t *testing.T) {
+	tests := []struct {
+		name        string
+		window      string
+		operation   operation
+		status      string
+		annotations map[string]string
+		want        *time.Time
+		wantErr     string
+	}{
+		{name: "should hold upgrade outside the window", window: "Sat,Sun 00:00-24:00", operation: Upgrade, status: k8sv1.ComponentStatusInstalled, want: &testNextWindowStart},
+		{name: "should hold downgrade outside the window", window: "Sat,Sun 00:00-24:00", operation: Downgrade, status: k8sv1.ComponentStatusInstalled, want: &testNextWindowStart},
+		{name: "should hold deletion outside the window", window: "Sat,Sun 00:00-24:00", operation: Delete, status: k8sv1.ComponentStatusInstalled, want: &testNextWindowStart},
+		{name: "should hold upgrade outside the window of the annotation", window: "", operation: Upgrade, status: k8sv1.ComponentStatusInstalled, annotations: map[string]string{annotations.MaintenanceWindowAnnotation: "0 0 * * Sat 48h"}, want: &testNextWindowStart},
+		{name: "should not hold upgrade within the window", window: "Fri 10:00-14:00", operation: Upgrade, status: k8sv1.ComponentStatusInstalled},
+		{name: "should not hold upgrade without window", window: "", operation: Upgrade, status: k8sv1.ComponentStatusInstalled},
+		{name: "should not hold installation", window: "Sat,Sun 00:00-24:00", operation: Install, status: k8sv1.ComponentStatusNotInstalled},
+		{name: "should not hold upgrade which has already started", window: "Sat,Sun 00:00-24:00", operation: Upgrade, status: k8sv1.ComponentStatusUpgrading},
+		{name: "should not hold emergency upgrade", window: "Sat,Sun 00:00-24:00", operation: Upgrade, status: k8sv1.ComponentStatusInstalled, annotations: map[string]string{annotations.EmergencyOperationAnnotation: "true"}},
+		{name: "should fail for invalid window of the annotation", window: "", operation: Upgrade, status: k8sv1.ComponentStatusInstalled, annotations: map[string]string{annotations.MaintenanceWindowAnnotation: "invalid"}, wantErr: "failed to parse maintenance window"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+			component.Status.Status = tt.status
+			component.Annotations = tt.annotations
+			sut := newMaintenanceWindowReconciler(t, tt.window)
+
+			// when
+			got, err := sut.getScheduledStart(component, tt.operation)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestComponentReconciler_scheduleOperation(t *testing.T) {
+	t.Run("should set scheduled condition and requeue for the start of the window", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+
+		conditionWriterMock := newMockConditionWriter(t)
+		conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).RunAndReturn(func(_ context.Context, c *k8sv1.Component, newConditions []metav1.Condition) error {
+			conditions.Set(c, newConditions...)
+			return nil
+		})
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", ScheduledEventReason, "%s is scheduled for the maintenance window starting at %s.", Upgrade, "2026-10-17T00:00:00Z")
+
+		sut := newMaintenanceWindowReconciler(t, "Sat,Sun 00:00-24:00")
+		sut.conditionWriter = conditionWriterMock
+		sut.recorder = recorderMock
+
+		// when
+		result, err := sut.scheduleOperation(testCtx, component, Upgrade, testNextWindowStart)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{RequeueAfter: 12 * time.Hour}, result)
+		assert.Equal(t, k8sv1.ComponentStatusInstalled, component.Status.Status)
+		progressing := conditions.Find(component, conditions.TypeProgressing)
+		require.NotNil(t, progressing)
+		assert.Equal(t, metav1.ConditionFalse, progressing.Status)
+		assert.Equal(t, conditions.ReasonScheduled, progressing.Reason)
+		assert.Equal(t, "Upgrade is scheduled for the maintenance window starting at 2026-10-17T00:00:00Z", progressing.Message)
+	})
+
+	t.Run("should not send event again for the same window", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+		conditions.Set(component, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonScheduled,
+			"Upgrade is scheduled for the maintenance window starting at 2026-10-17T00:00:00Z"))
+
+		sut := newMaintenanceWindowReconciler(t, "Sat,Sun 00:00-24:00")
+		sut.conditionWriter = newConditionWriterMock(t)
+		sut.recorder = newMockEventRecorder(t)
+
+		// when
+		result, err := sut.scheduleOperation(testCtx, component, Upgrade, testNextWindowStart)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{RequeueAfter: 12 * time.Hour}, result)
+	})
+
+	t.Run("should send event again for a later window", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+		conditions.Set(component, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonScheduled,
+			"Upgrade is scheduled for the maintenance window starting at 2026-10-10T00:00:00Z"))
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", ScheduledEventReason, "%s is scheduled for the maintenance window starting at %s.", Upgrade, "2026-10-17T00:00:00Z")
+
+		sut := newMaintenanceWindowReconciler(t, "Sat,Sun 00:00-24:00")
+		sut.conditionWriter = newConditionWriterMock(t)
+		sut.recorder = recorderMock
+
+		// when
+		_, err := sut.scheduleOperation(testCtx, component, Upgrade, testNextWindowStart)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail to update conditions", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+
+		conditionWriterMock := newMockConditionWriter(t)
+		conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).Return(assert.AnError)
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", ScheduledEventReason, "%s is scheduled for the maintenance window starting at %s.", Delete, "2026-10-17T00:00:00Z")
+
+		sut := newMaintenanceWindowReconciler(t, "Sat,Sun 00:00-24:00")
+		sut.conditionWriter = conditionWriterMock
+		sut.recorder = recorderMock
+
+		// when
+		_, err := sut.scheduleOperation(testCtx, component, Delete, testNextWindowStart)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to schedule Delete of component dogu-op")
+	})
+}
+
+func TestComponentReconciler_clearSchedule(t *testing.T) {
+	t.Run("should do nothing if the component is not scheduled", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+		conditions.Set(component, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonSucceeded, "done"))
+		sut := newMaintenanceWindowReconciler(t, "")
+
+		// when
+		err := sut.clearSchedule(testCtx, component, Ignore)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, conditions.ReasonSucceeded, conditions.Find(component, conditions.TypeProgressing).Reason)
+	})
+
+	t.Run("should keep condition for other operations", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+		conditions.Set(component, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonScheduled,
+			"Upgrade is scheduled for the maintenance window starting at 2026-10-17T00:00:00Z"))
+		sut := newMaintenanceWindowReconciler(t, "")
+
+		// when
+		err := sut.clearSchedule(testCtx, component, Upgrade)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, conditions.ReasonScheduled, conditions.Find(component, conditions.TypeProgressing).Reason)
+	})
+
+	t.Run("should cancel schedule if no operation is required anymore", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+		conditions.Set(component, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonScheduled,
+			"Upgrade is scheduled for the maintenance window starting at 2026-10-17T00:00:00Z"))
+
+		conditionWriterMock := newMockConditionWriter(t)
+		conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).RunAndReturn(func(_ context.Context, c *k8sv1.Component, newConditions []metav1.Condition) error {
+			conditions.Set(c, newConditions...)
+			return nil
+		})
+
+		sut := newMaintenanceWindowReconciler(t, "")
+		sut.conditionWriter = conditionWriterMock
+
+		// when
+		err := sut.clearSchedule(testCtx, component, Ignore)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, k8sv1.ComponentStatusInstalled, component.Status.Status)
+		progressing := conditions.Find(component, conditions.TypeProgressing)
+		require.NotNil(t, progressing)
+		assert.Equal(t, metav1.ConditionFalse, progressing.Status)
+		assert.Equal(t, conditions.ReasonCanceled, progressing.Reason)
+	})
+
+	t.Run("should fail to update conditions", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.1.0")
+		component.Status.Status = k8sv1.ComponentStatusInstalled
+		conditions.Set(component, newCondition(conditions.TypeProgressing, metav1.ConditionFalse, conditions.ReasonScheduled,
+			"Upgrade is scheduled for the maintenance window starting at 2026-10-17T00:00:00Z"))
+
+		conditionWriterMock := newMockConditionWriter(t)
+		conditionWriterMock.EXPECT().Update(testCtx, component, mock.Anything).Return(assert.AnError)
+
+		sut := newMaintenanceWindowReconciler(t, "")
+		sut.conditionWriter = conditionWriterMock
+
+		// when
+		err := sut.clearSchedule(testCtx, component, Ignore)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to clear schedule of component dogu-op")
+	})
+}
@@ -69,6 +69,53 @@ func (_c *MockComponentManager_Delete_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Downgrade provides a mock function with given fields: ctx, component
+func (_m *MockComponentManager) Downgrade(ctx context.Context, component *v1.Component) error {
+	ret := _m.Called(ctx, component)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Downgrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.Component) error); ok {
+		r0 = rf(ctx, component)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComponentManager_Downgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Downgrade'
+type MockComponentManager_Downgrade_Call struct {
+	*mock.Call
+}
+
+// Downgrade is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component *v1.Component
+func (_e *MockComponentManager_Expecter) Downgrade(ctx interface{}, component interface{}) *MockComponentManager_Downgrade_Call {
+	return &MockComponentManager_Downgrade_Call{Call: _e.mock.On("Downgrade", ctx, component)}
+}
+
+func (_c *MockComponentManager_Downgrade_Call) Run(run func(ctx context.Context, component *v1.Component)) *MockComponentManager_Downgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.Component))
+	})
+	return _c
+}
+
+func (_c *MockComponentManager_Downgrade_Call) Return(_a0 error) *MockComponentManager_Downgrade_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComponentManager_Downgrade_Call) RunAndReturn(run func(context.Context, *v1.Component) error) *MockComponentManager_Downgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Install provides a mock function with given fields: ctx, component
 func (_m *MockComponentManager) Install(ctx context.Context, component *v1.Component) error {
 	ret := _m.Called(ctx, component)
@@ -116,6 +163,53 @@ func (_c *MockComponentManager_Install_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// Migrate provides a mock function with given fields: ctx, component
+func (_m *MockComponentManager) Migrate(ctx context.Context, component *v1.Component) error {
+	ret := _m.Called(ctx, component)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Migrate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.Component) error); ok {
+		r0 = rf(ctx, component)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockComponentManager_Migrate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Migrate'
+type MockComponentManager_Migrate_Call struct {
+	*mock.Call
+}
+
+// Migrate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component *v1.Component
+func (_e *MockComponentManager_Expecter) Migrate(ctx interface{}, component interface{}) *MockComponentManager_Migrate_Call {
+	return &MockComponentManager_Migrate_Call{Call: _e.mock.On("Migrate", ctx, component)}
+}
+
+func (_c *MockComponentManager_Migrate_Call) Run(run func(ctx context.Context, component *v1.Component)) *MockComponentManager_Migrate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v1.Component))
+	})
+	return _c
+}
+
+func (_c *MockComponentManager_Migrate_Call) Return(_a0 error) *MockComponentManager_Migrate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockComponentManager_Migrate_Call) RunAndReturn(run func(context.Context, *v1.Component) error) *MockComponentManager_Migrate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Upgrade provides a mock function with given fields: ctx, component
 func (_m *MockComponentManager) Upgrade(ctx context.Context, component *v1.Component) error {
 	ret := _m.Called(ctx, component)
@@ -13,6 +13,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	release "helm.sh/helm/v3/pkg/release"
+
+	version "github.com/cloudogu/k8s-component-operator/pkg/version"
 )
 
 // mockHelmClient is an autogenerated mock type for the helmClient type
@@ -28,6 +30,63 @@ func (_m *mockHelmClient) EXPECT() *mockHelmClient_Expecter {
 	return &mockHelmClient_Expecter{mock: &_m.Mock}
 }
 
+// DryRunInstallOrUpgrade provides a mock function with given fields: ctx, _a1
+func (_m *mockHelmClient) DryRunInstallOrUpgrade(ctx context.Context, _a1 *client.ChartSpec) (string, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunInstallOrUpgrade")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *client.ChartSpec) (string, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *client.ChartSpec) string); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *client.ChartSpec) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockHelmClient_DryRunInstallOrUpgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunInstallOrUpgrade'
+type mockHelmClient_DryRunInstallOrUpgrade_Call struct {
+	*mock.Call
+}
+
+// DryRunInstallOrUpgrade is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *client.ChartSpec
+func (_e *mockHelmClient_Expecter) DryRunInstallOrUpgrade(ctx interface{}, _a1 interface{}) *mockHelmClient_DryRunInstallOrUpgrade_Call {
+	return &mockHelmClient_DryRunInstallOrUpgrade_Call{Call: _e.mock.On("DryRunInstallOrUpgrade", ctx, _a1)}
+}
+
+func (_c *mockHelmClient_DryRunInstallOrUpgrade_Call) Run(run func(ctx context.Context, _a1 *client.ChartSpec)) *mockHelmClient_DryRunInstallOrUpgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*client.ChartSpec))
+	})
+	return _c
+}
+
+func (_c *mockHelmClient_DryRunInstallOrUpgrade_Call) Return(_a0 string, _a1 error) *mockHelmClient_DryRunInstallOrUpgrade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockHelmClient_DryRunInstallOrUpgrade_Call) RunAndReturn(run func(context.Context, *client.ChartSpec) (string, error)) *mockHelmClient_DryRunInstallOrUpgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChart provides a mock function with given fields: ctx, spec
 func (_m *mockHelmClient) GetChart(ctx context.Context, spec *client.ChartSpec) (*chart.Chart, error) {
 	ret := _m.Called(ctx, spec)
@@ -316,6 +375,64 @@ func (_c *mockHelmClient_GetRelease_Call) RunAndReturn(run func(string) (*releas
 	return _c
 }
 
+// GetReleaseHistory provides a mock function with given fields: name
+func (_m *mockHelmClient) GetReleaseHistory(name string) ([]*release.Release, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReleaseHistory")
+	}
+
+	var r0 []*release.Release
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*release.Release, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*release.Release); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*release.Release)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockHelmClient_GetReleaseHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReleaseHistory'
+type mockHelmClient_GetReleaseHistory_Call struct {
+	*mock.Call
+}
+
+// GetReleaseHistory is a helper method to define mock.On call
+//   - name string
+func (_e *mockHelmClient_Expecter) GetReleaseHistory(name interface{}) *mockHelmClient_GetReleaseHistory_Call {
+	return &mockHelmClient_GetReleaseHistory_Call{Call: _e.mock.On("GetReleaseHistory", name)}
+}
+
+func (_c *mockHelmClient_GetReleaseHistory_Call) Run(run func(name string)) *mockHelmClient_GetReleaseHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *mockHelmClient_GetReleaseHistory_Call) Return(_a0 []*release.Release, _a1 error) *mockHelmClient_GetReleaseHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockHelmClient_GetReleaseHistory_Call) RunAndReturn(run func(string) ([]*release.Release, error)) *mockHelmClient_GetReleaseHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReleaseValues provides a mock function with given fields: name, allValues
 func (_m *mockHelmClient) GetReleaseValues(name string, allValues bool) (map[string]interface{}, error) {
 	ret := _m.Called(name, allValues)
@@ -584,6 +701,168 @@ func (_c *mockHelmClient_MarkReleaseAsFailed_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// PullChart provides a mock function with given fields: ctx, spec
+func (_m *mockHelmClient) PullChart(ctx context.Context, spec *client.ChartSpec) (string, error) {
+	ret := _m.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PullChart")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *client.ChartSpec) (string, error)); ok {
+		return rf(ctx, spec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *client.ChartSpec) string); ok {
+		r0 = rf(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *client.ChartSpec) error); ok {
+		r1 = rf(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockHelmClient_PullChart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PullChart'
+type mockHelmClient_PullChart_Call struct {
+	*mock.Call
+}
+
+// PullChart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec *client.ChartSpec
+func (_e *mockHelmClient_Expecter) PullChart(ctx interface{}, spec interface{}) *mockHelmClient_PullChart_Call {
+	return &mockHelmClient_PullChart_Call{Call: _e.mock.On("PullChart", ctx, spec)}
+}
+
+func (_c *mockHelmClient_PullChart_Call) Run(run func(ctx context.Context, spec *client.ChartSpec)) *mockHelmClient_PullChart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*client.ChartSpec))
+	})
+	return _c
+}
+
+func (_c *mockHelmClient_PullChart_Call) Return(_a0 string, _a1 error) *mockHelmClient_PullChart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockHelmClient_PullChart_Call) RunAndReturn(run func(context.Context, *client.ChartSpec) (string, error)) *mockHelmClient_PullChart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveVersion provides a mock function with given fields: chartName, selector, installedVersion
+func (_m *mockHelmClient) ResolveVersion(chartName string, selector version.Selector, installedVersion string) (string, error) {
+	ret := _m.Called(chartName, selector, installedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveVersion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, version.Selector, string) (string, error)); ok {
+		return rf(chartName, selector, installedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(string, version.Selector, string) string); ok {
+		r0 = rf(chartName, selector, installedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, version.Selector, string) error); ok {
+		r1 = rf(chartName, selector, installedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockHelmClient_ResolveVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveVersion'
+type mockHelmClient_ResolveVersion_Call struct {
+	*mock.Call
+}
+
+// ResolveVersion is a helper method to define mock.On call
+//   - chartName string
+//   - selector version.Selector
+//   - installedVersion string
+func (_e *mockHelmClient_Expecter) ResolveVersion(chartName interface{}, selector interface{}, installedVersion interface{}) *mockHelmClient_ResolveVersion_Call {
+	return &mockHelmClient_ResolveVersion_Call{Call: _e.mock.On("ResolveVersion", chartName, selector, installedVersion)}
+}
+
+func (_c *mockHelmClient_ResolveVersion_Call) Run(run func(chartName string, selector version.Selector, installedVersion string)) *mockHelmClient_ResolveVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(version.Selector), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockHelmClient_ResolveVersion_Call) Return(_a0 string, _a1 error) *mockHelmClient_ResolveVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockHelmClient_ResolveVersion_Call) RunAndReturn(run func(string, version.Selector, string) (string, error)) *mockHelmClient_ResolveVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackRelease provides a mock function with given fields: _a0, revision
+func (_m *mockHelmClient) RollbackRelease(_a0 *client.ChartSpec, revision int) error {
+	ret := _m.Called(_a0, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackRelease")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*client.ChartSpec, int) error); ok {
+		r0 = rf(_a0, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockHelmClient_RollbackRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackRelease'
+type mockHelmClient_RollbackRelease_Call struct {
+	*mock.Call
+}
+
+// RollbackRelease is a helper method to define mock.On call
+//   - _a0 *client.ChartSpec
+//   - revision int
+func (_e *mockHelmClient_Expecter) RollbackRelease(_a0 interface{}, revision interface{}) *mockHelmClient_RollbackRelease_Call {
+	return &mockHelmClient_RollbackRelease_Call{Call: _e.mock.On("RollbackRelease", _a0, revision)}
+}
+
+func (_c *mockHelmClient_RollbackRelease_Call) Run(run func(_a0 *client.ChartSpec, revision int)) *mockHelmClient_RollbackRelease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*client.ChartSpec), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *mockHelmClient_RollbackRelease_Call) Return(_a0 error) *mockHelmClient_RollbackRelease_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockHelmClient_RollbackRelease_Call) RunAndReturn(run func(*client.ChartSpec, int) error) *mockHelmClient_RollbackRelease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SatisfiesDependencies provides a mock function with given fields: ctx, _a1
 func (_m *mockHelmClient) SatisfiesDependencies(ctx context.Context, _a1 *client.ChartSpec) error {
 	ret := _m.Called(ctx, _a1)
@@ -631,6 +910,54 @@ func (_c *mockHelmClient_SatisfiesDependencies_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// SatisfiesDependents provides a mock function with given fields: ctx, componentName, _a2
+func (_m *mockHelmClient) SatisfiesDependents(ctx context.Context, componentName string, _a2 string) error {
+	ret := _m.Called(ctx, componentName, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SatisfiesDependents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, componentName, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockHelmClient_SatisfiesDependents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SatisfiesDependents'
+type mockHelmClient_SatisfiesDependents_Call struct {
+	*mock.Call
+}
+
+// SatisfiesDependents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentName string
+//   - _a2 string
+func (_e *mockHelmClient_Expecter) SatisfiesDependents(ctx interface{}, componentName interface{}, _a2 interface{}) *mockHelmClient_SatisfiesDependents_Call {
+	return &mockHelmClient_SatisfiesDependents_Call{Call: _e.mock.On("SatisfiesDependents", ctx, componentName, _a2)}
+}
+
+func (_c *mockHelmClient_SatisfiesDependents_Call) Run(run func(ctx context.Context, componentName string, _a2 string)) *mockHelmClient_SatisfiesDependents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockHelmClient_SatisfiesDependents_Call) Return(_a0 error) *mockHelmClient_SatisfiesDependents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockHelmClient_SatisfiesDependents_Call) RunAndReturn(run func(context.Context, string, string) error) *mockHelmClient_SatisfiesDependents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Uninstall provides a mock function with given fields: releaseName
 func (_m *mockHelmClient) Uninstall(releaseName string) error {
 	ret := _m.Called(releaseName)
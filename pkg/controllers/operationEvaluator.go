@@ -6,9 +6,10 @@ import (
 	"reflect"
 	"time"
 
-	"github.com/Masterminds/semver/v3"
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
 	"helm.sh/helm/v3/pkg/release"
 	corev1 "k8s.io/api/core/v1"
@@ -17,28 +18,31 @@ import (
 )
 
 type defaultOperationEvaluatorFactory struct {
-	recorder       record.EventRecorder
-	timeout        time.Duration
-	yamlSerializer yaml.Serializer
-	reader         configMapRefReader
+	componentClient componentInterface
+	recorder        record.EventRecorder
+	timeout         time.Duration
+	yamlSerializer  yaml.Serializer
+	reader          configMapRefReader
 }
 
 func (f *defaultOperationEvaluatorFactory) NewOperationEvaluator(helmClient helmClient) operationEvaluator {
 	return &defaultOperationEvaluator{
-		helmClient:     helmClient,
-		recorder:       f.recorder,
-		timeout:        f.timeout,
-		yamlSerializer: f.yamlSerializer,
-		reader:         f.reader,
+		componentClient: f.componentClient,
+		helmClient:      helmClient,
+		recorder:        f.recorder,
+		timeout:         f.timeout,
+		yamlSerializer:  f.yamlSerializer,
+		reader:          f.reader,
 	}
 }
 
 type defaultOperationEvaluator struct {
-	helmClient     helmClient
-	recorder       record.EventRecorder
-	timeout        time.Duration
-	yamlSerializer yaml.Serializer
-	reader         configMapRefReader
+	componentClient componentInterface
+	helmClient      helmClient
+	recorder        record.EventRecorder
+	timeout         time.Duration
+	yamlSerializer  yaml.Serializer
+	reader          configMapRefReader
 }
 
 func (e *defaultOperationEvaluator) EvaluateRequiredOperation(ctx context.Context, component *k8sv1.Component) (operation, error) {
@@ -47,11 +51,21 @@ func (e *defaultOperationEvaluator) EvaluateRequiredOperation(ctx context.Contex
 		return Delete, nil
 	}
 
+	// a running migration is resumed regardless of the status and the release
+	if component.Status.Migration != nil {
+		return Migrate, nil
+	}
+
 	switch component.Status.Status {
 	case k8sv1.ComponentStatusNotInstalled, k8sv1.ComponentStatusTryToInstall, k8sv1.ComponentStatusInstalling:
 		return Install, nil
 	case k8sv1.ComponentStatusInstalled, k8sv1.ComponentStatusTryToUpgrade, k8sv1.ComponentStatusTryToDelete:
-		return e.getChangeOperation(ctx, component)
+		op, err := e.getChangeOperation(ctx, component)
+		// a failed upgrade verification is continued although the new version is already deployed
+		if err == nil && op == Ignore && isUpgradeVerificationRunning(component) {
+			return Upgrade, nil
+		}
+		return op, err
 	case k8sv1.ComponentStatusDeleting:
 		return Delete, nil
 	case k8sv1.ComponentStatusUpgrading:
@@ -85,8 +99,10 @@ func (e *defaultOperationEvaluator) getChangeOperation(ctx context.Context, comp
 				"releaseNamespace", deployedRelease.Namespace, "targetNamespace", targetNamespace)
 			if existsReleaseInTargetNamespace {
 				return e.getChangeOperationForRelease(ctx, component, deployedRelease)
+			} else if annotations.IsTrue(component, annotations.MigrateNamespaceAnnotation) {
+				return Migrate, nil
 			} else {
-				e.recorder.Eventf(component, corev1.EventTypeWarning, UpgradeEventReason, "Deploy namespace mismatch (CR: %q; deployed: %q). Deploy namespace declaration is only allowed on install. Revert deploy namespace change to prevent failing upgrade.", targetNamespace, deployedRelease.Namespace)
+				e.recorder.Eventf(component, corev1.EventTypeWarning, UpgradeEventReason, "Deploy namespace mismatch (CR: %q; deployed: %q). Deploy namespace declaration is only allowed on install. Revert deploy namespace change to prevent failing upgrade or migrate the component with the annotation %s.", targetNamespace, deployedRelease.Namespace, annotations.MigrateNamespaceAnnotation)
 				return "", fmt.Errorf("component does not exist in target namespace (%q), but in namespace %q", targetNamespace, deployedRelease.Namespace)
 			}
 		}
@@ -116,38 +132,61 @@ func (e *defaultOperationEvaluator) isValuesChanged(ctx context.Context, deploye
 		return false, fmt.Errorf("failed to get values.yaml from component %s: %w", chartSpec.ChartName, err)
 	}
 
+	return !equalValues(deployedValues, chartSpecValues), nil
+}
+
+// equalValues compares the values of a release with the values of a chart spec.
+func equalValues(releaseValues map[string]interface{}, chartSpecValues map[string]interface{}) bool {
 	// if no additional values are set, the maps will look like this:
-	// deployedValues=map[string]interface {}(nil)
+	// releaseValues=map[string]interface {}(nil)
 	// chartSpecValues=map[string]interface {}{}
 	// this is treated as a difference by DeepEqual, so we have to handle this edge case manually
-	if len(deployedValues) == 0 && len(chartSpecValues) == 0 {
-		return false, nil
+	if len(releaseValues) == 0 && len(chartSpecValues) == 0 {
+		return true
 	}
 
-	return !reflect.DeepEqual(deployedValues, chartSpecValues), nil
+	return reflect.DeepEqual(releaseValues, chartSpecValues)
 }
 
 func (e *defaultOperationEvaluator) getChangeOperationForRelease(ctx context.Context, component *k8sv1.Component, release *release.Release) (operation, error) {
 	chart := release.Chart
-	deployedAppVersion, err := semver.NewVersion(chart.AppVersion())
+	deployedAppVersion, err := version.Parse(chart.AppVersion())
 	if err != nil {
 		return "", fmt.Errorf("failed to parse app version %s from helm chart %s: %w", chart.AppVersion(), chart.Name(), err)
 	}
 
-	componentVersion, err := semver.NewVersion(component.Spec.Version)
+	resolvedVersion, err := resolveComponentVersion(e.helmClient, component, chart.AppVersion())
+	if err != nil {
+		return "", err
+	}
+	if resolvedVersion != component.Spec.Version {
+		log.FromContext(ctx).Info(fmt.Sprintf("Resolved version %s of component %s to %s", component.Spec.Version, component.Spec.Name, resolvedVersion))
+	}
+
+	err = updateResolvedVersion(ctx, e.componentClient, component, resolvedVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if isRejectedVersion(component, resolvedVersion) {
+		log.FromContext(ctx).Info(fmt.Sprintf("Skipping version %s of component %s which was rolled back until the spec of the component changes", resolvedVersion, component.Spec.Name))
+		return Ignore, nil
+	}
+
+	componentVersion, err := version.Parse(resolvedVersion)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse component version %s from %s: %w", component.Spec.Version, component.Spec.Name, err)
+		return "", fmt.Errorf("failed to parse component version %s from %s: %w", resolvedVersion, component.Spec.Name, err)
 	}
 
-	if deployedAppVersion.LessThan(componentVersion) {
+	if deployedAppVersion.IsOlderThan(componentVersion) {
 		return Upgrade, nil
 	}
 
-	if deployedAppVersion.GreaterThan(componentVersion) {
+	if deployedAppVersion.IsNewerThan(componentVersion) {
 		return Downgrade, nil
 	}
 
-	isValuesChanged, err := e.isValuesChanged(ctx, release, component)
+	isValuesChanged, err := e.isValuesChanged(ctx, release, withVersion(component, resolvedVersion))
 	if err != nil {
 		return "", fmt.Errorf("failed to compare Values.yaml files of component %s: %w", component.Name, err)
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,13 +22,15 @@ func Test_defaultOperationEvaluatorFactory_NewOperationEvaluator(t *testing.T) {
 	recorderMock := newMockEventRecorder(t)
 	yamlSerializer := yaml.NewSerializer()
 	readerMock := newMockConfigMapRefReader(t)
+	componentClientMock := newMockComponentInterface(t)
 	timeout := 5 * time.Minute
 
 	sut := defaultOperationEvaluatorFactory{
-		recorder:       recorderMock,
-		timeout:        timeout,
-		yamlSerializer: yamlSerializer,
-		reader:         readerMock,
+		componentClient: componentClientMock,
+		recorder:        recorderMock,
+		timeout:         timeout,
+		yamlSerializer:  yamlSerializer,
+		reader:          readerMock,
 	}
 
 	// when
@@ -36,6 +39,7 @@ func Test_defaultOperationEvaluatorFactory_NewOperationEvaluator(t *testing.T) {
 	// then
 	evaluator, ok := actual.(*defaultOperationEvaluator)
 	require.True(t, ok)
+	assert.Same(t, componentClientMock, evaluator.componentClient)
 	assert.Same(t, helmClientMock, evaluator.helmClient)
 	assert.Same(t, recorderMock, evaluator.recorder)
 	assert.Equal(t, timeout, evaluator.timeout)
@@ -166,7 +170,7 @@ func Test_defaultOperationEvaluator_getChangeOperation(t *testing.T) {
 		component := getComponent("ecosystem", "k8s", "deploy-namespace", "dogu-op", "0.0.1-2")
 		mockHelmClient := newMockHelmClient(t)
 		mockRecorder := newMockEventRecorder(t)
-		mockRecorder.EXPECT().Eventf(component, corev1.EventTypeWarning, UpgradeEventReason, "Deploy namespace mismatch (CR: %q; deployed: %q). Deploy namespace declaration is only allowed on install. Revert deploy namespace change to prevent failing upgrade.", "deploy-namespace", "ecosystem").Return()
+		mockRecorder.EXPECT().Eventf(component, corev1.EventTypeWarning, UpgradeEventReason, "Deploy namespace mismatch (CR: %q; deployed: %q). Deploy namespace declaration is only allowed on install. Revert deploy namespace change to prevent failing upgrade or migrate the component with the annotation %s.", "deploy-namespace", "ecosystem", annotations.MigrateNamespaceAnnotation).Return()
 		helmReleases := []*release.Release{{Name: "dogu-op", Namespace: "ecosystem", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "0.0.1-2"}}}}
 		mockHelmClient.EXPECT().ListDeployedReleases().Return(helmReleases, nil)
 
@@ -182,6 +186,82 @@ func Test_defaultOperationEvaluator_getChangeOperation(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("should return migrate-operation if deploy namespace changed with migrate annotation", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "deploy-namespace", "dogu-op", "0.0.1-2")
+		component.Annotations = map[string]string{annotations.MigrateNamespaceAnnotation: "true"}
+		mockHelmClient := newMockHelmClient(t)
+		helmReleases := []*release.Release{{Name: "dogu-op", Namespace: "ecosystem", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "0.0.1-2"}}}}
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(helmReleases, nil)
+
+		sut := defaultOperationEvaluator{helmClient: mockHelmClient}
+
+		// when
+		op, err := sut.getChangeOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Migrate, op)
+	})
+
+	t.Run("should return upgrade-operation on upgrade from build number to release candidate of next version", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "1.6.0-rc.1")
+		mockHelmClient := newMockHelmClient(t)
+		helmReleases := []*release.Release{{Name: "dogu-op", Namespace: "ecosystem", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "1.5.1-8"}}}}
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(helmReleases, nil)
+
+		sut := defaultOperationEvaluator{helmClient: mockHelmClient}
+
+		// when
+		op, err := sut.getChangeOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Upgrade, op)
+	})
+
+	t.Run("should return upgrade-operation if version range resolves to a newer version", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "patch-only")
+		mockHelmClient := newMockHelmClient(t)
+		helmReleases := []*release.Release{{Name: "dogu-op", Namespace: "ecosystem", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "1.5.1"}}}}
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(helmReleases, nil)
+		mockHelmClient.EXPECT().ResolveVersion("k8s/dogu-op", mock.Anything, "1.5.1").Return("1.5.2", nil)
+		mockComponentClient := newMockComponentInterface(t)
+		mockComponentClient.EXPECT().Get(testCtx, "dogu-op", v1.GetOptions{}).Return(component.DeepCopy(), nil)
+		mockComponentClient.EXPECT().UpdateStatus(testCtx, mock.Anything, v1.UpdateOptions{}).RunAndReturn(returnUpdatedComponent)
+
+		sut := defaultOperationEvaluator{componentClient: mockComponentClient, helmClient: mockHelmClient}
+
+		// when
+		op, err := sut.getChangeOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Upgrade, op)
+		assert.Equal(t, "1.5.2", component.Status.ResolvedVersion)
+	})
+
+	t.Run("should fail on error resolving version range", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "~1.5")
+		mockHelmClient := newMockHelmClient(t)
+		helmReleases := []*release.Release{{Name: "dogu-op", Namespace: "ecosystem", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "1.5.1"}}}}
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(helmReleases, nil)
+		mockHelmClient.EXPECT().ResolveVersion("k8s/dogu-op", mock.Anything, "1.5.1").Return("", assert.AnError)
+
+		sut := defaultOperationEvaluator{helmClient: mockHelmClient}
+
+		// when
+		_, err := sut.getChangeOperation(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to resolve version ~1.5 of component dogu-op")
+	})
+
 	t.Run("should return upgrade-operation on upgrade", func(t *testing.T) {
 		// given
 		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.0.2")
@@ -326,6 +406,21 @@ func Test_defaultOperationEvaluator_getChangeOperation(t *testing.T) {
 }
 
 func Test_defaultOperationEvaluator_EvaluateRequiredOperation(t *testing.T) {
+	t.Run("should resume running migration", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "longhorn-system", "dogu-op", "0.0.0")
+		component.Status.Status = "upgrading"
+		component.Status.Migration = &k8sv1.MigrationStatus{Step: migrationStepUninstalled}
+		sut := defaultOperationEvaluator{}
+
+		// when
+		requiredOperation, err := sut.EvaluateRequiredOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Migrate, requiredOperation)
+	})
+
 	t.Run("should return install on status installing", func(t *testing.T) {
 		// given
 		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.0.0")
@@ -414,6 +509,72 @@ func Test_defaultOperationEvaluator_EvaluateRequiredOperation(t *testing.T) {
 		assert.Equal(t, Upgrade, requiredOperation)
 	})
 
+	t.Run("should continue upgrade verification of deployed version", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.0.1")
+		component.Status.Status = "tryToUpgrade"
+		component.Status.UpgradeVerification = &k8sv1.UpgradeVerificationStatus{Version: "0.0.1", StartedAt: v1.Now()}
+		component.Spec.ValuesConfigRef = &k8sv1.Reference{}
+		helmMock := newMockHelmClient(t)
+		installedReleases := []*release.Release{{Namespace: "ecosystem", Name: "dogu-op", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "0.0.1"}}}}
+		helmMock.EXPECT().ListDeployedReleases().Return(installedReleases, nil)
+		helmMock.EXPECT().GetReleaseValues("dogu-op", false).Return(map[string]interface{}{}, nil)
+		helmMock.EXPECT().GetChartSpecValues(mock.Anything).Return(map[string]interface{}{}, nil)
+		configMapRefReaderMock := newMockConfigMapRefReader(t)
+		configMapRefReaderMock.EXPECT().GetValues(testCtx, &k8sv1.Reference{}).Return("", nil)
+		sut := defaultOperationEvaluator{
+			helmClient:     helmMock,
+			timeout:        defaultHelmClientTimeoutMins,
+			yamlSerializer: yaml.NewSerializer(),
+			reader:         configMapRefReaderMock,
+		}
+
+		// when
+		requiredOperation, err := sut.EvaluateRequiredOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Upgrade, requiredOperation)
+	})
+
+	t.Run("should skip rolled back version until the spec changes", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.0.2")
+		component.Generation = 4
+		component.Status.Status = "tryToUpgrade"
+		component.Status.UpgradeVerification = &k8sv1.UpgradeVerificationStatus{Version: "0.0.2", RolledBack: true, ObservedGeneration: 4}
+		helmMock := newMockHelmClient(t)
+		installedReleases := []*release.Release{{Namespace: "ecosystem", Name: "dogu-op", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "0.0.1"}}}}
+		helmMock.EXPECT().ListDeployedReleases().Return(installedReleases, nil)
+		sut := defaultOperationEvaluator{helmClient: helmMock}
+
+		// when
+		requiredOperation, err := sut.EvaluateRequiredOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Ignore, requiredOperation)
+	})
+
+	t.Run("should upgrade to rolled back version after the spec changed", func(t *testing.T) {
+		// given
+		component := getComponent("ecosystem", "k8s", "", "dogu-op", "0.0.2")
+		component.Generation = 5
+		component.Status.Status = "tryToUpgrade"
+		component.Status.UpgradeVerification = &k8sv1.UpgradeVerificationStatus{Version: "0.0.2", RolledBack: true, ObservedGeneration: 4}
+		helmMock := newMockHelmClient(t)
+		installedReleases := []*release.Release{{Namespace: "ecosystem", Name: "dogu-op", Chart: &chart.Chart{Metadata: &chart.Metadata{AppVersion: "0.0.1"}}}}
+		helmMock.EXPECT().ListDeployedReleases().Return(installedReleases, nil)
+		sut := defaultOperationEvaluator{helmClient: helmMock}
+
+		// when
+		requiredOperation, err := sut.EvaluateRequiredOperation(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Upgrade, requiredOperation)
+	})
+
 	t.Run("should return delete on tryToDelete status", func(t *testing.T) {
 		// given
 		componentName := "dogu-op"
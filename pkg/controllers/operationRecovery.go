@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
+)
+
+// RecoveryEventReason The name of the event reporting the recovery of an interrupted helm action
+const RecoveryEventReason = "Recovery"
+
+// recoverInterruptedOperation recovers a helm action of the component which is still recorded in the operation
+// journal. As every helm action is removed from the journal when it finishes, such an action was interrupted, e.g. by
+// a crash or restart of the operator. The outcome of the recovery is reported by an event before the entry is removed
+// and the reconciliation continues as usual.
+func (r *ComponentReconciler) recoverInterruptedOperation(ctx context.Context, component *k8sv1.Component, hc helmClient) error {
+	entry, err := r.journal.Get(ctx, component.Spec.Name)
+	if err != nil {
+		return fmt.Errorf("failed to read operation journal: %w", err)
+	}
+	if entry == nil {
+		return nil
+	}
+
+	log.FromContext(ctx).Info(fmt.Sprintf("Found interrupted %s of component %s started at %s", entry.Operation, component.Spec.Name, entry.StartedAt))
+
+	outcome, err := r.recoverEntry(ctx, component, hc, entry)
+	if err != nil {
+		r.recorder.Eventf(component, corev1.EventTypeWarning, RecoveryEventReason, "Failed to recover interrupted %s: %s", entry.Operation, err.Error())
+		return fmt.Errorf("failed to recover interrupted %s of component %s: %w", entry.Operation, component.Spec.Name, err)
+	}
+
+	log.FromContext(ctx).Info(outcome)
+	r.recorder.Event(component, corev1.EventTypeWarning, RecoveryEventReason, outcome)
+
+	err = r.journal.Complete(ctx, component.Spec.Name)
+	if err != nil {
+		return fmt.Errorf("failed to remove recovered %s of component %s from operation journal: %w", entry.Operation, component.Spec.Name, err)
+	}
+
+	return nil
+}
+
+// recoverEntry decides by the current revision of the release how to deal with the interrupted helm action:
+//   - actions which did not create a new revision or which finished before the interruption are resumed by the
+//     following reconciliation.
+//   - pending releases are marked as failed. Without a previous revision to return to, the action is retried.
+//   - other releases are rolled back to the revision before the action. Interrupted rollbacks are finished instead.
+func (r *ComponentReconciler) recoverEntry(ctx context.Context, component *k8sv1.Component, hc helmClient, entry *journal.Entry) (string, error) {
+	release, err := hc.GetRelease(component.Spec.Name)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return fmt.Sprintf("Interrupted %s%s left no release. Resuming the operation.", entry.Operation, describeTarget(entry)), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get release: %w", err)
+	}
+
+	switch {
+	case release.Version <= entry.Revision:
+		return fmt.Sprintf("Interrupted %s%s did not change release revision %d. Resuming the operation.",
+			entry.Operation, describeTarget(entry), release.Version), nil
+	case release.Info.Status == helmRelease.StatusDeployed || release.Info.Status == helmRelease.StatusUninstalled:
+		return fmt.Sprintf("Interrupted %s%s finished with release revision %d in status %s.",
+			entry.Operation, describeTarget(entry), release.Version, release.Info.Status), nil
+	}
+
+	if release.Info.Status.IsPending() {
+		err = handlePendingRelease(log.FromContext(ctx), component, ctx, hc, r.timeout)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	rollbackRevision := entry.Revision
+	if entry.Operation == journal.OperationRollback {
+		rollbackRevision = entry.TargetRevision
+	}
+	if rollbackRevision == 0 || entry.Operation == journal.OperationUninstall {
+		return fmt.Sprintf("Release revision %d of interrupted %s%s failed. Resuming the operation.",
+			release.Version, entry.Operation, describeTarget(entry)), nil
+	}
+
+	rollbackSpec := &client.ChartSpec{ReleaseName: component.Spec.Name, Namespace: entry.Namespace, Timeout: r.timeout}
+	err = hc.RollbackRelease(rollbackSpec, rollbackRevision)
+	if err != nil {
+		return "", fmt.Errorf("failed to roll back release to revision %d: %w", rollbackRevision, err)
+	}
+
+	return fmt.Sprintf("Rolled back release revision %d of interrupted %s%s to revision %d.",
+		release.Version, entry.Operation, describeTarget(entry), rollbackRevision), nil
+}
+
+func describeTarget(entry *journal.Entry) string {
+	if entry.TargetVersion == "" {
+		return ""
+	}
+
+	return " to version " + entry.TargetVersion
+}
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
+)
+
+// newEmptyJournalMock creates a journal without interrupted helm actions.
+func newEmptyJournalMock(t *testing.T) *mockOperationJournal {
+	journalMock := newMockOperationJournal(t)
+	journalMock.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return journalMock
+}
+
+func getInterruptedUpgrade() *journal.Entry {
+	return &journal.Entry{
+		Operation:     journal.OperationUpgrade,
+		Namespace:     testNamespace,
+		TargetVersion: "0.2.0",
+		ChartDigest:   "sha256:abc",
+		StartedAt:     metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+		Revision:      3,
+	}
+}
+
+func getRevision(version int, status release.Status) *release.Release {
+	return &release.Release{Name: "dogu-op", Namespace: testNamespace, Version: version, Info: &release.Info{Status: status}}
+}
+
+func TestComponentReconciler_recoverInterruptedOperation(t *testing.T) {
+	t.Run("should do nothing without interrupted operation", func(t *testing.T) {
+		// given
+		sut := &ComponentReconciler{journal: newEmptyJournalMock(t)}
+
+		// when
+		err := sut.recoverInterruptedOperation(testCtx, getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0"), newMockHelmClient(t))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail to read journal", func(t *testing.T) {
+		// given
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Get(testCtx, "dogu-op").Return(nil, assert.AnError)
+		sut := &ComponentReconciler{journal: journalMock}
+
+		// when
+		err := sut.recoverInterruptedOperation(testCtx, getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0"), newMockHelmClient(t))
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to read operation journal")
+	})
+
+	tests := []struct {
+		name          string
+		entry         func() *journal.Entry
+		releases      []*release.Release
+		releaseErr    error
+		expectPending bool
+		wantRollback  int
+		wantEvent     string
+	}{
+		{
+			name:       "should resume operation without release",
+			entry:      getInterruptedUpgrade,
+			releaseErr: driver.ErrReleaseNotFound,
+			wantEvent:  "Interrupted upgrade to version 0.2.0 left no release. Resuming the operation.",
+		},
+		{
+			name:      "should resume operation which did not change the release",
+			entry:     getInterruptedUpgrade,
+			releases:  []*release.Release{getRevision(3, release.StatusDeployed)},
+			wantEvent: "Interrupted upgrade to version 0.2.0 did not change release revision 3. Resuming the operation.",
+		},
+		{
+			name:      "should report operation which finished before the interruption",
+			entry:     getInterruptedUpgrade,
+			releases:  []*release.Release{getRevision(4, release.StatusDeployed)},
+			wantEvent: "Interrupted upgrade to version 0.2.0 finished with release revision 4 in status deployed.",
+		},
+		{
+			name:         "should roll back failed upgrade to previous revision",
+			entry:        getInterruptedUpgrade,
+			releases:     []*release.Release{getRevision(4, release.StatusFailed)},
+			wantRollback: 3,
+			wantEvent:    "Rolled back release revision 4 of interrupted upgrade to version 0.2.0 to revision 3.",
+		},
+		{
+			name:          "should fail pending upgrade and roll back to previous revision",
+			entry:         getInterruptedUpgrade,
+			releases:      []*release.Release{getRevision(4, release.StatusPendingUpgrade), getRevision(4, release.StatusFailed)},
+			expectPending: true,
+			wantRollback:  3,
+			wantEvent:     "Rolled back release revision 4 of interrupted upgrade to version 0.2.0 to revision 3.",
+		},
+		{
+			name: "should finish interrupted rollback",
+			entry: func() *journal.Entry {
+				entry := getInterruptedUpgrade()
+				entry.Operation = journal.OperationRollback
+				entry.TargetRevision = 2
+				return entry
+			},
+			releases:     []*release.Release{getRevision(4, release.StatusFailed)},
+			wantRollback: 2,
+			wantEvent:    "Rolled back release revision 4 of interrupted rollback to version 0.2.0 to revision 2.",
+		},
+		{
+			name: "should resume failed installation",
+			entry: func() *journal.Entry {
+				entry := getInterruptedUpgrade()
+				entry.Operation = journal.OperationInstall
+				entry.Revision = 0
+				return entry
+			},
+			releases:  []*release.Release{getRevision(1, release.StatusFailed)},
+			wantEvent: "Release revision 1 of interrupted install to version 0.2.0 failed. Resuming the operation.",
+		},
+		{
+			name: "should resume failed uninstallation",
+			entry: func() *journal.Entry {
+				return &journal.Entry{Operation: journal.OperationUninstall, Revision: 3}
+			},
+			releases:  []*release.Release{getRevision(4, release.StatusFailed)},
+			wantEvent: "Release revision 4 of interrupted uninstall failed. Resuming the operation.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0")
+
+			journalMock := newMockOperationJournal(t)
+			journalMock.EXPECT().Get(testCtx, "dogu-op").Return(tt.entry(), nil)
+			journalMock.EXPECT().Complete(testCtx, "dogu-op").Return(nil)
+
+			helmClientMock := newMockHelmClient(t)
+			if tt.releaseErr != nil {
+				helmClientMock.EXPECT().GetRelease("dogu-op").Return(nil, tt.releaseErr)
+			}
+			for _, r := range tt.releases {
+				helmClientMock.EXPECT().GetRelease("dogu-op").Return(r, nil).Once()
+			}
+			if tt.expectPending {
+				helmClientMock.EXPECT().MarkReleaseAsFailed("dogu-op", mock.Anything).Return(nil)
+			}
+			if tt.wantRollback > 0 {
+				spec := &client.ChartSpec{ReleaseName: "dogu-op", Namespace: testNamespace, Timeout: defaultHelmClientTimeoutMins}
+				helmClientMock.EXPECT().RollbackRelease(spec, tt.wantRollback).Return(nil)
+			}
+
+			recorderMock := newMockEventRecorder(t)
+			recorderMock.EXPECT().Event(component, corev1.EventTypeWarning, RecoveryEventReason, tt.wantEvent)
+
+			sut := &ComponentReconciler{journal: journalMock, recorder: recorderMock, timeout: defaultHelmClientTimeoutMins}
+
+			// when
+			err := sut.recoverInterruptedOperation(testCtx, component, helmClientMock)
+
+			// then
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("should keep entry if rollback fails", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0")
+
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Get(testCtx, "dogu-op").Return(getInterruptedUpgrade(), nil)
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getRevision(4, release.StatusFailed), nil)
+		helmClientMock.EXPECT().RollbackRelease(mock.Anything, 3).Return(assert.AnError)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, corev1.EventTypeWarning, RecoveryEventReason, "Failed to recover interrupted %s: %s", journal.OperationUpgrade, mock.Anything)
+
+		sut := &ComponentReconciler{journal: journalMock, recorder: recorderMock, timeout: defaultHelmClientTimeoutMins}
+
+		// when
+		err := sut.recoverInterruptedOperation(testCtx, component, helmClientMock)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to recover interrupted upgrade of component dogu-op: failed to roll back release to revision 3")
+	})
+
+	t.Run("should fail to remove recovered entry", func(t *testing.T) {
+		// given
+		component := getComponent(testNamespace, "k8s", "", "dogu-op", "0.2.0")
+
+		journalMock := newMockOperationJournal(t)
+		journalMock.EXPECT().Get(testCtx, "dogu-op").Return(getInterruptedUpgrade(), nil)
+		journalMock.EXPECT().Complete(testCtx, "dogu-op").Return(assert.AnError)
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetRelease("dogu-op").Return(getRevision(4, release.StatusDeployed), nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Event(component, corev1.EventTypeWarning, RecoveryEventReason, mock.Anything)
+
+		sut := &ComponentReconciler{journal: journalMock, recorder: recorderMock}
+
+		// when
+		err := sut.recoverInterruptedOperation(testCtx, component, helmClientMock)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to remove recovered upgrade of component dogu-op from operation journal")
+	})
+}
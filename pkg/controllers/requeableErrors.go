@@ -3,8 +3,61 @@ package controllers
 import (
 	"fmt"
 	"time"
+
+	k8sErrs "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
 )
 
+// backoffPolicy describes how the requeue time grows with consecutive failures of a certain kind of error.
+type backoffPolicy struct {
+	// multiplier is applied to the previous requeue time.
+	multiplier float64
+	// minRequeueTime is the lower bound of the requeue time. The configured default requeue time is used if it is higher.
+	minRequeueTime time.Duration
+	// maxRequeueTime caps the requeue time of this policy. Zero means that only the operator-wide maximum applies.
+	maxRequeueTime time.Duration
+}
+
+var (
+	// defaultBackoffPolicy is used for all errors without a more specific policy.
+	defaultBackoffPolicy = backoffPolicy{multiplier: 2}
+	// dependencyBackoffPolicy is used for unsatisfied dependencies which usually take a while to be installed.
+	dependencyBackoffPolicy = backoffPolicy{multiplier: 2, minRequeueTime: 10 * time.Second}
+	// registryBackoffPolicy is used for errors while accessing the helm registry to back off quickly from unavailable registries.
+	registryBackoffPolicy = backoffPolicy{multiplier: 3}
+	// conflictBackoffPolicy is used for update conflicts of kubernetes resources which usually resolve within seconds.
+	conflictBackoffPolicy = backoffPolicy{multiplier: 1.5, maxRequeueTime: 30 * time.Second}
+)
+
+// next calculates the requeue time following the given previous requeue time.
+func (bp backoffPolicy) next(previousRequeueTime time.Duration, defaultRequeueTime time.Duration) time.Duration {
+	initialRequeueTime := max(defaultRequeueTime, bp.minRequeueTime)
+	if previousRequeueTime <= 0 {
+		return initialRequeueTime
+	}
+
+	requeueTime := max(time.Duration(float64(previousRequeueTime)*bp.multiplier), initialRequeueTime)
+	if bp.maxRequeueTime > 0 && requeueTime > bp.maxRequeueTime {
+		return bp.maxRequeueTime
+	}
+
+	return requeueTime
+}
+
+func getBackoffPolicy(err error) backoffPolicy {
+	switch {
+	case k8sErrs.IsConflict(err):
+		return conflictBackoffPolicy
+	case helm.IsDependencyUnsatisfiedError(err):
+		return dependencyBackoffPolicy
+	case helm.IsRegistryError(err):
+		return registryBackoffPolicy
+	default:
+		return defaultBackoffPolicy
+	}
+}
+
 type genericRequeueableError struct {
 	errMsg string
 	err    error
@@ -15,18 +68,13 @@ func (gre *genericRequeueableError) Error() string {
 	return fmt.Sprintf("%s: %s", gre.errMsg, gre.err.Error())
 }
 
-// GetRequeueTime returns the time until the component should be requeued.
+// GetRequeueTime returns the time until the component should be requeued. The time grows exponentially with every
+// consecutive failure according to the backoff policy of the wrapped error.
 func (gre *genericRequeueableError) GetRequeueTime(requeueTimeNanos time.Duration, defaultRequeueTimeNanos time.Duration) time.Duration {
-	return getRequeueTime(requeueTimeNanos, defaultRequeueTimeNanos)
+	return getBackoffPolicy(gre.err).next(requeueTimeNanos, defaultRequeueTimeNanos)
 }
 
 // Unwrap returns the root error.
 func (gre *genericRequeueableError) Unwrap() error {
 	return gre.err
 }
-
-func getRequeueTime(_ time.Duration, defaultRequeueTimeNanos time.Duration) time.Duration {
-	// Do not use parameter because we only want to use defaultRequeueTime as the requeueTime.
-	// We don't want to change the interface.
-	return defaultRequeueTimeNanos
-}
@@ -2,11 +2,15 @@ package controllers
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	k8sErrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func Test_genericRequeueableError_Unwrap(t *testing.T) {
@@ -33,7 +37,10 @@ func Test_genericRequeueableError_Error(t *testing.T) {
 }
 
 func Test_genericRequeueableError_GetRequeueTime(t *testing.T) {
+	conflictErr := fmt.Errorf("failed to update status: %w", k8sErrs.NewConflict(schema.GroupResource{}, "dogu-op", assert.AnError))
+
 	type args struct {
+		err                error
 		requeueTime        time.Duration
 		defaultRequeueTime time.Duration
 	}
@@ -42,15 +49,53 @@ func Test_genericRequeueableError_GetRequeueTime(t *testing.T) {
 		args args
 		want time.Duration
 	}{
-		{"always return defaultRequeueTime case 1", args{0 * time.Second, 5 * time.Second}, 5 * time.Second},
-		{"always return defaultRequeueTime case 2", args{15 * time.Second, 5 * time.Second}, 5 * time.Second},
-		{"always return defaultRequeueTime case 3", args{30 * time.Second, 5 * time.Second}, 5 * time.Second},
-		{"always return defaultRequeueTime case 3", args{256 * time.Second, 5 * time.Second}, 5 * time.Second},
+		{"start with defaultRequeueTime", args{assert.AnError, 0 * time.Second, 5 * time.Second}, 5 * time.Second},
+		{"double previous requeueTime case 1", args{assert.AnError, 15 * time.Second, 5 * time.Second}, 30 * time.Second},
+		{"double previous requeueTime case 2", args{assert.AnError, 256 * time.Second, 5 * time.Second}, 512 * time.Second},
+		{"never fall below defaultRequeueTime", args{assert.AnError, 1 * time.Second, 5 * time.Second}, 5 * time.Second},
+		{"grow conflicts slowly", args{conflictErr, 10 * time.Second, 3 * time.Second}, 15 * time.Second},
+		{"cap conflicts", args{conflictErr, 25 * time.Second, 3 * time.Second}, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due := &genericRequeueableError{"oh noez", tt.args.err}
+			assert.Equalf(t, tt.want, due.GetRequeueTime(tt.args.requeueTime, tt.args.defaultRequeueTime), "GetRequeueTime(%v)", tt.args.requeueTime)
+		})
+	}
+}
+
+func Test_backoffPolicy_next(t *testing.T) {
+	type args struct {
+		previousRequeueTime time.Duration
+		defaultRequeueTime  time.Duration
+	}
+	tests := []struct {
+		name   string
+		policy backoffPolicy
+		args   args
+		want   time.Duration
+	}{
+		{"dependency policy starts with minimum", dependencyBackoffPolicy, args{0, 3 * time.Second}, 10 * time.Second},
+		{"dependency policy starts with higher default", dependencyBackoffPolicy, args{0, 20 * time.Second}, 20 * time.Second},
+		{"dependency policy doubles", dependencyBackoffPolicy, args{10 * time.Second, 3 * time.Second}, 20 * time.Second},
+		{"registry policy triples", registryBackoffPolicy, args{10 * time.Second, 3 * time.Second}, 30 * time.Second},
+		{"conflict policy is capped", conflictBackoffPolicy, args{time.Minute, 3 * time.Second}, 30 * time.Second},
+		{"default policy is not capped", defaultBackoffPolicy, args{time.Hour, 3 * time.Second}, 2 * time.Hour},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			due := &genericRequeueableError{}
-			assert.Equalf(t, tt.want, due.GetRequeueTime(tt.args.requeueTime, tt.args.defaultRequeueTime), "getRequeueTime(%v)", tt.args.requeueTime)
+			assert.Equal(t, tt.want, tt.policy.next(tt.args.previousRequeueTime, tt.args.defaultRequeueTime))
 		})
 	}
 }
+
+func Test_getBackoffPolicy(t *testing.T) {
+	t.Run("should return conflict policy", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", k8sErrs.NewConflict(schema.GroupResource{}, "dogu-op", assert.AnError))
+
+		assert.Equal(t, conflictBackoffPolicy, getBackoffPolicy(err))
+	})
+	t.Run("should return default policy", func(t *testing.T) {
+		assert.Equal(t, defaultBackoffPolicy, getBackoffPolicy(assert.AnError))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -16,21 +17,29 @@ import (
 	"github.com/cloudogu/retry-lib/retry"
 )
 
+// defaultRequeueJitterFactor is the maximum fraction by which the requeue time gets shortened randomly. This avoids
+// that many failing components hit the registry and the API server at the same time.
+const defaultRequeueJitterFactor = 0.1
+
 // componentRequeueHandler is responsible to requeue a component resource after it failed.
 type componentRequeueHandler struct {
-	clientSet   componentEcosystemInterface
-	namespace   string
-	recorder    record.EventRecorder
-	requeueTime time.Duration
+	clientSet      componentEcosystemInterface
+	namespace      string
+	recorder       record.EventRecorder
+	requeueTime    time.Duration
+	maxRequeueTime time.Duration
+	jitterFactor   float64
 }
 
 // NewComponentRequeueHandler creates a new component requeue handler.
-func NewComponentRequeueHandler(clientSet componentEcosystemInterface, recorder record.EventRecorder, namespace string, requeueTime time.Duration) *componentRequeueHandler {
+func NewComponentRequeueHandler(clientSet componentEcosystemInterface, recorder record.EventRecorder, namespace string, requeueTime time.Duration, maxRequeueTime time.Duration) *componentRequeueHandler {
 	return &componentRequeueHandler{
-		clientSet:   clientSet,
-		namespace:   namespace,
-		recorder:    recorder,
-		requeueTime: requeueTime,
+		clientSet:      clientSet,
+		namespace:      namespace,
+		recorder:       recorder,
+		requeueTime:    requeueTime,
+		maxRequeueTime: maxRequeueTime,
+		jitterFactor:   defaultRequeueJitterFactor,
 	}
 }
 
@@ -41,7 +50,7 @@ func (d *componentRequeueHandler) Handle(ctx context.Context, contextMessage str
 		return d.noLongerHandleRequeueing(ctx, component)
 	}
 
-	requeueTime := requeueableErr.GetRequeueTime(component.Status.RequeueTimeNanos, d.requeueTime)
+	requeueTime := d.capRequeueTime(requeueableErr.GetRequeueTime(component.Status.RequeueTimeNanos, d.requeueTime))
 
 	updateError := retry.OnConflict(func() error {
 		compClient := d.clientSet.ComponentV1Alpha1().Components(d.namespace)
@@ -60,10 +69,10 @@ func (d *componentRequeueHandler) Handle(ctx context.Context, contextMessage str
 		return ctrl.Result{}, fmt.Errorf("failed to update component status while requeueing: %w", updateError)
 	}
 
-	result := ctrl.Result{Requeue: true, RequeueAfter: requeueTime}
+	result := ctrl.Result{Requeue: true, RequeueAfter: d.addJitter(requeueTime)}
 	d.fireRequeueEvent(component, result)
 
-	log.FromContext(ctx).Info(fmt.Sprintf("%s: requeue in %s seconds because of: %s", contextMessage, requeueTime, originalErr.Error()))
+	log.FromContext(ctx).Info(fmt.Sprintf("%s: requeue in %s seconds because of: %s", contextMessage, result.RequeueAfter, originalErr.Error()))
 
 	return result, nil
 }
@@ -96,6 +105,26 @@ func (d *componentRequeueHandler) noLongerHandleRequeueing(ctx context.Context,
 	return ctrl.Result{}, err
 }
 
+// capRequeueTime limits the requeue time to the configured maximum. The capped value is persisted in the component
+// status so that the backoff does not grow any further.
+func (d *componentRequeueHandler) capRequeueTime(requeueTime time.Duration) time.Duration {
+	if d.maxRequeueTime > 0 && requeueTime > d.maxRequeueTime {
+		return d.maxRequeueTime
+	}
+
+	return requeueTime
+}
+
+// addJitter shortens the requeue time by a random fraction of at most jitterFactor. Shortening instead of extending
+// keeps the requeue time below the configured maximum.
+func (d *componentRequeueHandler) addJitter(requeueTime time.Duration) time.Duration {
+	if d.jitterFactor <= 0 {
+		return requeueTime
+	}
+
+	return requeueTime - time.Duration(rand.Float64()*d.jitterFactor*float64(requeueTime))
+}
+
 func shouldRequeue(err error) (bool, requeuableError) {
 	var requeueableError requeuableError
 	return errors.As(err, &requeueableError), requeueableError
@@ -73,6 +73,38 @@ func Test_componentRequeueHandler_Handle(t *testing.T) {
 
 		assert.Equal(t, reconcile.Result{Requeue: false, RequeueAfter: 0}, actual)
 	})
+	t.Run("should persist capped requeue time", func(t *testing.T) {
+		// given
+		component := createComponent("k8s-dogu-operator", "official", "1.2.3")
+		component.Status.RequeueTimeNanos = time.Minute
+
+		componentInterfaceMock := newMockComponentInterface(t)
+		componentInterfaceMock.EXPECT().Get(testCtx, component.Name, mock.Anything).Return(component, nil)
+		componentInterfaceMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(ctx context.Context, updated *v1.Component, options metav1.UpdateOptions) (*v1.Component, error) {
+				assert.Equal(t, 90*time.Second, updated.Status.RequeueTimeNanos)
+				return updated, nil
+			})
+		componentClientGetterMock := newMockComponentV1Alpha1Interface(t)
+		componentClientGetterMock.EXPECT().Components(testNamespace).Return(componentInterfaceMock)
+		clientSetMock := newMockComponentEcosystemInterface(t)
+		clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentClientGetterMock)
+
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", "Requeue", "Falling back to component status %s: Trying again in %s.", "upgrading", "1m30s")
+
+		sut := NewComponentRequeueHandler(clientSetMock, recorderMock, testNamespace, 3*time.Second, 90*time.Second)
+		sut.jitterFactor = 0
+
+		requeueErr := &genericRequeueableError{"oh noez", assert.AnError}
+
+		// when
+		actual, err := sut.Handle(testCtx, "", component, requeueErr, "upgrading")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{Requeue: true, RequeueAfter: 90 * time.Second}, actual)
+	})
 	t.Run("should succeed", func(t *testing.T) {
 		// given
 		component := createComponent("k8s-dogu-operator", "official", "1.2.3")
@@ -104,6 +136,38 @@ func Test_componentRequeueHandler_Handle(t *testing.T) {
 	})
 }
 
+func Test_componentRequeueHandler_capRequeueTime(t *testing.T) {
+	t.Run("should cap requeue time", func(t *testing.T) {
+		sut := &componentRequeueHandler{maxRequeueTime: time.Minute}
+
+		assert.Equal(t, time.Minute, sut.capRequeueTime(2*time.Minute))
+		assert.Equal(t, 30*time.Second, sut.capRequeueTime(30*time.Second))
+	})
+	t.Run("should not cap without configured maximum", func(t *testing.T) {
+		sut := &componentRequeueHandler{}
+
+		assert.Equal(t, time.Hour, sut.capRequeueTime(time.Hour))
+	})
+}
+
+func Test_componentRequeueHandler_addJitter(t *testing.T) {
+	t.Run("should shorten requeue time by at most the jitter factor", func(t *testing.T) {
+		sut := &componentRequeueHandler{jitterFactor: defaultRequeueJitterFactor}
+
+		for range 100 {
+			actual := sut.addJitter(10 * time.Second)
+
+			assert.LessOrEqual(t, actual, 10*time.Second)
+			assert.GreaterOrEqual(t, actual, 9*time.Second)
+		}
+	})
+	t.Run("should not change requeue time without jitter factor", func(t *testing.T) {
+		sut := &componentRequeueHandler{}
+
+		assert.Equal(t, 10*time.Second, sut.addJitter(10*time.Second))
+	})
+}
+
 func createComponent(name, namespace, version string) *v1.Component {
 	return &v1.Component{
 		ObjectMeta: metav1.ObjectMeta{
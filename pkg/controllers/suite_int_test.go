@@ -12,7 +12,9 @@ import (
 
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
 	"github.com/cloudogu/k8s-component-lib/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
 	"github.com/cloudogu/k8s-component-operator/pkg/config"
+	"github.com/cloudogu/k8s-component-operator/pkg/journal"
 	"github.com/cloudogu/k8s-component-operator/pkg/yaml"
 	"k8s.io/client-go/kubernetes"
 
@@ -109,6 +111,7 @@ var _ = ginkgo.BeforeSuite(func() {
 	helmClientFactoryMock := newMockHelmClientFactory(t)
 	helmClientFactoryMock.EXPECT().NewHelmClient().Return(helmClientMock, nil).Maybe()
 
+	operationJournal := journal.New(componentClientSet.CoreV1().ConfigMaps(namespace))
 	reconciler := &ComponentReconciler{
 		clientSet: componentClientSet,
 		recorder:  recorderMock,
@@ -117,19 +120,23 @@ var _ = ginkgo.BeforeSuite(func() {
 			clientSet: componentClientSet,
 			recorder:  recorderMock,
 			timeout:   defaultHelmClientTimeoutMins,
+			journal:   operationJournal,
 		},
 		helmClientFactory: helmClientFactoryMock,
 		operationEvaluatorFactory: &defaultOperationEvaluatorFactory{
-			recorder:       recorderMock,
-			timeout:        defaultHelmClientTimeoutMins,
-			yamlSerializer: yaml.NewSerializer(),
-			reader:         configMapRefReaderMock,
+			componentClient: componentClientSet.ComponentV1Alpha1().Components(namespace),
+			recorder:        recorderMock,
+			timeout:         defaultHelmClientTimeoutMins,
+			yamlSerializer:  yaml.NewSerializer(),
+			reader:          configMapRefReaderMock,
 		},
-		requeueHandler: NewComponentRequeueHandler(componentClientSet, recorderMock, namespace, defaultRequeueTime),
-		namespace:      namespace,
-		timeout:        defaultHelmClientTimeoutMins,
-		yamlSerializer: yaml.NewSerializer(),
-		reader:         configMapRefReaderMock,
+		requeueHandler:  NewComponentRequeueHandler(componentClientSet, recorderMock, namespace, defaultRequeueTime, 10*time.Minute),
+		conditionWriter: conditions.NewWriter(componentClientSet.ComponentV1Alpha1().Components(namespace)),
+		namespace:       namespace,
+		timeout:         defaultHelmClientTimeoutMins,
+		yamlSerializer:  yaml.NewSerializer(),
+		reader:          configMapRefReaderMock,
+		journal:         operationJournal,
 	}
 
 	err = reconciler.SetupWithManager(k8sManager)
@@ -0,0 +1,44 @@
+package discovery
+
+import (
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	componentClient "github.com/cloudogu/k8s-component-lib/client"
+)
+
+type ecosystemClientSet interface {
+	componentClient.ComponentEcosystemInterface
+}
+
+type componentInterface interface {
+	componentClient.ComponentInterface
+}
+
+type configMapInterface interface {
+	v1.ConfigMapInterface
+}
+
+type helmClient interface {
+	// ListReleasesByStateMask lists all releases in the ecosystem namespace which match the given states.
+	ListReleasesByStateMask(states action.ListStates) ([]*release.Release, error)
+}
+
+type helmClientFactory interface {
+	NewHelmClient() (helmClient, error)
+}
+
+// interfaces for mocks
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type componentV1Alpha1Client interface {
+	componentClient.ComponentV1Alpha1Interface
+}
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type coreV1Interface interface {
+	v1.CoreV1Interface
+}
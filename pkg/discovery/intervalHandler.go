@@ -0,0 +1,317 @@
+// Package discovery regularly compares the helm releases in the ecosystem namespace with the component resources and
+// reports releases without a component, components without a release and stuck releases.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+const (
+	// SummaryConfigMapName is the name of the config map containing the result of the last discovery.
+	SummaryConfigMapName = "k8s-component-operator-discovery"
+	// summaryKey is the key of the discovery result in the summary config map.
+	summaryKey = "summary.yaml"
+	// operatorName is used as value of the component name label of the summary config map.
+	operatorName = "k8s-component-operator"
+)
+
+// Release describes a helm release found by the discovery.
+type Release struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     string `json:"chart"`
+	Version   string `json:"version"`
+	Status    string `json:"status"`
+	Revision  int    `json:"revision"`
+}
+
+// Summary is the result of a discovery.
+type Summary struct {
+	// LastDiscovery is the time of the discovery.
+	LastDiscovery metav1.Time `json:"lastDiscovery"`
+	// ReleasesWithoutComponent contains all releases no component resource belongs to.
+	ReleasesWithoutComponent []Release `json:"releasesWithoutComponent"`
+	// ComponentsWithoutRelease contains the names of all installed components whose release vanished.
+	ComponentsWithoutRelease []string `json:"componentsWithoutRelease"`
+	// StuckReleases contains all releases in the status failed or in a pending status.
+	StuckReleases []Release `json:"stuckReleases"`
+	// CreatedComponents contains the names of the component resources created for releases without a component.
+	CreatedComponents []string `json:"createdComponents,omitempty"`
+	// SkippedReleases contains the deployed releases without a component for which no component resource was created
+	// because the release name differs from the chart name. Components always use the chart name as release name.
+	SkippedReleases []Release `json:"skippedReleases,omitempty"`
+}
+
+// IntervalHandler regularly compares the helm releases in the ecosystem namespace with the component resources and
+// stores the result in the config map SummaryConfigMapName. Optionally, component resources are created for deployed
+// releases without a component. These components adopt their release.
+type IntervalHandler struct {
+	namespace         string
+	componentClient   componentInterface
+	configMapClient   configMapInterface
+	helmClientFactory helmClientFactory
+	interval          time.Duration
+	createComponents  bool
+	componentRegistry string
+	now               func() time.Time
+}
+
+// NewIntervalHandler creates a new IntervalHandler. Components created for releases without a component get the given
+// registry namespace.
+func NewIntervalHandler(namespace string, clientSet ecosystemClientSet, newHelmClient helm.NewHelmClientFunc[helmClient], interval time.Duration, createComponents bool, componentRegistry string) *IntervalHandler {
+	return &IntervalHandler{
+		namespace:         namespace,
+		componentClient:   clientSet.ComponentV1Alpha1().Components(namespace),
+		configMapClient:   clientSet.CoreV1().ConfigMaps(namespace),
+		helmClientFactory: newHelmClient,
+		interval:          interval,
+		createComponents:  createComponents,
+		componentRegistry: componentRegistry,
+		now:               time.Now,
+	}
+}
+
+// Start regularly discovers orphaned releases and components until the context is done.
+func (h *IntervalHandler) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).
+		WithName("discovery interval handler")
+	logger.Info(fmt.Sprintf("started regularly discovering orphaned releases and components with interval %s", h.interval))
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			logger.Info("regularly discovering orphaned releases and components...")
+			err := h.discover(ctx)
+			if err != nil {
+				logger.Error(err, "failed to discover orphaned releases and components")
+			}
+		}
+	}
+}
+
+func (h *IntervalHandler) discover(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	components, err := h.componentClient.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list components: %w", err)
+	}
+
+	hc, err := h.helmClientFactory.NewHelmClient()
+	if err != nil {
+		return fmt.Errorf("failed to create helm client: %w", err)
+	}
+
+	releases, err := hc.ListReleasesByStateMask(action.ListAll)
+	if err != nil {
+		return fmt.Errorf("failed to list releases: %w", err)
+	}
+
+	summary := compare(latestReleases(releases), components.Items)
+	summary.LastDiscovery = metav1.NewTime(h.now())
+	logger.Info(fmt.Sprintf("discovered %d releases without component, %d components without release and %d stuck releases",
+		len(summary.ReleasesWithoutComponent), len(summary.ComponentsWithoutRelease), len(summary.StuckReleases)))
+
+	if h.createComponents {
+		summary.CreatedComponents, summary.SkippedReleases, err = h.createMissingComponents(ctx, summary.ReleasesWithoutComponent)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = h.storeSummary(ctx, summary)
+	if err != nil {
+		return fmt.Errorf("failed to store discovery summary in config map %s: %w", SummaryConfigMapName, err)
+	}
+
+	return nil
+}
+
+// latestReleases returns the latest revision of every release which is not uninstalled.
+func latestReleases(releases []*release.Release) map[string]*release.Release {
+	latest := map[string]*release.Release{}
+	for _, r := range releases {
+		if r.Info == nil {
+			continue
+		}
+
+		if current, ok := latest[r.Name]; !ok || r.Version > current.Version {
+			latest[r.Name] = r
+		}
+	}
+
+	for name, r := range latest {
+		if r.Info.Status == release.StatusUninstalled {
+			delete(latest, name)
+		}
+	}
+
+	return latest
+}
+
+func compare(releases map[string]*release.Release, components []k8sv1.Component) Summary {
+	summary := Summary{
+		ReleasesWithoutComponent: []Release{},
+		ComponentsWithoutRelease: []string{},
+		StuckReleases:            []Release{},
+	}
+
+	componentNames := map[string]bool{}
+	for _, component := range components {
+		componentNames[component.Spec.Name] = true
+
+		if _, hasRelease := releases[component.Spec.Name]; !hasRelease && isInstalled(component) {
+			summary.ComponentsWithoutRelease = append(summary.ComponentsWithoutRelease, component.Name)
+		}
+	}
+
+	for _, r := range releases {
+		if !componentNames[r.Name] {
+			summary.ReleasesWithoutComponent = append(summary.ReleasesWithoutComponent, toRelease(r))
+		}
+
+		if r.Info.Status == release.StatusFailed || r.Info.Status.IsPending() {
+			summary.StuckReleases = append(summary.StuckReleases, toRelease(r))
+		}
+	}
+
+	slices.Sort(summary.ComponentsWithoutRelease)
+	sortReleases(summary.ReleasesWithoutComponent)
+	sortReleases(summary.StuckReleases)
+
+	return summary
+}
+
+func isInstalled(component k8sv1.Component) bool {
+	return component.Status.Status == k8sv1.ComponentStatusInstalled && component.DeletionTimestamp == nil
+}
+
+func toRelease(r *release.Release) Release {
+	result := Release{
+		Name:      r.Name,
+		Namespace: r.Namespace,
+		Status:    r.Info.Status.String(),
+		Revision:  r.Version,
+	}
+
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		result.Chart = r.Chart.Metadata.Name
+		result.Version = r.Chart.Metadata.Version
+	}
+
+	return result
+}
+
+func sortReleases(releases []Release) {
+	slices.SortFunc(releases, func(a, b Release) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// createMissingComponents creates a component with the AdoptAnnotation for every deployed release without a component.
+// Releases whose name differs from their chart name are skipped as a component could never adopt them. It returns the
+// names of the created components and the skipped releases.
+func (h *IntervalHandler) createMissingComponents(ctx context.Context, releases []Release) (created []string, skipped []Release, err error) {
+	logger := log.FromContext(ctx)
+	for _, r := range releases {
+		if r.Status != release.StatusDeployed.String() || r.Chart == "" {
+			continue
+		}
+
+		if r.Name != r.Chart {
+			logger.Info(fmt.Sprintf("skipped creating a component for release %s because it was installed from chart %s with another name", r.Name, r.Chart))
+			skipped = append(skipped, r)
+			continue
+		}
+
+		_, err = h.componentClient.Create(ctx, h.newComponent(r), metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			return created, skipped, fmt.Errorf("failed to create component for release %s: %w", r.Name, err)
+		}
+
+		logger.Info(fmt.Sprintf("created component %s to adopt release %s", r.Name, r.Name))
+		created = append(created, r.Name)
+	}
+
+	return created, skipped, nil
+}
+
+func (h *IntervalHandler) newComponent(r Release) *k8sv1.Component {
+	component := &k8sv1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        r.Name,
+			Namespace:   h.namespace,
+			Annotations: map[string]string{annotations.AdoptAnnotation: "true"},
+		},
+		Spec: k8sv1.ComponentSpec{
+			Namespace: h.componentRegistry,
+			Name:      r.Chart,
+			Version:   r.Version,
+		},
+	}
+
+	if r.Namespace != h.namespace {
+		component.Spec.DeployNamespace = r.Namespace
+	}
+
+	return component
+}
+
+// storeSummary creates or updates the summary config map.
+func (h *IntervalHandler) storeSummary(ctx context.Context, summary Summary) error {
+	summaryBytes, err := yaml.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	data := map[string]string{summaryKey: string(summaryBytes)}
+
+	return retry.OnConflict(func() error {
+		configMap, err := h.configMapClient.Get(ctx, SummaryConfigMapName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			_, err = h.configMapClient.Create(ctx, newSummaryConfigMap(data), metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		configMap.Data = data
+		_, err = h.configMapClient.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func newSummaryConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   SummaryConfigMapName,
+			Labels: map[string]string{k8sv1.ComponentNameLabelKey: operatorName},
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,318 @@
+package discovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+)
+
+const testNamespace = "ecosystem"
+
+var testCtx = context.Background()
+var testNow = time.Date(2024, time.January, 1, 3, 0, 0, 0, time.UTC)
+
+func newTestComponent(name string, status string) k8sv1.Component {
+	return k8sv1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec:       k8sv1.ComponentSpec{Namespace: "k8s", Name: name, Version: "1.0.0"},
+		Status:     k8sv1.ComponentStatus{Status: status, InstalledVersion: "1.0.0"},
+	}
+}
+
+func newTestRelease(name string, namespace string, revision int, status release.Status) *release.Release {
+	return &release.Release{
+		Name:      name,
+		Namespace: namespace,
+		Version:   revision,
+		Info:      &release.Info{Status: status},
+		Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: name, Version: "1.2.3"}},
+	}
+}
+
+func newTestHandler(componentClient componentInterface, configMapClient configMapInterface, hc helmClient) *IntervalHandler {
+	factoryMock := &mockHelmClientFactory{}
+	factoryMock.EXPECT().NewHelmClient().Return(hc, nil).Maybe()
+	return &IntervalHandler{
+		namespace:         testNamespace,
+		componentClient:   componentClient,
+		configMapClient:   configMapClient,
+		helmClientFactory: factoryMock,
+		interval:          time.Hour,
+		componentRegistry: "k8s",
+		now:               func() time.Time { return testNow },
+	}
+}
+
+func parseSummary(t *testing.T, configMap *corev1.ConfigMap) Summary {
+	t.Helper()
+	summary := Summary{}
+	require.NoError(t, yaml.Unmarshal([]byte(configMap.Data[summaryKey]), &summary))
+	return summary
+}
+
+func TestNewIntervalHandler(t *testing.T) {
+	// given
+	componentMock := newMockComponentInterface(t)
+	componentV1Mock := newMockComponentV1Alpha1Client(t)
+	componentV1Mock.EXPECT().Components(testNamespace).Return(componentMock)
+	configMapMock := newMockConfigMapInterface(t)
+	coreV1Mock := newMockCoreV1Interface(t)
+	coreV1Mock.EXPECT().ConfigMaps(testNamespace).Return(configMapMock)
+	clientSetMock := newMockEcosystemClientSet(t)
+	clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentV1Mock)
+	clientSetMock.EXPECT().CoreV1().Return(coreV1Mock)
+
+	// when
+	actual := NewIntervalHandler(testNamespace, clientSetMock, nil, time.Hour, true, "k8s")
+
+	// then
+	require.NotNil(t, actual)
+	assert.Equal(t, componentMock, actual.componentClient)
+	assert.Equal(t, configMapMock, actual.configMapClient)
+	assert.Equal(t, time.Hour, actual.interval)
+	assert.True(t, actual.createComponents)
+	assert.Equal(t, "k8s", actual.componentRegistry)
+	assert.NotNil(t, actual.now)
+}
+
+func TestIntervalHandler_Start(t *testing.T) {
+	t.Run("should stop when context is done", func(t *testing.T) {
+		// given
+		sut := newTestHandler(newMockComponentInterface(t), newMockConfigMapInterface(t), newMockHelmClient(t))
+		ctx, cancel := context.WithCancel(testCtx)
+		cancel()
+
+		// when
+		err := sut.Start(ctx)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestIntervalHandler_discover(t *testing.T) {
+	t.Run("should store summary of orphaned releases and components", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(&k8sv1.ComponentList{Items: []k8sv1.Component{
+			newTestComponent("k8s-dogu-operator", k8sv1.ComponentStatusInstalled),
+			newTestComponent("k8s-etcd", k8sv1.ComponentStatusInstalled),
+			newTestComponent("k8s-longhorn", k8sv1.ComponentStatusInstalling),
+		}}, nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().ListReleasesByStateMask(action.ListAll).Return([]*release.Release{
+			newTestRelease("k8s-dogu-operator", testNamespace, 2, release.StatusPendingUpgrade),
+			newTestRelease("k8s-loki", testNamespace, 1, release.StatusDeployed),
+			newTestRelease("k8s-velero", testNamespace, 1, release.StatusUninstalled),
+		}, nil)
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, SummaryConfigMapName, metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{}, SummaryConfigMapName))
+		configMapMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).RunAndReturn(
+			func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.CreateOptions) (*corev1.ConfigMap, error) {
+				assert.Equal(t, SummaryConfigMapName, configMap.Name)
+				summary := parseSummary(t, configMap)
+				assert.Equal(t, testNow, summary.LastDiscovery.UTC())
+				assert.Equal(t, []Release{{Name: "k8s-loki", Namespace: testNamespace, Chart: "k8s-loki", Version: "1.2.3", Status: "deployed", Revision: 1}}, summary.ReleasesWithoutComponent)
+				assert.Equal(t, []string{"k8s-etcd"}, summary.ComponentsWithoutRelease)
+				assert.Equal(t, []Release{{Name: "k8s-dogu-operator", Namespace: testNamespace, Chart: "k8s-dogu-operator", Version: "1.2.3", Status: "pending-upgrade", Revision: 2}}, summary.StuckReleases)
+				assert.Empty(t, summary.CreatedComponents)
+				return configMap, nil
+			})
+		sut := newTestHandler(componentMock, configMapMock, helmMock)
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should create components for deployed releases without component", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(&k8sv1.ComponentList{}, nil)
+		componentMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).RunAndReturn(
+			func(_ context.Context, component *k8sv1.Component, _ metav1.CreateOptions) (*k8sv1.Component, error) {
+				assert.Equal(t, "k8s-longhorn", component.Name)
+				assert.Equal(t, testNamespace, component.Namespace)
+				assert.Equal(t, "true", component.Annotations[annotations.AdoptAnnotation])
+				assert.Equal(t, k8sv1.ComponentSpec{Namespace: "k8s", Name: "k8s-longhorn", Version: "1.2.3", DeployNamespace: "longhorn-system"}, component.Spec)
+				return component, nil
+			})
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().ListReleasesByStateMask(action.ListAll).Return([]*release.Release{
+			newTestRelease("k8s-longhorn", "longhorn-system", 1, release.StatusDeployed),
+			newTestRelease("k8s-loki", testNamespace, 1, release.StatusFailed),
+		}, nil)
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, SummaryConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{}, nil)
+		configMapMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.UpdateOptions) (*corev1.ConfigMap, error) {
+				summary := parseSummary(t, configMap)
+				assert.Len(t, summary.ReleasesWithoutComponent, 2)
+				assert.Equal(t, []string{"k8s-longhorn"}, summary.CreatedComponents)
+				return configMap, nil
+			})
+		sut := newTestHandler(componentMock, configMapMock, helmMock)
+		sut.createComponents = true
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail to list components", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, newMockConfigMapInterface(t), newMockHelmClient(t))
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list components")
+	})
+	t.Run("should fail to create helm client", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(&k8sv1.ComponentList{}, nil)
+		factoryMock := newMockHelmClientFactory(t)
+		factoryMock.EXPECT().NewHelmClient().Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, newMockConfigMapInterface(t), nil)
+		sut.helmClientFactory = factoryMock
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to create helm client")
+	})
+	t.Run("should fail to list releases", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(&k8sv1.ComponentList{}, nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().ListReleasesByStateMask(action.ListAll).Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, newMockConfigMapInterface(t), helmMock)
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list releases")
+	})
+	t.Run("should fail to create component", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(&k8sv1.ComponentList{}, nil)
+		componentMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).Return(nil, assert.AnError)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().ListReleasesByStateMask(action.ListAll).Return([]*release.Release{
+			newTestRelease("k8s-loki", testNamespace, 1, release.StatusDeployed),
+		}, nil)
+		sut := newTestHandler(componentMock, newMockConfigMapInterface(t), helmMock)
+		sut.createComponents = true
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to create component for release k8s-loki")
+	})
+	t.Run("should fail to store summary", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(testCtx, metav1.ListOptions{}).Return(&k8sv1.ComponentList{}, nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().ListReleasesByStateMask(action.ListAll).Return(nil, nil)
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, SummaryConfigMapName, metav1.GetOptions{}).Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, configMapMock, helmMock)
+
+		// when
+		err := sut.discover(testCtx)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to store discovery summary in config map k8s-component-operator-discovery")
+	})
+}
+
+func Test_latestReleases(t *testing.T) {
+	// given
+	releases := []*release.Release{
+		newTestRelease("k8s-loki", testNamespace, 1, release.StatusSuperseded),
+		newTestRelease("k8s-loki", testNamespace, 2, release.StatusDeployed),
+		newTestRelease("k8s-velero", testNamespace, 2, release.StatusUninstalled),
+		newTestRelease("k8s-velero", testNamespace, 1, release.StatusSuperseded),
+		{Name: "broken"},
+	}
+
+	// when
+	actual := latestReleases(releases)
+
+	// then
+	require.Len(t, actual, 1)
+	assert.Equal(t, 2, actual["k8s-loki"].Version)
+}
+
+func TestIntervalHandler_createMissingComponents(t *testing.T) {
+	t.Run("should skip existing components and releases which are not deployed", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).
+			Return(nil, k8serrors.NewAlreadyExists(schema.GroupResource{}, "k8s-loki"))
+		sut := newTestHandler(componentMock, nil, nil)
+
+		// when
+		actual, skipped, err := sut.createMissingComponents(testCtx, []Release{
+			{Name: "k8s-loki", Namespace: testNamespace, Chart: "k8s-loki", Version: "1.2.3", Status: "deployed"},
+			{Name: "k8s-velero", Namespace: testNamespace, Chart: "k8s-velero", Version: "1.2.3", Status: "failed"},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+		assert.Empty(t, skipped)
+	})
+
+	t.Run("should skip releases whose name differs from their chart", func(t *testing.T) {
+		// given
+		sut := newTestHandler(newMockComponentInterface(t), nil, nil)
+		renamedRelease := Release{Name: "longhorn", Namespace: "longhorn-system", Chart: "k8s-longhorn", Version: "1.2.3", Status: "deployed"}
+
+		// when
+		actual, skipped, err := sut.createMissingComponents(testCtx, []Release{renamedRelease})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+		assert.Equal(t, []Release{renamedRelease}, skipped)
+	})
+}
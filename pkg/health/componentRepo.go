@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
 	"github.com/cloudogu/retry-lib/retry"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -50,6 +51,7 @@ func (cr *defaultComponentRepo) updateCondition(ctx context.Context, component *
 		if version != noVersionChange {
 			component.Status.InstalledVersion = version
 		}
+		conditions.Set(component, healthConditions(component)...)
 
 		_, err = cr.client.UpdateStatus(ctx, component, metav1.UpdateOptions{})
 		if err != nil {
@@ -1,14 +1,17 @@
 package health
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
 )
 
 func Test_defaultComponentRepo_get(t *testing.T) {
@@ -191,8 +194,13 @@ func Test_defaultComponentRepo_updateCondition(t *testing.T) {
 			clientMock.EXPECT().Get(testCtx, testComponentName, metav1.GetOptions{}).
 				Return(tt.mockValues.getComponent, tt.mockValues.getErr)
 			if tt.mockValues.shouldUpdate {
-				clientMock.EXPECT().UpdateStatus(testCtx, tt.mockValues.updateComponentIn, metav1.UpdateOptions{}).
-					Return(tt.mockValues.updateComponentOut, tt.mockValues.updateErr)
+				clientMock.EXPECT().UpdateStatus(testCtx, mock.Anything, metav1.UpdateOptions{}).
+					RunAndReturn(func(_ context.Context, component *v1.Component, _ metav1.UpdateOptions) (*v1.Component, error) {
+						assert.Equal(t, tt.mockValues.updateComponentIn.Status.Health, component.Status.Health)
+						assert.Equal(t, tt.mockValues.updateComponentIn.Status.InstalledVersion, component.Status.InstalledVersion)
+						assert.NotNil(t, conditions.Find(component, conditions.TypeReady))
+						return tt.mockValues.updateComponentOut, tt.mockValues.updateErr
+					})
 			}
 			cr := &defaultComponentRepo{client: clientMock}
 			tt.wantErr(t, cr.updateCondition(testCtx, tt.args.component, tt.args.statusFn, tt.args.version))
@@ -0,0 +1,32 @@
+package health
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+)
+
+// healthConditions returns the Ready and Degraded conditions matching the health of the component.
+// An unavailable component is only degraded if it is installed. Otherwise, it is simply not ready yet.
+func healthConditions(component *v1.Component) []metav1.Condition {
+	switch component.Status.Health {
+	case v1.AvailableHealthStatus:
+		return newHealthConditions(metav1.ConditionTrue, metav1.ConditionFalse, conditions.ReasonAvailable, "All applications of the component are available")
+	case v1.UnknownHealthStatus:
+		return newHealthConditions(metav1.ConditionUnknown, metav1.ConditionUnknown, conditions.ReasonHealthUnknown, "The health of the component is unknown")
+	}
+
+	if component.Status.Status == v1.ComponentStatusInstalled {
+		return newHealthConditions(metav1.ConditionFalse, metav1.ConditionTrue, conditions.ReasonUnavailable, "Not all applications of the component are available")
+	}
+
+	return newHealthConditions(metav1.ConditionFalse, metav1.ConditionFalse, conditions.ReasonNotInstalled, "The component is not installed yet or an operation is in progress")
+}
+
+func newHealthConditions(ready, degraded metav1.ConditionStatus, reason, message string) []metav1.Condition {
+	return []metav1.Condition{
+		{Type: conditions.TypeReady, Status: ready, Reason: reason, Message: message},
+		{Type: conditions.TypeDegraded, Status: degraded, Reason: reason, Message: message},
+	}
+}
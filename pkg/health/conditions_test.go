@@ -0,0 +1,47 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/conditions"
+)
+
+func Test_healthConditions(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       string
+		health       v1.HealthStatus
+		wantReady    metav1.ConditionStatus
+		wantDegraded metav1.ConditionStatus
+		wantReason   string
+	}{
+		{name: "available", status: v1.ComponentStatusInstalled, health: v1.AvailableHealthStatus, wantReady: metav1.ConditionTrue, wantDegraded: metav1.ConditionFalse, wantReason: conditions.ReasonAvailable},
+		{name: "unavailable after installation", status: v1.ComponentStatusInstalled, health: v1.UnavailableHealthStatus, wantReady: metav1.ConditionFalse, wantDegraded: metav1.ConditionTrue, wantReason: conditions.ReasonUnavailable},
+		{name: "unavailable during installation", status: v1.ComponentStatusInstalling, health: v1.UnavailableHealthStatus, wantReady: metav1.ConditionFalse, wantDegraded: metav1.ConditionFalse, wantReason: conditions.ReasonNotInstalled},
+		{name: "pending", status: v1.ComponentStatusNotInstalled, health: v1.PendingHealthStatus, wantReady: metav1.ConditionFalse, wantDegraded: metav1.ConditionFalse, wantReason: conditions.ReasonNotInstalled},
+		{name: "unknown", status: v1.ComponentStatusInstalled, health: v1.UnknownHealthStatus, wantReady: metav1.ConditionUnknown, wantDegraded: metav1.ConditionUnknown, wantReason: conditions.ReasonHealthUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			component := &v1.Component{Status: v1.ComponentStatus{Status: tt.status, Health: tt.health}}
+
+			// when
+			actual := healthConditions(component)
+
+			// then
+			require.Len(t, actual, 2)
+			assert.Equal(t, conditions.TypeReady, actual[0].Type)
+			assert.Equal(t, tt.wantReady, actual[0].Status)
+			assert.Equal(t, tt.wantReason, actual[0].Reason)
+			assert.Equal(t, conditions.TypeDegraded, actual[1].Type)
+			assert.Equal(t, tt.wantDegraded, actual[1].Status)
+			assert.Equal(t, tt.wantReason, actual[1].Reason)
+		})
+	}
+}
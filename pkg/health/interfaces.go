@@ -4,6 +4,8 @@ import (
 	"context"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -31,6 +33,8 @@ type ComponentManager interface {
 	UpdateComponentHealth(ctx context.Context, componentName string, namespace string) error
 	UpdateComponentHealthWithInstalledVersion(ctx context.Context, componentName string, namespace string, version string) error
 	UpdateComponentHealthAll(ctx context.Context) error
+	// IsComponentAvailable returns true if all applications of the component are available.
+	IsComponentAvailable(ctx context.Context, componentName string, namespace string) (bool, error)
 }
 
 type applicationFinder interface {
@@ -52,6 +56,17 @@ type componentRepo interface {
 	updateCondition(ctx context.Context, component *v1.Component, statusFn func() (v1.HealthStatus, error), version string) error
 }
 
+// replicaFinder finds other replicas of the component operator.
+type replicaFinder interface {
+	hasStandbyReplicas(ctx context.Context) (bool, error)
+}
+
+// podClient gets and lists the pods of the component operator.
+type podClient interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Pod, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error)
+}
+
 // interfaces for mocks
 
 //nolint:unused
@@ -72,7 +72,28 @@ func (m *DefaultManager) UpdateComponentHealthAll(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// IsComponentAvailable returns true if all applications of the component are available. In contrast to the health
+// status, the status of the component itself is ignored so that upgrades can be verified before they are completed.
+func (m *DefaultManager) IsComponentAvailable(ctx context.Context, componentName string, namespace string) (bool, error) {
+	deploymentList, statefulSetList, daemonSetList, err := m.findComponentApplications(ctx, componentName, namespace)
+	if err != nil {
+		return false, fmt.Errorf("failed to find applications for component %q: %w", componentName, err)
+	}
+
+	return areApplicationsAvailable(ctx, deploymentList, statefulSetList, daemonSetList), nil
+}
+
 func (m *DefaultManager) componentHealthStatus(ctx context.Context, deployments *appsv1.DeploymentList, statefulSets *appsv1.StatefulSetList, daemonSets *appsv1.DaemonSetList, component *v1.Component) v1.HealthStatus {
+	componentAvailable := areApplicationsAvailable(ctx, deployments, statefulSets, daemonSets) &&
+		component.Status.Status == v1.ComponentStatusInstalled
+
+	if componentAvailable {
+		return v1.AvailableHealthStatus
+	}
+	return v1.UnavailableHealthStatus
+}
+
+func areApplicationsAvailable(ctx context.Context, deployments *appsv1.DeploymentList, statefulSets *appsv1.StatefulSetList, daemonSets *appsv1.DaemonSetList) bool {
 	logger := log.FromContext(ctx).WithName("componentHealthStatus")
 
 	states := make([]state, 0, len(deployments.Items)+len(statefulSets.Items)+len(daemonSets.Items))
@@ -86,12 +107,7 @@ func (m *DefaultManager) componentHealthStatus(ctx context.Context, deployments
 		}
 	}
 
-	componentAvailable := util.Reduce(states, true, func(value state, acc bool) bool {
+	return util.Reduce(states, true, func(value state, acc bool) bool {
 		return value.IsAvailable() && acc
-	}) && component.Status.Status == v1.ComponentStatusInstalled
-
-	if componentAvailable {
-		return v1.AvailableHealthStatus
-	}
-	return v1.UnavailableHealthStatus
+	})
 }
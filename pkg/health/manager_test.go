@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -546,3 +547,49 @@ func TestDefaultManager_UpdateComponentHealthAll(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultManager_IsComponentAvailable(t *testing.T) {
+	t.Run("should fail to find applications", func(t *testing.T) {
+		// given
+		finder := newMockApplicationFinder(t)
+		finder.EXPECT().findComponentApplications(testCtx, testComponentName, testNamespace).Return(nil, nil, nil, assert.AnError)
+		sut := &DefaultManager{applicationFinder: finder}
+
+		// when
+		available, err := sut.IsComponentAvailable(testCtx, testComponentName, testNamespace)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, fmt.Sprintf("failed to find applications for component %q", testComponentName))
+		assert.False(t, available)
+	})
+	t.Run("should be unavailable if an application is not available", func(t *testing.T) {
+		// given
+		finder := newMockApplicationFinder(t)
+		finder.EXPECT().findComponentApplications(testCtx, testComponentName, testNamespace).
+			Return(availableDeploymentList(), unavailableStatefulSetList(), availableDaemonSetList(), nil)
+		sut := &DefaultManager{applicationFinder: finder}
+
+		// when
+		available, err := sut.IsComponentAvailable(testCtx, testComponentName, testNamespace)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, available)
+	})
+	t.Run("should be available if all applications are available", func(t *testing.T) {
+		// given
+		finder := newMockApplicationFinder(t)
+		finder.EXPECT().findComponentApplications(testCtx, testComponentName, testNamespace).
+			Return(availableDeploymentList(), availableStatefulSetList(), availableDaemonSetList(), nil)
+		sut := &DefaultManager{applicationFinder: finder}
+
+		// when
+		available, err := sut.IsComponentAvailable(testCtx, testComponentName, testNamespace)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, available)
+	})
+}
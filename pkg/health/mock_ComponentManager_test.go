@@ -21,6 +21,64 @@ func (_m *MockComponentManager) EXPECT() *MockComponentManager_Expecter {
 	return &MockComponentManager_Expecter{mock: &_m.Mock}
 }
 
+// IsComponentAvailable provides a mock function with given fields: ctx, componentName, namespace
+func (_m *MockComponentManager) IsComponentAvailable(ctx context.Context, componentName string, namespace string) (bool, error) {
+	ret := _m.Called(ctx, componentName, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsComponentAvailable")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, componentName, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, componentName, namespace)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, componentName, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockComponentManager_IsComponentAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsComponentAvailable'
+type MockComponentManager_IsComponentAvailable_Call struct {
+	*mock.Call
+}
+
+// IsComponentAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentName string
+//   - namespace string
+func (_e *MockComponentManager_Expecter) IsComponentAvailable(ctx interface{}, componentName interface{}, namespace interface{}) *MockComponentManager_IsComponentAvailable_Call {
+	return &MockComponentManager_IsComponentAvailable_Call{Call: _e.mock.On("IsComponentAvailable", ctx, componentName, namespace)}
+}
+
+func (_c *MockComponentManager_IsComponentAvailable_Call) Run(run func(ctx context.Context, componentName string, namespace string)) *MockComponentManager_IsComponentAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockComponentManager_IsComponentAvailable_Call) Return(_a0 bool, _a1 error) *MockComponentManager_IsComponentAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockComponentManager_IsComponentAvailable_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockComponentManager_IsComponentAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateComponentHealth provides a mock function with given fields: ctx, componentName, namespace
 func (_m *MockComponentManager) UpdateComponentHealth(ctx context.Context, componentName string, namespace string) error {
 	ret := _m.Called(ctx, componentName, namespace)
@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// operatorPodLabelKeys are the keys of the labels which all pods of the component operator have in common.
+var operatorPodLabelKeys = []string{"control-plane", "app.kubernetes.io/name", "app.kubernetes.io/instance"}
+
+type defaultReplicaFinder struct {
+	podClient podClient
+	podName   string
+}
+
+// hasStandbyReplicas returns true if another ready replica of the component operator can take over the leadership.
+// Replicas which are terminating are ignored. Without the name of the own pod no replicas can be found.
+func (rf *defaultReplicaFinder) hasStandbyReplicas(ctx context.Context) (bool, error) {
+	if rf.podName == "" {
+		return false, nil
+	}
+
+	ownPod, err := rf.podClient.Get(ctx, rf.podName, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to get pod %q of the component operator: %w", rf.podName, err)
+	}
+
+	selector := labels.Set{}
+	for _, key := range operatorPodLabelKeys {
+		if value, ok := ownPod.Labels[key]; ok {
+			selector[key] = value
+		}
+	}
+	if len(selector) == 0 {
+		return false, nil
+	}
+
+	pods, err := rf.podClient.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return false, fmt.Errorf("failed to list pods of the component operator: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Name != rf.podName && pod.DeletionTimestamp == nil && isPodReady(pod) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func isPodReady(pod corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
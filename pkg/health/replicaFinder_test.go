@@ -0,0 +1,118 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testPodName = "k8s-component-operator-abc"
+
+var testOperatorPodLabels = map[string]string{
+	"control-plane":              "controller-manager",
+	"app.kubernetes.io/name":     "k8s-component-operator",
+	"app.kubernetes.io/instance": "k8s-component-operator",
+	"pod-template-hash":          "abc",
+}
+
+const testOperatorPodSelector = "app.kubernetes.io/instance=k8s-component-operator,app.kubernetes.io/name=k8s-component-operator,control-plane=controller-manager"
+
+func newTestPod(name string, ready bool) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: testOperatorPodLabels},
+		Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}},
+	}
+}
+
+func Test_defaultReplicaFinder_hasStandbyReplicas(t *testing.T) {
+	ownPod := newTestPod(testPodName, true)
+	terminatingPod := newTestPod("k8s-component-operator-terminating", true)
+	terminatingPod.DeletionTimestamp = &metav1.Time{}
+
+	tests := []struct {
+		name string
+		pods []corev1.Pod
+		want bool
+	}{
+		{name: "should find ready replica", pods: []corev1.Pod{ownPod, newTestPod("k8s-component-operator-def", true)}, want: true},
+		{name: "should ignore own pod", pods: []corev1.Pod{ownPod}, want: false},
+		{name: "should ignore unready replica", pods: []corev1.Pod{ownPod, newTestPod("k8s-component-operator-def", false)}, want: false},
+		{name: "should ignore terminating replica", pods: []corev1.Pod{ownPod, terminatingPod}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			podClientMock := newMockPodClient(t)
+			podClientMock.EXPECT().Get(testCtx, testPodName, metav1.GetOptions{}).Return(&ownPod, nil)
+			podClientMock.EXPECT().List(testCtx, metav1.ListOptions{LabelSelector: testOperatorPodSelector}).
+				Return(&corev1.PodList{Items: tt.pods}, nil)
+			sut := &defaultReplicaFinder{podClient: podClientMock, podName: testPodName}
+
+			// when
+			actual, err := sut.hasStandbyReplicas(testCtx)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, actual)
+		})
+	}
+	t.Run("should not find replicas without pod name", func(t *testing.T) {
+		// given
+		sut := &defaultReplicaFinder{podClient: newMockPodClient(t)}
+
+		// when
+		actual, err := sut.hasStandbyReplicas(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, actual)
+	})
+	t.Run("should not find replicas without operator labels", func(t *testing.T) {
+		// given
+		podClientMock := newMockPodClient(t)
+		podClientMock.EXPECT().Get(testCtx, testPodName, metav1.GetOptions{}).Return(&corev1.Pod{}, nil)
+		sut := &defaultReplicaFinder{podClient: podClientMock, podName: testPodName}
+
+		// when
+		actual, err := sut.hasStandbyReplicas(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, actual)
+	})
+	t.Run("should fail to get own pod", func(t *testing.T) {
+		// given
+		podClientMock := newMockPodClient(t)
+		podClientMock.EXPECT().Get(testCtx, testPodName, metav1.GetOptions{}).Return(nil, assert.AnError)
+		sut := &defaultReplicaFinder{podClient: podClientMock, podName: testPodName}
+
+		// when
+		_, err := sut.hasStandbyReplicas(testCtx)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get pod \"k8s-component-operator-abc\" of the component operator")
+	})
+	t.Run("should fail to list pods", func(t *testing.T) {
+		// given
+		podClientMock := newMockPodClient(t)
+		podClientMock.EXPECT().Get(testCtx, testPodName, metav1.GetOptions{}).Return(&ownPod, nil)
+		podClientMock.EXPECT().List(testCtx, metav1.ListOptions{LabelSelector: testOperatorPodSelector}).Return(nil, assert.AnError)
+		sut := &defaultReplicaFinder{podClient: podClientMock, podName: testPodName}
+
+		// when
+		_, err := sut.hasStandbyReplicas(testCtx)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list pods of the component operator")
+	})
+}
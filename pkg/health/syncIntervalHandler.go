@@ -15,13 +15,17 @@ const componentOperatorName = "k8s-component-operator"
 type SyncIntervalHandler struct {
 	manager            ComponentManager
 	repo               componentRepo
+	replicaFinder      replicaFinder
 	healthSyncInterval time.Duration
 }
 
-func NewSyncIntervalHandler(namespace string, clientSet ecosystemClientSet, healthSyncInterval time.Duration) *SyncIntervalHandler {
+// NewSyncIntervalHandler creates a handler which regularly syncs the health of all components. It only runs on the
+// leading replica of the operator, which is running in the pod with the given name.
+func NewSyncIntervalHandler(namespace string, podName string, clientSet ecosystemClientSet, healthSyncInterval time.Duration) *SyncIntervalHandler {
 	return &SyncIntervalHandler{
 		manager:            NewManager(namespace, clientSet),
 		repo:               &defaultComponentRepo{client: clientSet.ComponentV1Alpha1().Components(namespace)},
+		replicaFinder:      &defaultReplicaFinder{podClient: clientSet.CoreV1().Pods(namespace), podName: podName},
 		healthSyncInterval: healthSyncInterval,
 	}
 }
@@ -64,6 +68,16 @@ func (s *SyncIntervalHandler) Start(ctx context.Context) error {
 }
 
 func (s *SyncIntervalHandler) handleShutdown(ctx context.Context) error {
+	// another replica takes over the leadership and keeps the health of the components up to date
+	hasStandbyReplicas, err := s.replicaFinder.hasStandbyReplicas(ctx)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to find standby replicas, handling health status anyway")
+	}
+	if hasStandbyReplicas {
+		log.FromContext(ctx).Info("standby replica takes over, keeping health status")
+		return nil
+	}
+
 	components, err := s.repo.list(ctx)
 	if err != nil {
 		return err
@@ -10,6 +10,7 @@ import (
 
 	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestNewSyncIntervalHandler(t *testing.T) {
@@ -21,13 +22,15 @@ func TestNewSyncIntervalHandler(t *testing.T) {
 	componentMock := newMockComponentClient(t)
 	componentV1Mock.EXPECT().Components(testNamespace).Return(componentMock)
 	clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentV1Mock)
+	clientSetMock.EXPECT().CoreV1().Return(fake.NewSimpleClientset().CoreV1())
 
 	// when
-	actual := NewSyncIntervalHandler(testNamespace, clientSetMock, 2*time.Minute)
+	actual := NewSyncIntervalHandler(testNamespace, "k8s-component-operator-abc", clientSetMock, 2*time.Minute)
 
 	// then
 	assert.NotEmpty(t, actual)
 	assert.NotEmpty(t, actual.manager)
+	assert.NotEmpty(t, actual.replicaFinder)
 }
 
 func TestSyncIntervalHandler_Start(t *testing.T) {
@@ -284,9 +287,12 @@ func TestSyncIntervalHandler_Start(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			replicaFinderMock := newMockReplicaFinder(t)
+			replicaFinderMock.EXPECT().hasStandbyReplicas(mock.Anything).Return(false, nil)
 			s := &SyncIntervalHandler{
 				manager:            tt.managerFn(t),
 				repo:               tt.repoFn(t),
+				replicaFinder:      replicaFinderMock,
 				healthSyncInterval: time.Millisecond,
 			}
 			ctx, cancelFunc := context.WithTimeout(testCtx, 10*time.Millisecond)
@@ -295,3 +301,34 @@ func TestSyncIntervalHandler_Start(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncIntervalHandler_handleShutdown(t *testing.T) {
+	t.Run("should keep health status if a standby replica takes over", func(t *testing.T) {
+		// given
+		replicaFinderMock := newMockReplicaFinder(t)
+		replicaFinderMock.EXPECT().hasStandbyReplicas(testCtx).Return(true, nil)
+		s := &SyncIntervalHandler{repo: newMockComponentRepo(t), replicaFinder: replicaFinderMock}
+
+		// when
+		err := s.handleShutdown(testCtx)
+
+		// then
+		assert.NoError(t, err)
+	})
+	t.Run("should set health status if standby replicas cannot be found", func(t *testing.T) {
+		// given
+		replicaFinderMock := newMockReplicaFinder(t)
+		replicaFinderMock.EXPECT().hasStandbyReplicas(testCtx).Return(false, assert.AnError)
+		repoMock := newMockComponentRepo(t)
+		component := k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Name: "k8s-dogu-operator"}}
+		repoMock.EXPECT().list(testCtx).Return(&k8sv1.ComponentList{Items: []k8sv1.Component{component}}, nil)
+		repoMock.EXPECT().updateCondition(testCtx, &component, mock.Anything, noVersionChange).Return(nil)
+		s := &SyncIntervalHandler{repo: repoMock, replicaFinder: replicaFinderMock}
+
+		// when
+		err := s.handleShutdown(testCtx)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
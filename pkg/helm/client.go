@@ -2,9 +2,17 @@ package helm
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"sort"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
@@ -13,9 +21,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
-	"github.com/cloudogu/cesapp-lib/core"
 	"github.com/cloudogu/k8s-component-operator/pkg/config"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/metrics"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
 )
 
 const (
@@ -30,73 +39,209 @@ type HelmClient interface {
 	client.Client
 }
 
+// ClientFactory creates helm clients for the currently configured registries. The registries can be replaced at
+// runtime, e.g. when the credentials of a registry were rotated.
 type ClientFactory struct {
-	namespace    string
-	helmRepoData *config.HelmRepositoryData
-	debug        bool
-	debugLog     action.DebugLog
+	namespace  string
+	debug      bool
+	debugLog   action.DebugLog
+	mutex      sync.RWMutex
+	registries *config.HelmRegistries
 }
 
-func NewClientFactory(namespace string, helmRepoData *config.HelmRepositoryData, debug bool, debugLog action.DebugLog) *ClientFactory {
+func NewClientFactory(namespace string, registries *config.HelmRegistries, debug bool, debugLog action.DebugLog) *ClientFactory {
 	return &ClientFactory{
-		namespace:    namespace,
-		helmRepoData: helmRepoData,
-		debug:        debug,
-		debugLog:     debugLog,
+		namespace:  namespace,
+		registries: registries,
+		debug:      debug,
+		debugLog:   debugLog,
+	}
+}
+
+// SetRegistries replaces the registries of all helm clients created afterwards. Clients created before keep
+// accessing the previous registries.
+func (f *ClientFactory) SetRegistries(registries *config.HelmRegistries) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.registries = registries
+}
+
+// VerifyRegistries checks that all registries are reachable and accept their credentials, TLS data and proxy. OCI
+// registries are verified by a login and classic chart repositories by a request.
+func (f *ClientFactory) VerifyRegistries(ctx context.Context, registries *config.HelmRegistries) error {
+	var errs []error
+	for _, helmRepoData := range registries.Registries {
+		options, err := newRegistryOptions(f.namespace, helmRepoData, registries.Proxy, f.debug, f.debugLog)
+		if err == nil {
+			err = client.PingRegistry(ctx, options, helmRepoData.URL())
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to verify registry %s: %w", helmRepoData.Name, err))
+		}
 	}
+
+	return errors.Join(errs...)
 }
 
 func (f *ClientFactory) NewHelmClient() (*Client, error) {
-	return NewClient(f.namespace, f.helmRepoData, f.debug, f.debugLog)
+	f.mutex.RLock()
+	registries := f.registries
+	f.mutex.RUnlock()
+
+	if registries == nil {
+		return nil, fmt.Errorf("failed to create helm client: registries are not loaded yet")
+	}
+
+	return NewClient(f.namespace, registries, f.debug, f.debugLog)
 }
 
-// Client wraps the HelmClient with config.HelmRepositoryData
+// NewHelmClientFunc creates a new helm client, e.g. ClientFactory.NewHelmClient. It implements the helm client factory
+// interfaces of the packages using the helm client by their own interface T.
+type NewHelmClientFunc[T any] func() (*Client, error)
+
+// NewHelmClient creates a new helm client and returns it as T.
+func (f NewHelmClientFunc[T]) NewHelmClient() (T, error) {
+	var helmClient T
+	newClient, err := f()
+	if err != nil {
+		return helmClient, err
+	}
+
+	helmClient, ok := any(newClient).(T)
+	if !ok {
+		return helmClient, fmt.Errorf("helm client does not implement %T", &helmClient)
+	}
+
+	return helmClient, nil
+}
+
+// Client wraps the HelmClients of all configured registries with their config.HelmRepositoryData
 type Client struct {
-	helmClient        HelmClient
-	helmRepoData      *config.HelmRepositoryData
+	// helmClient accesses the first registry and the releases in the cluster.
+	helmClient   HelmClient
+	helmRepoData *config.HelmRepositoryData
+	// registries contains all registries including the first one. Charts are only looked up in the first registry if
+	// it is nil.
+	registries *config.HelmRegistries
+	// registryClients contains the helm clients of the registries used so far by their name.
+	registryClients   map[string]HelmClient
+	registryMutex     sync.Mutex
+	namespace         string
+	debug             bool
+	debugLog          action.DebugLog
 	dependencyChecker dependencyChecker
 }
 
-// NewClient create a new instance of the helm client.
-func NewClient(namespace string, helmRepoData *config.HelmRepositoryData, debug bool, debugLog action.DebugLog) (*Client, error) {
-	opt := &client.RestConfClientOptions{
-		Options: &client.Options{
-			Namespace:        namespace,
-			RepositoryCache:  helmRepositoryCache,
-			RepositoryConfig: helmRepositoryConfig,
-			RegistryConfig:   helmRegistryConfigFile,
-			Debug:            debug,
-			DebugLog:         debugLog,
-			PlainHttp:        helmRepoData.PlainHttp,
-			InsecureTls:      helmRepoData.InsecureTLS,
-		},
-		RestConfig: ctrl.GetConfigOrDie(),
+// NewClient create a new instance of the helm client. Only the helm client of the first registry is created
+// immediately; the helm clients of the other registries are created when a chart is looked up in them.
+func NewClient(namespace string, registries *config.HelmRegistries, debug bool, debugLog action.DebugLog) (*Client, error) {
+	if len(registries.Registries) == 0 {
+		return nil, fmt.Errorf("failed to create helm client: no registry configured")
 	}
 
-	helmClient, err := client.NewClientFromRestConf(opt)
+	firstRegistry := registries.Registries[0]
+	helmClient, err := newRegistryClient(namespace, firstRegistry, registries.Proxy, debug, debugLog)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create helm client: %w", err)
+		return nil, err
 	}
 
 	return &Client{
 		helmClient:        helmClient,
-		helmRepoData:      helmRepoData,
+		helmRepoData:      firstRegistry,
+		registries:        registries,
+		registryClients:   map[string]HelmClient{firstRegistry.Name: helmClient},
+		namespace:         namespace,
+		debug:             debug,
+		debugLog:          debugLog,
 		dependencyChecker: &installedDependencyChecker{},
 	}, nil
 }
 
+// registryClient returns the helm client of the given registry and creates it on first use.
+func (c *Client) registryClient(helmRepoData *config.HelmRepositoryData) (HelmClient, error) {
+	c.registryMutex.Lock()
+	defer c.registryMutex.Unlock()
+
+	if helmClient, ok := c.registryClients[helmRepoData.Name]; ok {
+		return helmClient, nil
+	}
+
+	helmClient, err := newRegistryClient(c.namespace, helmRepoData, c.registries.Proxy, c.debug, c.debugLog)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.registryClients == nil {
+		c.registryClients = map[string]HelmClient{}
+	}
+	c.registryClients[helmRepoData.Name] = helmClient
+	return helmClient, nil
+}
+
+func newRegistryClient(namespace string, helmRepoData *config.HelmRepositoryData, proxy *config.ProxyConfig, debug bool, debugLog action.DebugLog) (HelmClient, error) {
+	options, err := newRegistryOptions(namespace, helmRepoData, proxy, debug, debugLog)
+	if err != nil {
+		return nil, err
+	}
+
+	helmClient, err := client.NewClientFromRestConf(&client.RestConfClientOptions{Options: options, RestConfig: ctrl.GetConfigOrDie()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create helm client for registry %s: %w", helmRepoData.Name, err)
+	}
+
+	return helmClient, nil
+}
+
+// newRegistryOptions returns the options to access the registry with its credentials, TLS data and the proxy.
+func newRegistryOptions(namespace string, helmRepoData *config.HelmRepositoryData, proxy *config.ProxyConfig, debug bool, debugLog action.DebugLog) (*client.Options, error) {
+	tlsFiles, err := writeRegistryTlsFiles(helmRepoData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.Options{
+		Namespace:        namespace,
+		RepositoryCache:  helmRepositoryCache,
+		RepositoryConfig: helmRepositoryConfig,
+		RegistryConfig:   helmRegistryConfigFile,
+		Debug:            debug,
+		DebugLog:         debugLog,
+		PlainHttp:        helmRepoData.PlainHttp,
+		InsecureTls:      helmRepoData.InsecureTLS,
+		Username:         helmRepoData.Username,
+		Password:         helmRepoData.Password,
+		CAFile:           tlsFiles.caFile,
+		CertFile:         tlsFiles.certFile,
+		KeyFile:          tlsFiles.keyFile,
+		Proxy:            proxy.ProxyFunc(),
+	}, nil
+}
+
 // InstallOrUpgrade takes a helmChart and applies it.
 func (c *Client) InstallOrUpgrade(ctx context.Context, chart *client.ChartSpec) error {
-	// This helm-client currently only works with OCI-Helm-Repositories.
-	// Therefore, the chartName has to include the FQDN of the repository (e.g. "oci://my.repo/...")
-	// If in the future non-oci-repositories need to be used, this should be done here...
-	chart.ChartName = c.patchOciEndpoint(chart.ChartName)
-
 	if chart.Version == "" {
-		return fmt.Errorf("cannot install chart %q without version", chart.ChartName)
+		return fmt.Errorf("cannot install chart %q without version", c.patchEndpoint(chart.ChartName))
+	}
+
+	// Charts pulled by PullChart are applied from their archive. Otherwise, the chartName has to include the URL of the
+	// registry serving the chart (e.g. "oci://my.repo/..." or "https://my.repo/...").
+	helmClient := c.helmClient
+	if !isChartArchive(chart.ChartName) {
+		location, err := c.findServingLocation(ctx, chart)
+		if err != nil {
+			return fmt.Errorf("failed to find registry serving chart %s: %w", chart.ChartName, err)
+		}
+		chart.ChartName = location.ref
+		helmClient = location.helmClient
 	}
 
-	_, err := c.helmClient.InstallOrUpgradeChart(ctx, chart)
+	_, err := c.GetChartSpecValues(chart)
+	if err != nil {
+		return err
+	}
+
+	_, err = helmClient.InstallOrUpgradeChart(ctx, chart)
 	if err != nil {
 		return fmt.Errorf("error while installOrUpgrade chart %s: %w", chart.ChartName, err)
 	}
@@ -104,18 +249,39 @@ func (c *Client) InstallOrUpgrade(ctx context.Context, chart *client.ChartSpec)
 	return nil
 }
 
+// DryRunInstallOrUpgrade renders the given helmChart with a server-side dry-run and returns the rendered manifest.
+// The release itself is not changed.
+func (c *Client) DryRunInstallOrUpgrade(ctx context.Context, chart *client.ChartSpec) (string, error) {
+	chart.DryRun = true
+
+	if chart.Version == "" {
+		return "", fmt.Errorf("cannot render chart %q without version", c.patchEndpoint(chart.ChartName))
+	}
+
+	location, err := c.findServingLocation(ctx, chart)
+	if err != nil {
+		return "", fmt.Errorf("failed to find registry serving chart %s: %w", chart.ChartName, err)
+	}
+	chart.ChartName = location.ref
+
+	renderedRelease, err := location.helmClient.InstallOrUpgradeChart(ctx, chart)
+	if err != nil {
+		return "", fmt.Errorf("error while rendering chart %s with dry-run: %w", chart.ChartName, err)
+	}
+
+	return renderedRelease.Manifest, nil
+}
+
 // SatisfiesDependencies checks if all dependencies are satisfied in terms of installation and version.
 func (c *Client) SatisfiesDependencies(ctx context.Context, chart *client.ChartSpec) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Checking if components dependencies are satisfied", "component", chart.ChartName)
 
-	chart.ChartName = c.patchOciEndpoint(chart.ChartName)
-
 	if chart.Version == "" {
-		return fmt.Errorf("cannot install chart %q without version", chart.ChartName)
+		return fmt.Errorf("cannot install chart %q without version", c.patchEndpoint(chart.ChartName))
 	}
 
-	componentChart, err := c.getChart(ctx, chart)
+	_, componentChart, _, err := c.locateChart(ctx, chart)
 	if err != nil {
 		return fmt.Errorf("failed to get chart %s: %w", chart.ChartName, err)
 	}
@@ -134,21 +300,44 @@ func (c *Client) SatisfiesDependencies(ctx context.Context, chart *client.ChartS
 	return nil
 }
 
-func (c *Client) getChart(ctx context.Context, chartSpec *client.ChartSpec) (*chart.Chart, error) {
+// SatisfiesDependents checks if the given version of the component satisfies the version requirements of all
+// installed components depending on it.
+func (c *Client) SatisfiesDependents(ctx context.Context, componentName string, version string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Checking if version satisfies the requirements of dependent components", "component", componentName, "version", version)
+
+	deployedReleases, err := c.ListDeployedReleases()
+	if err != nil {
+		return fmt.Errorf("failed to list deployed releases: %w", err)
+	}
+
+	err = checkDependentConstraints(componentName, version, deployedReleases)
+	if err != nil {
+		return fmt.Errorf("version %s of component %s does not satisfy the requirements of dependent components: %w", version, componentName, err)
+	}
+
+	return nil
+}
+
+// getChart pulls the chart from the registry of the location and returns it with the path of its archive.
+func (c *Client) getChart(ctx context.Context, location chartLocation, chartSpec *client.ChartSpec) (*chart.Chart, string, error) {
 	logger := log.FromContext(ctx)
 
 	logger.Info("Trying to get chart with options",
 		"chart", chartSpec.ChartName,
 		"version", chartSpec.Version,
-		"plainHTTP", c.helmRepoData.PlainHttp,
-		"insecureTLS", c.helmRepoData.InsecureTLS)
+		"registry", location.helmRepoData.Name,
+		"plainHTTP", location.helmRepoData.PlainHttp,
+		"insecureTLS", location.helmRepoData.InsecureTLS)
 
-	componentChart, _, err := c.helmClient.GetChart(chartSpec)
+	start := time.Now()
+	componentChart, chartPath, err := location.helmClient.GetChart(chartSpec)
+	metrics.ObserveRegistryRequest(metrics.RegistryRequestPull, start, err)
 	if err != nil {
-		return nil, fmt.Errorf("error while getting chart for %s:%s: %w", chartSpec.ChartName, chartSpec.Version, err)
+		return nil, "", &registryError{fmt.Errorf("error while getting chart for %s:%s: %w", chartSpec.ChartName, chartSpec.Version, err)}
 	}
 
-	return componentChart, nil
+	return componentChart, chartPath, nil
 }
 
 // Uninstall removes the helmRelease for the given name
@@ -172,6 +361,20 @@ func (c *Client) GetRelease(name string) (*release.Release, error) {
 	return c.helmClient.GetRelease(name)
 }
 
+// GetReleaseHistory returns all stored revisions of the release with the given name.
+func (c *Client) GetReleaseHistory(name string) ([]*release.Release, error) {
+	return c.helmClient.GetReleaseHistory(name)
+}
+
+// RollbackRelease rolls the release of the given chart back to the given revision.
+func (c *Client) RollbackRelease(chart *client.ChartSpec, revision int) error {
+	if err := c.helmClient.RollbackReleaseToRevision(chart, revision); err != nil {
+		return fmt.Errorf("error while rolling back helm-release %s to revision %d: %w", chart.ReleaseName, revision, err)
+	}
+
+	return nil
+}
+
 // GetReleaseValues returns the (optionally, all computed) values for the specified release.
 func (c *Client) GetReleaseValues(name string, allValues bool) (map[string]interface{}, error) {
 	return c.helmClient.GetReleaseValues(name, allValues)
@@ -201,15 +404,23 @@ func (c *Client) GetDeployedReleaseVersion(ctx context.Context, name string) (st
 
 // GetChartSpecValues returns the additional values for the specified ChartSpec.
 func (c *Client) GetChartSpecValues(spec *client.ChartSpec) (map[string]interface{}, error) {
-	return c.helmClient.GetChartSpecValues(spec)
+	values, err := c.helmClient.GetChartSpecValues(spec)
+	if err != nil {
+		return values, &valuesError{err}
+	}
+
+	return values, nil
 }
 
 func (c *Client) MarkReleaseAsFailed(name string, reason string) error {
 	return c.helmClient.MarkReleaseAsFailed(name, reason)
 }
 
-func (c *Client) patchOciEndpoint(chartName string) string {
-	if strings.HasPrefix(chartName, ociSchemePrefix) {
+// patchEndpoint prefixes the chart name with the URL of the first configured repository unless the chart name already
+// contains a schema. Charts of https repositories are looked up by the last part of the chart name in the index.yaml
+// located at the remaining URL, e.g. "https://my.repo/k8s/index.yaml" for the chart "k8s/dogu-op".
+func (c *Client) patchEndpoint(chartName string) string {
+	if strings.Contains(chartName, "://") {
 		return chartName
 	}
 
@@ -217,10 +428,9 @@ func (c *Client) patchOciEndpoint(chartName string) string {
 }
 
 func (c *Client) GetLatestVersion(chartName string) (string, error) {
-	ref := strings.TrimPrefix(c.patchOciEndpoint(chartName), ociSchemePrefix)
-	tags, err := c.helmClient.Tags(ref)
+	tags, err := c.GetAvailableVersions(chartName)
 	if err != nil {
-		return "", fmt.Errorf("error resolving tags for chart %s: %w", chartName, err)
+		return "", err
 	}
 
 	//sort tags by version
@@ -234,27 +444,128 @@ func (c *Client) GetLatestVersion(chartName string) (string, error) {
 	return sortedTags[0], nil
 }
 
+// GetAvailableVersions returns all tags of the chart with the given name in the first registry serving it.
+func (c *Client) GetAvailableVersions(chartName string) ([]string, error) {
+	var tags []string
+	err := c.tryLocations(chartName, func(location chartLocation) error {
+		ref := strings.TrimPrefix(location.ref, ociSchemePrefix)
+		start := time.Now()
+		var err error
+		tags, err = location.helmClient.Tags(ref)
+		metrics.ObserveRegistryRequest(metrics.RegistryRequestTags, start, err)
+		if err != nil {
+			return &registryError{fmt.Errorf("error resolving tags for chart %s: %w", location.ref, err)}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// ResolveVersion returns the newest version of the chart with the given name matching the selector. Exact versions
+// are returned without accessing the registry. The installed version is only required for the patch-only channel.
+func (c *Client) ResolveVersion(chartName string, selector version.Selector, installedVersion string) (string, error) {
+	if selector.IsExact() {
+		return selector.Select(nil, installedVersion)
+	}
+
+	tags, err := c.GetAvailableVersions(chartName)
+	if err != nil {
+		return "", err
+	}
+
+	resolvedVersion, err := selector.Select(tags, installedVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve version %s for chart %s: %w", selector, chartName, err)
+	}
+
+	return resolvedVersion, nil
+}
+
+// GetChart returns the chart from the first registry serving it.
 func (c *Client) GetChart(ctx context.Context, spec *client.ChartSpec) (*chart.Chart, error) {
-	spec.ChartName = c.patchOciEndpoint(spec.ChartName)
-	return c.getChart(ctx, spec)
+	_, componentChart, _, err := c.locateChart(ctx, spec)
+	return componentChart, err
+}
+
+// PullChart pulls the chart from the first registry serving it and returns the sha256 digest of its archive. The chart
+// name of the spec is replaced by the path of the archive, so that InstallOrUpgrade applies exactly this archive
+// without pulling the chart again.
+func (c *Client) PullChart(ctx context.Context, spec *client.ChartSpec) (string, error) {
+	if spec.Version == "" {
+		return "", fmt.Errorf("cannot pull chart %q without version", c.patchEndpoint(spec.ChartName))
+	}
+
+	_, _, chartPath, err := c.locateChart(ctx, spec)
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := getArchiveDigest(chartPath)
+	if err != nil {
+		return "", err
+	}
+
+	spec.ChartName = chartPath
+	return digest, nil
 }
 
+// isChartArchive checks whether the chart name is the path of an archive pulled by PullChart. Chart names of
+// components are never absolute paths.
+func isChartArchive(chartName string) bool {
+	return filepath.IsAbs(chartName)
+}
+
+// getArchiveDigest returns the sha256 digest of the chart archive at the given path.
+func getArchiveDigest(chartPath string) (string, error) {
+	chartFile, err := os.Open(chartPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open chart archive %s: %w", chartPath, err)
+	}
+	defer func() { _ = chartFile.Close() }()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, chartFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read chart archive %s: %w", chartPath, err)
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// sortByVersionDescending sorts the tags by their version with the newest version first. Tags which are no valid
+// versions are dropped. Pre-releases are only returned if there is no release at all.
 func sortByVersionDescending(tags []string) []string {
-	versions := make([]core.Version, 0)
+	var releases, preReleases []version.Version
 	for _, tag := range tags {
-		v, err := core.ParseVersion(tag)
-		if err == nil {
-			versions = append(versions, v)
+		v, err := version.Parse(tag)
+		if err != nil {
+			continue
+		}
+
+		if v.IsPreRelease() {
+			preReleases = append(preReleases, v)
+		} else {
+			releases = append(releases, v)
 		}
 	}
 
-	sort.SliceStable(versions, func(i, j int) bool {
-		return versions[i].IsNewerOrEqualThan(versions[j])
+	versions := releases
+	if len(versions) == 0 {
+		versions = preReleases
+	}
+
+	slices.SortStableFunc(versions, func(a, b version.Version) int {
+		return b.Compare(a)
 	})
 
 	result := make([]string, len(versions))
-	for i, version := range versions {
-		result[i] = version.Raw
+	for i, v := range versions {
+		result[i] = v.Raw
 	}
 
 	return result
@@ -273,3 +584,49 @@ func (due *dependencyUnsatisfiedError) Error() string {
 func (due *dependencyUnsatisfiedError) Unwrap() error {
 	return due.err
 }
+
+// IsDependencyUnsatisfiedError checks if the given error was caused by unsatisfied component dependencies.
+func IsDependencyUnsatisfiedError(err error) bool {
+	var dependencyErr *dependencyUnsatisfiedError
+	return errors.As(err, &dependencyErr)
+}
+
+type registryError struct {
+	err error
+}
+
+// Error returns the string representation of the wrapped error.
+func (re *registryError) Error() string {
+	return re.err.Error()
+}
+
+// Unwrap returns the root error.
+func (re *registryError) Unwrap() error {
+	return re.err
+}
+
+// IsRegistryError checks if the given error was caused by accessing the helm registry.
+func IsRegistryError(err error) bool {
+	var regErr *registryError
+	return errors.As(err, &regErr)
+}
+
+type valuesError struct {
+	err error
+}
+
+// Error returns the string representation of the wrapped error.
+func (ve *valuesError) Error() string {
+	return ve.err.Error()
+}
+
+// Unwrap returns the root error.
+func (ve *valuesError) Unwrap() error {
+	return ve.err
+}
+
+// IsValuesError checks if the given error was caused by values of a component which could not be read or parsed.
+func IsValuesError(err error) bool {
+	var valErr *valuesError
+	return errors.As(err, &valErr)
+}
@@ -3,12 +3,17 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/repo"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
@@ -19,6 +24,12 @@ type provider struct {
 	*action.Configuration
 	plainHttp   bool
 	insecureTls bool
+	username    string
+	password    string
+	caFile      string
+	certFile    string
+	keyFile     string
+	getters     getter.Providers
 }
 
 const rollbackReleaseTimeoutMinsEnv = "ROLLBACK_RELEASE_TIMEOUT_MINS"
@@ -26,18 +37,27 @@ const defaultRollbackReleaseTimeoutMins = time.Duration(15)
 
 func (p *provider) newInstall() installAction {
 	installAction := action.NewInstall(p.Configuration)
-	installAction.PlainHTTP = p.plainHttp
-	installAction.InsecureSkipTLSverify = p.insecureTls
+	p.configureChartPathOptions(&installAction.ChartPathOptions)
 	return &install{Install: installAction}
 }
 
 func (p *provider) newUpgrade() upgradeAction {
 	upgradeAction := action.NewUpgrade(p.Configuration)
-	upgradeAction.PlainHTTP = p.plainHttp
-	upgradeAction.InsecureSkipTLSverify = p.insecureTls
+	p.configureChartPathOptions(&upgradeAction.ChartPathOptions)
 	return &upgrade{Upgrade: upgradeAction}
 }
 
+// configureChartPathOptions sets the options to access the registry when charts are located.
+func (p *provider) configureChartPathOptions(options *action.ChartPathOptions) {
+	options.PlainHTTP = p.plainHttp
+	options.InsecureSkipTLSverify = p.insecureTls
+	options.Username = p.username
+	options.Password = p.password
+	options.CaFile = p.caFile
+	options.CertFile = p.certFile
+	options.KeyFile = p.keyFile
+}
+
 func (p *provider) newUninstall() uninstallAction {
 	uninstallAction := action.NewUninstall(p.Configuration)
 	return &uninstall{Uninstall: uninstallAction}
@@ -45,9 +65,8 @@ func (p *provider) newUninstall() uninstallAction {
 
 func (p *provider) newLocateChart() locateChartAction {
 	dummyAction := action.NewInstall(p.Configuration)
-	dummyAction.PlainHTTP = p.plainHttp
-	dummyAction.InsecureSkipTLSverify = p.insecureTls
-	return &locateChart{dummyAction: dummyAction}
+	p.configureChartPathOptions(&dummyAction.ChartPathOptions)
+	return &locateChart{dummyAction: dummyAction, getters: p.getters}
 }
 
 func (p *provider) newListReleases() listReleasesAction {
@@ -71,6 +90,11 @@ func (p *provider) newRollbackRelease() rollbackReleaseAction {
 	return &rollbackRelease{Rollback: rollbackAction}
 }
 
+func (p *provider) newReleaseHistory() releaseHistoryAction {
+	historyAction := action.NewHistory(p.Configuration)
+	return &releaseHistory{History: historyAction}
+}
+
 // markReleaseFailed marks the release as failed.
 // This is used to set releases that have the status “pending-install“ to “failed“ after the operator crashed to
 // prevent the release from becoming unrecoverable.
@@ -125,11 +149,80 @@ func (u *uninstall) raw() *action.Uninstall {
 
 type locateChart struct {
 	dummyAction *action.Install
+	getters     getter.Providers
 }
 
 func (l *locateChart) locateChart(name, version string, settings *cli.EnvSettings) (chartPath string, err error) {
 	l.dummyAction.Version = version
-	return l.dummyAction.ChartPathOptions.LocateChart(name, settings)
+	if !isRepositoryURL(name) {
+		return l.dummyAction.ChartPathOptions.LocateChart(name, settings)
+	}
+
+	// charts of classic repositories are looked up by their name in the index.yaml of the repository
+	l.dummyAction.RepoURL, name = splitRepositoryURL(name)
+	return l.downloadFromRepository(name, settings)
+}
+
+// downloadFromRepository downloads the chart like action.ChartPathOptions.LocateChart but with the getters of the
+// client, so that the chart is downloaded with the proxy and TLS config of the registry.
+func (l *locateChart) downloadFromRepository(name string, settings *cli.EnvSettings) (string, error) {
+	options := l.dummyAction.ChartPathOptions
+	chartURL, err := repo.FindChartInAuthAndTLSAndPassRepoURL(options.RepoURL, options.Username, options.Password, name, options.Version,
+		options.CertFile, options.KeyFile, options.CaFile, options.InsecureSkipTLSverify, options.PassCredentialsAll, l.getters)
+	if err != nil {
+		return "", err
+	}
+
+	// like helm, credentials are only passed on if the chart is served by the host of the repository
+	username, password := options.Username, options.Password
+	if !options.PassCredentialsAll && !isSameHost(options.RepoURL, chartURL) {
+		username, password = "", ""
+	}
+
+	chartDownloader := downloader.ChartDownloader{
+		Out:     os.Stdout,
+		Keyring: options.Keyring,
+		Getters: l.getters,
+		Options: []getter.Option{
+			getter.WithPassCredentialsAll(options.PassCredentialsAll),
+			getter.WithTLSClientConfig(options.CertFile, options.KeyFile, options.CaFile),
+			getter.WithInsecureSkipVerifyTLS(options.InsecureSkipTLSverify),
+			getter.WithPlainHTTP(options.PlainHTTP),
+			getter.WithBasicAuth(username, password),
+		},
+		RepositoryConfig: settings.RepositoryConfig,
+		RepositoryCache:  settings.RepositoryCache,
+	}
+
+	if options.Verify {
+		chartDownloader.Verify = downloader.VerifyAlways
+	}
+
+	err = os.MkdirAll(settings.RepositoryCache, 0755)
+	if err != nil {
+		return "", fmt.Errorf("failed to create repository cache %s: %w", settings.RepositoryCache, err)
+	}
+
+	chartPath, _, err := chartDownloader.DownloadTo(chartURL, options.Version, settings.RepositoryCache)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Abs(chartPath)
+}
+
+// isSameHost checks whether both URLs have the same scheme and host including the port.
+func isSameHost(firstURL, secondURL string) bool {
+	first, err := url.Parse(firstURL)
+	if err != nil {
+		return false
+	}
+	second, err := url.Parse(secondURL)
+	if err != nil {
+		return false
+	}
+
+	return first.Scheme == second.Scheme && first.Host == second.Host
 }
 
 type listReleases struct {
@@ -180,6 +273,18 @@ func (r *rollbackRelease) raw() *action.Rollback {
 	return r.Rollback
 }
 
+type releaseHistory struct {
+	*action.History
+}
+
+func (h *releaseHistory) releaseHistory(releaseName string) ([]*helmRelease.Release, error) {
+	return h.Run(releaseName)
+}
+
+func (h *releaseHistory) raw() *action.History {
+	return h.History
+}
+
 func readRollbackReleaseTimeoutMinsEnv() time.Duration {
 	rollbackReleaseTimeoutMinsString, found := os.LookupEnv(rollbackReleaseTimeoutMinsEnv)
 	if !found {
@@ -22,6 +22,11 @@ func Test_provider_newInstall(t *testing.T) {
 		Configuration: &action.Configuration{},
 		plainHttp:     true,
 		insecureTls:   true,
+		username:      "user",
+		password:      "secret",
+		caFile:        "ca.crt",
+		certFile:      "tls.crt",
+		keyFile:       "tls.key",
 	}
 
 	// when
@@ -31,6 +36,11 @@ func Test_provider_newInstall(t *testing.T) {
 	assert.NotEmpty(t, result.raw())
 	assert.True(t, result.raw().PlainHTTP)
 	assert.True(t, result.raw().InsecureSkipTLSverify)
+	assert.Equal(t, "user", result.raw().Username)
+	assert.Equal(t, "secret", result.raw().Password)
+	assert.Equal(t, "ca.crt", result.raw().CaFile)
+	assert.Equal(t, "tls.crt", result.raw().CertFile)
+	assert.Equal(t, "tls.key", result.raw().KeyFile)
 }
 
 func Test_provider_newInstall_providerOptionsNotSet(t *testing.T) {
@@ -52,6 +62,11 @@ func Test_provider_newUpgrade(t *testing.T) {
 		Configuration: &action.Configuration{},
 		plainHttp:     true,
 		insecureTls:   true,
+		username:      "user",
+		password:      "secret",
+		caFile:        "ca.crt",
+		certFile:      "tls.crt",
+		keyFile:       "tls.key",
 	}
 
 	// when
@@ -61,6 +76,11 @@ func Test_provider_newUpgrade(t *testing.T) {
 	assert.NotEmpty(t, result.raw())
 	assert.True(t, result.raw().PlainHTTP)
 	assert.True(t, result.raw().InsecureSkipTLSverify)
+	assert.Equal(t, "user", result.raw().Username)
+	assert.Equal(t, "secret", result.raw().Password)
+	assert.Equal(t, "ca.crt", result.raw().CaFile)
+	assert.Equal(t, "tls.crt", result.raw().CertFile)
+	assert.Equal(t, "tls.key", result.raw().KeyFile)
 }
 func Test_provider_newUpgrade_providerOptionsNotSet(t *testing.T) {
 	// given
@@ -141,6 +161,17 @@ func Test_provider_newRollbackRelease(t *testing.T) {
 	assert.NotEmpty(t, result.raw())
 }
 
+func Test_provider_newReleaseHistory(t *testing.T) {
+	// given
+	sut := &provider{Configuration: &action.Configuration{}}
+
+	// when
+	result := sut.newReleaseHistory()
+
+	// then
+	assert.NotEmpty(t, result.raw())
+}
+
 func Test_provider_markReleaseFailed_success(t *testing.T) {
 	// given
 	memDriver := driver.NewMemory()
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	fmtlog "log"
 	"net"
@@ -30,6 +31,10 @@ const (
 
 const anyVersionConstraint = ">0.0.0-0"
 
+// dryRunOptionServer lets helm render dry-runs against the cluster so that lookups and the validation of resources
+// work like in a real installation.
+const dryRunOptionServer = "server"
+
 var defaultDebugLog = func(format string, v ...interface{}) {
 	fmtlog.Printf(format, v...)
 }
@@ -71,89 +76,159 @@ func newClient(options *Options, clientGetter genericclioptions.RESTClientGetter
 		return nil, err
 	}
 
-	registryClient, err := createRegistryClient(options, settings)
+	transport, err := newTransport(options)
+	if err != nil {
+		return nil, err
+	}
+
+	registryClient, err := createRegistryClient(options, settings, transport)
 	if err != nil {
 		return nil, err
 	}
 	actionConfig.RegistryClient = registryClient
+	getters := newGetters(settings, transport)
 
 	actionProvider := &provider{
 		Configuration: actionConfig,
 		plainHttp:     options.PlainHttp,
 		insecureTls:   options.InsecureTls,
+		username:      options.Username,
+		password:      options.Password,
+		caFile:        options.CAFile,
+		certFile:      options.CertFile,
+		keyFile:       options.KeyFile,
+		getters:       getters,
 	}
 
 	return &HelmClient{
-		TagResolver: registryClient,
-		Settings:    settings,
-		actions:     actionProvider,
-		DebugLog:    debugLog,
-		output:      options.Output,
+		TagResolver: &repositoryTagResolver{
+			registry:    registryClient,
+			getters:     getters,
+			insecureTls: options.InsecureTls,
+			username:    options.Username,
+			password:    options.Password,
+			caFile:      options.CAFile,
+			certFile:    options.CertFile,
+			keyFile:     options.KeyFile,
+		},
+		Settings: settings,
+		actions:  actionProvider,
+		DebugLog: debugLog,
+		output:   options.Output,
 	}, nil
 }
 
-func createRegistryClient(options *Options, settings *cli.EnvSettings) (*registry.Client, error) {
+func createRegistryClient(options *Options, settings *cli.EnvSettings, transport *http.Transport) (*registry.Client, error) {
 	clientOpts := []registry.ClientOption{
 		registry.ClientOptDebug(settings.Debug),
 		registry.ClientOptCredentialsFile(settings.RegistryConfig),
 	}
 
-	var err error
-	clientOpts, err = configureHttpRegistryClientOptions(options, clientOpts)
-	if err != nil {
-		return nil, err
+	if options.Username != "" {
+		clientOpts = append(clientOpts, registry.ClientOptBasicAuth(options.Username, options.Password))
 	}
 
+	clientOpts = configureHttpRegistryClientOptions(options, transport, clientOpts)
+
 	return registry.NewClient(clientOpts...)
 }
 
-func configureHttpRegistryClientOptions(options *Options, clientOpts []registry.ClientOption) ([]registry.ClientOption, error) {
+func configureHttpRegistryClientOptions(options *Options, transport *http.Transport, clientOpts []registry.ClientOption) []registry.ClientOption {
 	if options.PlainHttp {
 		clientOpts = append(clientOpts, registry.ClientOptPlainHTTP())
 	}
 
-	var httpTransport *http.Transport
-	var err error
-	httpTransport, err = getProxyTransportIfConfigured()
-	if err != nil {
-		return nil, err
+	if transport != nil {
+		clientOpts = append(clientOpts, registry.ClientOptHTTPClient(&http.Client{Timeout: time.Second * 10, Transport: transport}))
 	}
 
-	httpTransport = configureTls(options, httpTransport)
+	return clientOpts
+}
+
+// newTransport creates the transport with the proxy and the TLS config of the options which is shared by all requests
+// to the registry. It returns nil if the default transport suffices.
+func newTransport(options *Options) (*http.Transport, error) {
+	return configureTls(options, newProxyTransport(options.Proxy))
+}
 
-	if httpTransport != nil {
-		clientOpts = append(clientOpts, registry.ClientOptHTTPClient(&http.Client{Timeout: time.Second * 10, Transport: httpTransport}))
+// newGetters returns the getters to download indexes and charts. Charts of classic repositories are downloaded with the
+// given transport if it is not nil, as helm would otherwise use its own transport without the proxy and TLS config.
+func newGetters(settings *cli.EnvSettings, transport *http.Transport) getter.Providers {
+	getters := getter.All(settings)
+	if transport == nil {
+		return getters
 	}
 
-	return clientOpts, nil
+	httpProvider := getter.Provider{
+		Schemes: []string{"http", "https"},
+		New: func(options ...getter.Option) (getter.Getter, error) {
+			return getter.NewHTTPGetter(append(options, getter.WithTransport(transport))...)
+		},
+	}
+
+	// getters are selected by the first provider supporting the scheme
+	return append(getter.Providers{httpProvider}, getters...)
 }
 
-func configureTls(options *Options, transport *http.Transport) *http.Transport {
-	if !options.PlainHttp && options.InsecureTls {
-		tlsConfig := &tls.Config{InsecureSkipVerify: true}
-		if transport != nil {
-			transport.TLSClientConfig = tlsConfig
-		} else {
-			transport = &http.Transport{TLSClientConfig: tlsConfig}
-		}
+func configureTls(options *Options, transport *http.Transport) (*http.Transport, error) {
+	if options.PlainHttp {
+		return transport, nil
+	}
+
+	tlsConfig, err := newTlsConfig(options)
+	if err != nil || tlsConfig == nil {
+		return transport, err
 	}
 
-	return transport
+	if transport != nil {
+		transport.TLSClientConfig = tlsConfig
+	} else {
+		transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+
+	return transport, nil
 }
 
-func getProxyTransportIfConfigured() (*http.Transport, error) {
-	proxyURL, found := os.LookupEnv("PROXY_URL")
-	if !found || len(proxyURL) < 1 {
+// newTlsConfig creates the TLS config with the CA bundle and the client certificate of the options. It returns nil if
+// the default TLS config suffices.
+func newTlsConfig(options *Options) (*tls.Config, error) {
+	if !options.InsecureTls && options.CAFile == "" && options.CertFile == "" {
 		return nil, nil
 	}
 
-	parsedProxy, err := url.Parse(proxyURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse proxy: %w", err)
+	tlsConfig := &tls.Config{InsecureSkipVerify: options.InsecureTls}
+
+	if options.CAFile != "" {
+		caBundle, err := os.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle %s: %w", options.CAFile, err)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM encoded certificates", options.CAFile)
+		}
+		tlsConfig.RootCAs = rootCAs
 	}
 
-	proxyFn := func(request *http.Request) (*url.URL, error) {
-		return parsedProxy, nil
+	if options.CertFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate %s: %w", options.CertFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newProxyTransport creates a transport sending requests through the given proxy. It returns nil without proxy.
+func newProxyTransport(proxy func(*http.Request) (*url.URL, error)) *http.Transport {
+	if proxy == nil {
+		return nil
 	}
 
 	return &http.Transport{
@@ -168,8 +243,8 @@ func getProxyTransportIfConfigured() (*http.Transport, error) {
 		IdleConnTimeout:       90 * time.Second,
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
-		Proxy:                 proxyFn,
-	}, nil
+		Proxy:                 proxy,
+	}
 }
 
 // setEnvSettings sets the client's environment settings based on the provided client configuration.
@@ -271,9 +346,19 @@ func (c *HelmClient) GetRelease(name string) (*release.Release, error) {
 	return c.getRelease(name)
 }
 
+// GetReleaseHistory returns all stored revisions of the release specified by name.
+func (c *HelmClient) GetReleaseHistory(name string) ([]*release.Release, error) {
+	return c.getReleaseHistory(name)
+}
+
 // RollbackRelease implicitly rolls back a release to the last revision.
 func (c *HelmClient) RollbackRelease(spec *ChartSpec) error {
-	return c.rollbackRelease(spec)
+	return c.rollbackRelease(spec, 0)
+}
+
+// RollbackReleaseToRevision rolls back a release to the given revision. A revision of 0 rolls back to the last revision.
+func (c *HelmClient) RollbackReleaseToRevision(spec *ChartSpec, revision int) error {
+	return c.rollbackRelease(spec, revision)
 }
 
 // UninstallRelease uninstalls the provided release
@@ -481,10 +566,23 @@ func (c *HelmClient) getRelease(name string) (*release.Release, error) {
 	return rel, nil
 }
 
-// rollbackRelease implicitly rolls back a release to the last revision.
-func (c *HelmClient) rollbackRelease(spec *ChartSpec) error {
+// getReleaseHistory returns all revisions of the release matching the provided 'name'.
+func (c *HelmClient) getReleaseHistory(name string) ([]*release.Release, error) {
+	historyAction := c.actions.newReleaseHistory()
+
+	releases, err := historyAction.releaseHistory(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of release %q: %w", name, err)
+	}
+
+	return releases, nil
+}
+
+// rollbackRelease rolls back a release to the given revision. A revision of 0 rolls back to the last revision.
+func (c *HelmClient) rollbackRelease(spec *ChartSpec, revision int) error {
 	rollbackAction := c.actions.newRollbackRelease()
 	mergeRollbackOptions(spec, rollbackAction.raw())
+	rollbackAction.raw().Version = revision
 
 	err := rollbackAction.rollbackRelease(spec.ReleaseName)
 	if err != nil {
@@ -509,6 +607,9 @@ func mergeInstallOptions(chartSpec *ChartSpec, installOptions *action.Install) {
 	installOptions.Version = chartSpec.Version
 	installOptions.Atomic = chartSpec.Atomic
 	installOptions.PostRenderer = chartSpec.PostRenderer
+	if chartSpec.DryRun {
+		installOptions.DryRunOption = dryRunOptionServer
+	}
 }
 
 // mergeUpgradeOptions merges values of the provided chart to helm upgrade options used by the client.
@@ -521,6 +622,9 @@ func mergeUpgradeOptions(chartSpec *ChartSpec, upgradeOptions *action.Upgrade) {
 	upgradeOptions.Atomic = chartSpec.Atomic
 	upgradeOptions.CleanupOnFail = chartSpec.CleanupOnFail
 	upgradeOptions.PostRenderer = chartSpec.PostRenderer
+	if chartSpec.DryRun {
+		upgradeOptions.DryRunOption = dryRunOptionServer
+	}
 }
 
 // mergeUninstallReleaseOptions merges values of the provided chart to helm uninstall options used by the client.
@@ -19,6 +19,8 @@ type Client interface {
 	ListDeployedReleases() ([]*release.Release, error)
 	ListReleasesByStateMask(action.ListStates) ([]*release.Release, error)
 	GetRelease(name string) (*release.Release, error)
+	// GetReleaseHistory returns all stored revisions of a release.
+	GetReleaseHistory(name string) ([]*release.Release, error)
 	// RollBack is an interface to abstract a rollback action.
 	RollBack
 	GetReleaseValues(name string, allValues bool) (map[string]interface{}, error)
@@ -36,6 +38,7 @@ type TagResolver interface {
 
 type RollBack interface {
 	RollbackRelease(spec *ChartSpec) error
+	RollbackReleaseToRevision(spec *ChartSpec, revision int) error
 }
 
 type actionProvider interface {
@@ -47,6 +50,7 @@ type actionProvider interface {
 	newGetReleaseValues() getReleaseValuesAction
 	newGetRelease() getReleaseAction
 	newRollbackRelease() rollbackReleaseAction
+	newReleaseHistory() releaseHistoryAction
 	markReleaseFailed(releaseName, reason string) error
 }
 
@@ -89,6 +93,11 @@ type rollbackReleaseAction interface {
 	raw() *action.Rollback
 }
 
+type releaseHistoryAction interface {
+	releaseHistory(releaseName string) ([]*release.Release, error)
+	raw() *action.History
+}
+
 type valuesOptions interface {
 	MergeValues(p getter.Providers) (map[string]interface{}, error)
 }
@@ -207,6 +207,64 @@ func (_c *MockClient_GetRelease_Call) RunAndReturn(run func(string) (*release.Re
 	return _c
 }
 
+// GetReleaseHistory provides a mock function with given fields: name
+func (_m *MockClient) GetReleaseHistory(name string) ([]*release.Release, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReleaseHistory")
+	}
+
+	var r0 []*release.Release
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*release.Release, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*release.Release); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*release.Release)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetReleaseHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReleaseHistory'
+type MockClient_GetReleaseHistory_Call struct {
+	*mock.Call
+}
+
+// GetReleaseHistory is a helper method to define mock.On call
+//   - name string
+func (_e *MockClient_Expecter) GetReleaseHistory(name interface{}) *MockClient_GetReleaseHistory_Call {
+	return &MockClient_GetReleaseHistory_Call{Call: _e.mock.On("GetReleaseHistory", name)}
+}
+
+func (_c *MockClient_GetReleaseHistory_Call) Run(run func(name string)) *MockClient_GetReleaseHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetReleaseHistory_Call) Return(_a0 []*release.Release, _a1 error) *MockClient_GetReleaseHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_GetReleaseHistory_Call) RunAndReturn(run func(string) ([]*release.Release, error)) *MockClient_GetReleaseHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReleaseValues provides a mock function with given fields: name, allValues
 func (_m *MockClient) GetReleaseValues(name string, allValues bool) (map[string]interface{}, error) {
 	ret := _m.Called(name, allValues)
@@ -592,6 +650,53 @@ func (_c *MockClient_RollbackRelease_Call) RunAndReturn(run func(*ChartSpec) err
 	return _c
 }
 
+// RollbackReleaseToRevision provides a mock function with given fields: spec, revision
+func (_m *MockClient) RollbackReleaseToRevision(spec *ChartSpec, revision int) error {
+	ret := _m.Called(spec, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackReleaseToRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ChartSpec, int) error); ok {
+		r0 = rf(spec, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_RollbackReleaseToRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackReleaseToRevision'
+type MockClient_RollbackReleaseToRevision_Call struct {
+	*mock.Call
+}
+
+// RollbackReleaseToRevision is a helper method to define mock.On call
+//   - spec *ChartSpec
+//   - revision int
+func (_e *MockClient_Expecter) RollbackReleaseToRevision(spec interface{}, revision interface{}) *MockClient_RollbackReleaseToRevision_Call {
+	return &MockClient_RollbackReleaseToRevision_Call{Call: _e.mock.On("RollbackReleaseToRevision", spec, revision)}
+}
+
+func (_c *MockClient_RollbackReleaseToRevision_Call) Run(run func(spec *ChartSpec, revision int)) *MockClient_RollbackReleaseToRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*ChartSpec), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_RollbackReleaseToRevision_Call) Return(_a0 error) *MockClient_RollbackReleaseToRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_RollbackReleaseToRevision_Call) RunAndReturn(run func(*ChartSpec, int) error) *MockClient_RollbackReleaseToRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Tags provides a mock function with given fields: ref
 func (_m *MockClient) Tags(ref string) ([]string, error) {
 	ret := _m.Called(ref)
@@ -63,6 +63,53 @@ func (_c *MockRollBack_RollbackRelease_Call) RunAndReturn(run func(*ChartSpec) e
 	return _c
 }
 
+// RollbackReleaseToRevision provides a mock function with given fields: spec, revision
+func (_m *MockRollBack) RollbackReleaseToRevision(spec *ChartSpec, revision int) error {
+	ret := _m.Called(spec, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackReleaseToRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ChartSpec, int) error); ok {
+		r0 = rf(spec, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRollBack_RollbackReleaseToRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackReleaseToRevision'
+type MockRollBack_RollbackReleaseToRevision_Call struct {
+	*mock.Call
+}
+
+// RollbackReleaseToRevision is a helper method to define mock.On call
+//   - spec *ChartSpec
+//   - revision int
+func (_e *MockRollBack_Expecter) RollbackReleaseToRevision(spec interface{}, revision interface{}) *MockRollBack_RollbackReleaseToRevision_Call {
+	return &MockRollBack_RollbackReleaseToRevision_Call{Call: _e.mock.On("RollbackReleaseToRevision", spec, revision)}
+}
+
+func (_c *MockRollBack_RollbackReleaseToRevision_Call) Run(run func(spec *ChartSpec, revision int)) *MockRollBack_RollbackReleaseToRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*ChartSpec), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRollBack_RollbackReleaseToRevision_Call) Return(_a0 error) *MockRollBack_RollbackReleaseToRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRollBack_RollbackReleaseToRevision_Call) RunAndReturn(run func(*ChartSpec, int) error) *MockRollBack_RollbackReleaseToRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRollBack creates a new instance of MockRollBack. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRollBack(t interface {
@@ -299,6 +299,53 @@ func (_c *mockActionProvider_newLocateChart_Call) RunAndReturn(run func() locate
 	return _c
 }
 
+// newReleaseHistory provides a mock function with no fields
+func (_m *mockActionProvider) newReleaseHistory() releaseHistoryAction {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for newReleaseHistory")
+	}
+
+	var r0 releaseHistoryAction
+	if rf, ok := ret.Get(0).(func() releaseHistoryAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(releaseHistoryAction)
+		}
+	}
+
+	return r0
+}
+
+// mockActionProvider_newReleaseHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'newReleaseHistory'
+type mockActionProvider_newReleaseHistory_Call struct {
+	*mock.Call
+}
+
+// newReleaseHistory is a helper method to define mock.On call
+func (_e *mockActionProvider_Expecter) newReleaseHistory() *mockActionProvider_newReleaseHistory_Call {
+	return &mockActionProvider_newReleaseHistory_Call{Call: _e.mock.On("newReleaseHistory")}
+}
+
+func (_c *mockActionProvider_newReleaseHistory_Call) Run(run func()) *mockActionProvider_newReleaseHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockActionProvider_newReleaseHistory_Call) Return(_a0 releaseHistoryAction) *mockActionProvider_newReleaseHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockActionProvider_newReleaseHistory_Call) RunAndReturn(run func() releaseHistoryAction) *mockActionProvider_newReleaseHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newRollbackRelease provides a mock function with no fields
 func (_m *mockActionProvider) newRollbackRelease() rollbackReleaseAction {
 	ret := _m.Called()
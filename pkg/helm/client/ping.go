@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+)
+
+const pingTimeout = 10 * time.Second
+
+// PingRegistry checks that the registry with the given URL is reachable and accepts the credentials of the options.
+// OCI registries like "oci://registry.example.com/k8s" are pinged at their API, which requires a login. Classic chart
+// repositories like "https://charts.example.com/k8s" are requested directly and only fail if they are not reachable or
+// reject the credentials.
+func PingRegistry(ctx context.Context, options *Options, registryURL string) error {
+	transport, err := newTransport(options)
+	if err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: pingTimeout}
+	if transport != nil {
+		httpClient.Transport = transport
+	}
+
+	if isRepositoryURL(registryURL) {
+		return pingRepository(ctx, httpClient, options, registryURL)
+	}
+
+	return pingOCIRegistry(ctx, httpClient, options, registryURL)
+}
+
+func pingOCIRegistry(ctx context.Context, httpClient *http.Client, options *Options, registryURL string) error {
+	host, _, _ := strings.Cut(strings.TrimPrefix(registryURL, "oci://"), "/")
+	registry, err := remote.NewRegistry(host)
+	if err != nil {
+		return fmt.Errorf("invalid registry %s: %w", registryURL, err)
+	}
+
+	credential, err := registryCredential(options, host)
+	if err != nil {
+		return err
+	}
+
+	registry.PlainHTTP = options.PlainHttp
+	registry.Client = &auth.Client{Client: httpClient, Cache: auth.NewCache(), Credential: credential}
+
+	err = registry.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to log in to registry %s: %w", host, err)
+	}
+
+	return nil
+}
+
+// registryCredential returns the username and password of the options or the credentials of the registry config.
+func registryCredential(options *Options, host string) (auth.CredentialFunc, error) {
+	if options.Username != "" {
+		return auth.StaticCredential(host, auth.Credential{Username: options.Username, Password: options.Password}), nil
+	}
+
+	if options.RegistryConfig == "" {
+		return nil, nil
+	}
+
+	store, err := credentials.NewStore(options.RegistryConfig, credentials.StoreOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry config %s: %w", options.RegistryConfig, err)
+	}
+
+	return credentials.Credential(store), nil
+}
+
+func pingRepository(ctx context.Context, httpClient *http.Client, options *Options, registryURL string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, registryURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid chart repository %s: %w", registryURL, err)
+	}
+	if options.Username != "" {
+		request.SetBasicAuth(options.Username, options.Password)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to request chart repository %s: %w", registryURL, err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("chart repository %s rejected the credentials: %s", registryURL, response.Status)
+	}
+
+	return nil
+}
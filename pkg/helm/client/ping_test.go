@@ -0,0 +1,124 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBasicAuthServer returns a server which only answers requests with the given credentials.
+func newBasicAuthServer(t *testing.T, username string, password string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualUsername, actualPassword, ok := r.BasicAuth()
+		if !ok || actualUsername != username || actualPassword != password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestPingRegistry(t *testing.T) {
+	t.Run("should log in to oci registry", func(t *testing.T) {
+		// given
+		server := newBasicAuthServer(t, "user", "secret")
+		host := strings.TrimPrefix(server.URL, "http://")
+
+		// when
+		err := PingRegistry(testCtx, &Options{PlainHttp: true, Username: "user", Password: "secret"}, "oci://"+host+"/k8s")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should log in to oci registry with credentials of registry config", func(t *testing.T) {
+		// given
+		server := newBasicAuthServer(t, "user", "secret")
+		host := strings.TrimPrefix(server.URL, "http://")
+		registryConfig := filepath.Join(t.TempDir(), "config.json")
+		// base64 of "user:secret"
+		err := os.WriteFile(registryConfig, []byte(`{"auths":{"`+host+`":{"auth":"dXNlcjpzZWNyZXQ="}}}`), 0600)
+		require.NoError(t, err)
+
+		// when
+		err = PingRegistry(testCtx, &Options{PlainHttp: true, RegistryConfig: registryConfig}, "oci://"+host)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail to log in to oci registry with invalid credentials", func(t *testing.T) {
+		// given
+		server := newBasicAuthServer(t, "user", "secret")
+		host := strings.TrimPrefix(server.URL, "http://")
+
+		// when
+		err := PingRegistry(testCtx, &Options{PlainHttp: true, Username: "user", Password: "invalid"}, "oci://"+host+"/k8s")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to log in to registry "+host)
+		assert.ErrorContains(t, err, "401")
+	})
+
+	t.Run("should request chart repository with credentials", func(t *testing.T) {
+		// given
+		server := newBasicAuthServer(t, "user", "secret")
+
+		// when
+		err := PingRegistry(testCtx, &Options{Username: "user", Password: "secret"}, server.URL+"/k8s")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should accept other errors of chart repository", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(server.Close)
+
+		// when
+		err := PingRegistry(testCtx, &Options{}, server.URL+"/k8s")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if chart repository rejects credentials", func(t *testing.T) {
+		// given
+		server := newBasicAuthServer(t, "user", "secret")
+
+		// when
+		err := PingRegistry(testCtx, &Options{Username: "user", Password: "invalid"}, server.URL+"/k8s")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "chart repository "+server.URL+"/k8s rejected the credentials: 401 Unauthorized")
+	})
+
+	t.Run("should fail for unreachable chart repository", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		// when
+		err := PingRegistry(testCtx, &Options{}, server.URL)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to request chart repository "+server.URL)
+	})
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// indexName is the name under which the index.yaml of a chart repository is cached while resolving tags.
+const indexName = "component-operator"
+
+// isRepositoryURL checks whether the chart reference points to a classic chart repository which serves an index.yaml
+// instead of an OCI registry.
+func isRepositoryURL(ref string) bool {
+	return strings.HasPrefix(ref, "https://") || strings.HasPrefix(ref, "http://")
+}
+
+// splitRepositoryURL splits a chart reference like "https://charts.example.com/k8s/dogu-op" into the URL of the chart
+// repository "https://charts.example.com/k8s" and the name of the chart "dogu-op".
+func splitRepositoryURL(ref string) (repoURL string, chartName string) {
+	separator := strings.LastIndex(ref, "/")
+	return ref[:separator], ref[separator+1:]
+}
+
+// repositoryTagResolver resolves the tags of charts in OCI registries with the registry client and the versions of
+// charts in classic chart repositories from the index.yaml of the repository.
+type repositoryTagResolver struct {
+	registry    TagResolver
+	getters     getter.Providers
+	insecureTls bool
+	username    string
+	password    string
+	caFile      string
+	certFile    string
+	keyFile     string
+}
+
+// Tags returns all tags or versions of the referenced chart.
+func (r *repositoryTagResolver) Tags(ref string) ([]string, error) {
+	if !isRepositoryURL(ref) {
+		return r.registry.Tags(ref)
+	}
+
+	return r.indexTags(ref)
+}
+
+func (r *repositoryTagResolver) indexTags(ref string) ([]string, error) {
+	repoURL, chartName := splitRepositoryURL(ref)
+
+	index, err := r.downloadIndex(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	chartVersions, found := index.Entries[chartName]
+	if !found {
+		return nil, fmt.Errorf("chart %q not found in repository %s", chartName, repoURL)
+	}
+
+	tags := make([]string, 0, len(chartVersions))
+	for _, chartVersion := range chartVersions {
+		tags = append(tags, chartVersion.Version)
+	}
+
+	return tags, nil
+}
+
+func (r *repositoryTagResolver) downloadIndex(repoURL string) (*repo.IndexFile, error) {
+	chartRepo, err := repo.NewChartRepository(&repo.Entry{
+		Name:                  indexName,
+		URL:                   repoURL,
+		Username:              r.username,
+		Password:              r.password,
+		CAFile:                r.caFile,
+		CertFile:              r.certFile,
+		KeyFile:               r.keyFile,
+		InsecureSkipTLSverify: r.insecureTls,
+	}, r.getters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chart repository %s: %w", repoURL, err)
+	}
+
+	// every download uses its own cache directory as components are reconciled concurrently
+	chartRepo.CachePath, err = os.MkdirTemp("", "helm-index-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cache directory for index of repository %s: %w", repoURL, err)
+	}
+	defer func() { _ = os.RemoveAll(chartRepo.CachePath) }()
+
+	indexPath, err := chartRepo.DownloadIndexFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to download index of repository %s: %w", repoURL, err)
+	}
+
+	index, err := repo.LoadIndexFile(indexPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load index of repository %s: %w", repoURL, err)
+	}
+
+	return index, nil
+}
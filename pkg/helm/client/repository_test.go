@@ -0,0 +1,284 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/getter"
+)
+
+const testIndexYaml = `apiVersion: v1
+entries:
+  test-chart:
+  - apiVersion: v2
+    name: test-chart
+    version: 1.0.0
+    urls:
+    - charts/test-chart-1.0.0.tgz
+  - apiVersion: v2
+    name: test-chart
+    version: 0.9.0
+    urls:
+    - charts/test-chart-0.9.0.tgz
+`
+
+// newTestRepository starts a chart repository below the path /k8s serving an index.yaml and the packaged test chart.
+func newTestRepository(t *testing.T) *httptest.Server {
+	helmChart, err := loader.Load("testdata/test-chart")
+	require.NoError(t, err)
+	chartArchive, err := chartutil.Save(helmChart, t.TempDir())
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/k8s/index.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testIndexYaml))
+	})
+	mux.HandleFunc("/k8s/charts/test-chart-1.0.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, chartArchive)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newMutualTlsServer starts a chart repository serving an index.yaml by TLS which requires a client certificate signed
+// by the test CA.
+func newMutualTlsServer(t *testing.T) *httptest.Server {
+	serverCert, err := tls.LoadX509KeyPair("testdata/tls/server.crt", "testdata/tls/server.key")
+	require.NoError(t, err)
+	caBundle, err := os.ReadFile("testdata/tls/ca.crt")
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(caBundle))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testIndexYaml))
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func Test_isRepositoryURL(t *testing.T) {
+	assert.True(t, isRepositoryURL("https://charts.example.com/k8s/dogu-op"))
+	assert.True(t, isRepositoryURL("http://charts.example.com/k8s/dogu-op"))
+	assert.False(t, isRepositoryURL("oci://registry.example.com/k8s/dogu-op"))
+	assert.False(t, isRepositoryURL("registry.example.com/k8s/dogu-op"))
+}
+
+func Test_splitRepositoryURL(t *testing.T) {
+	// when
+	repoURL, chartName := splitRepositoryURL("https://charts.example.com/k8s/dogu-op")
+
+	// then
+	assert.Equal(t, "https://charts.example.com/k8s", repoURL)
+	assert.Equal(t, "dogu-op", chartName)
+}
+
+func Test_repositoryTagResolver_Tags(t *testing.T) {
+	t.Run("should resolve tags of oci chart with registry", func(t *testing.T) {
+		// given
+		registryMock := NewMockTagResolver(t)
+		registryMock.EXPECT().Tags("registry.example.com/k8s/dogu-op").Return([]string{"1.0.0"}, nil)
+
+		sut := &repositoryTagResolver{registry: registryMock}
+
+		// when
+		tags, err := sut.Tags("registry.example.com/k8s/dogu-op")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.0.0"}, tags)
+	})
+
+	t.Run("should resolve versions from index of chart repository", func(t *testing.T) {
+		// given
+		server := newTestRepository(t)
+		sut := &repositoryTagResolver{getters: getter.All(cli.New())}
+
+		// when
+		tags, err := sut.Tags(server.URL + "/k8s/test-chart")
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"1.0.0", "0.9.0"}, tags)
+	})
+
+	t.Run("should authenticate at chart repository", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "user" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(testIndexYaml))
+		}))
+		t.Cleanup(server.Close)
+		sut := &repositoryTagResolver{getters: getter.All(cli.New()), username: "user", password: "secret"}
+
+		// when
+		tags, err := sut.Tags(server.URL + "/k8s/test-chart")
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"1.0.0", "0.9.0"}, tags)
+	})
+
+	t.Run("should authenticate at chart repository by client certificate", func(t *testing.T) {
+		// given
+		server := newMutualTlsServer(t)
+		sut := &repositoryTagResolver{
+			getters:  getter.All(cli.New()),
+			caFile:   "testdata/tls/ca.crt",
+			certFile: "testdata/tls/tls.crt",
+			keyFile:  "testdata/tls/tls.key",
+		}
+
+		// when
+		tags, err := sut.Tags(server.URL + "/k8s/test-chart")
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"1.0.0", "0.9.0"}, tags)
+	})
+
+	t.Run("should fail without client certificate", func(t *testing.T) {
+		// given
+		server := newMutualTlsServer(t)
+		sut := &repositoryTagResolver{getters: getter.All(cli.New()), caFile: "testdata/tls/ca.crt"}
+
+		// when
+		_, err := sut.Tags(server.URL + "/k8s/test-chart")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to download index of repository "+server.URL+"/k8s")
+	})
+
+	t.Run("should fail for chart missing in index", func(t *testing.T) {
+		// given
+		server := newTestRepository(t)
+		sut := &repositoryTagResolver{getters: getter.All(cli.New())}
+
+		// when
+		_, err := sut.Tags(server.URL + "/k8s/dogu-op")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "chart \"dogu-op\" not found in repository "+server.URL+"/k8s")
+	})
+
+	t.Run("should fail to download index", func(t *testing.T) {
+		// given
+		server := newTestRepository(t)
+		sut := &repositoryTagResolver{getters: getter.All(cli.New())}
+
+		// when
+		_, err := sut.Tags(server.URL + "/other/test-chart")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to download index of repository "+server.URL+"/other")
+	})
+}
+
+func Test_locateChart_locateChart(t *testing.T) {
+	t.Run("should download chart listed in index of chart repository", func(t *testing.T) {
+		// given
+		server := newTestRepository(t)
+		settings := cli.New()
+		settings.RepositoryCache = t.TempDir()
+		settings.RepositoryConfig = filepath.Join(t.TempDir(), "repositories.yaml")
+
+		sut := &locateChart{dummyAction: action.NewInstall(&action.Configuration{}), getters: getter.All(settings)}
+
+		// when
+		chartPath, err := sut.locateChart(server.URL+"/k8s/test-chart", ">0.0.0-0", settings)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, server.URL+"/k8s", sut.dummyAction.RepoURL)
+		_, err = os.Stat(chartPath)
+		require.NoError(t, err)
+		helmChart, err := loader.Load(chartPath)
+		require.NoError(t, err)
+		assert.Equal(t, "1.0.0", helmChart.Metadata.Version)
+	})
+
+	t.Run("should download chart through proxy", func(t *testing.T) {
+		// given
+		repository := newTestRepository(t)
+		var proxiedRequests []string
+		// the proxy forwards all requests to the test repository and records the requested urls
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxiedRequests = append(proxiedRequests, r.URL.String())
+			repository.Config.Handler.ServeHTTP(w, r)
+		}))
+		t.Cleanup(proxy.Close)
+		proxyURL, err := url.Parse(proxy.URL)
+		require.NoError(t, err)
+
+		transport, err := newTransport(&Options{Proxy: http.ProxyURL(proxyURL)})
+		require.NoError(t, err)
+		settings := cli.New()
+		settings.RepositoryCache = t.TempDir()
+		settings.RepositoryConfig = filepath.Join(t.TempDir(), "repositories.yaml")
+
+		sut := &locateChart{dummyAction: action.NewInstall(&action.Configuration{}), getters: newGetters(settings, transport)}
+
+		// when
+		chartPath, err := sut.locateChart("http://charts.example.com/k8s/test-chart", ">0.0.0-0", settings)
+
+		// then
+		require.NoError(t, err)
+		helmChart, err := loader.Load(chartPath)
+		require.NoError(t, err)
+		assert.Equal(t, "1.0.0", helmChart.Metadata.Version)
+		assert.Equal(t, []string{
+			"http://charts.example.com/k8s/index.yaml",
+			"http://charts.example.com/k8s/charts/test-chart-1.0.0.tgz",
+		}, proxiedRequests)
+	})
+
+	t.Run("should fail for chart missing in index", func(t *testing.T) {
+		// given
+		server := newTestRepository(t)
+		settings := cli.New()
+		settings.RepositoryCache = t.TempDir()
+
+		sut := &locateChart{dummyAction: action.NewInstall(&action.Configuration{}), getters: getter.All(settings)}
+
+		// when
+		_, err := sut.locateChart(server.URL+"/k8s/dogu-op", ">0.0.0-0", settings)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "chart \"dogu-op\" version \">0.0.0-0\" not found in "+server.URL+"/k8s repository")
+	})
+}
+
+func Test_isSameHost(t *testing.T) {
+	assert.True(t, isSameHost("https://charts.example.com/k8s", "https://charts.example.com/k8s/charts/dogu-op-1.0.0.tgz"))
+	assert.False(t, isSameHost("https://charts.example.com/k8s", "https://cdn.example.com/dogu-op-1.0.0.tgz"))
+	assert.False(t, isSameHost("https://charts.example.com/k8s", "http://charts.example.com/k8s/charts/dogu-op-1.0.0.tgz"))
+	assert.False(t, isSameHost("https://charts.example.com:8443/k8s", "https://charts.example.com/k8s/charts/dogu-op-1.0.0.tgz"))
+}
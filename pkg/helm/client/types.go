@@ -2,6 +2,8 @@ package client
 
 import (
 	"io"
+	"net/http"
+	"net/url"
 	"time"
 
 	"helm.sh/helm/v3/pkg/postrender"
@@ -42,6 +44,16 @@ type Options struct {
 	PlainHttp bool
 	// InsecureTls allows invalid or selfsigned certificates to be used. This option may be overridden by PlainHttp which forces HTTP traffic.
 	InsecureTls bool
+	// Username and Password authenticate at the registry. They take precedence over the credentials in the RegistryConfig.
+	Username string
+	Password string
+	// CAFile contains the path of PEM encoded CA certificates which are trusted in addition to the system CAs.
+	CAFile string
+	// CertFile and KeyFile contain the paths of the PEM encoded client certificate and key for mTLS.
+	CertFile string
+	KeyFile  string
+	// Proxy selects the proxy for requests to the registry like http.Transport.Proxy. No proxy is used if it is nil.
+	Proxy func(*http.Request) (*url.URL, error)
 }
 
 // RESTClientOption is a function that can be used to set the RESTClientOptions of a HelmClient.
@@ -123,4 +135,7 @@ type ChartSpec struct {
 	// on installation and upgrade after rendering the templates
 	// +optional
 	PostRenderer postrender.PostRenderer
+	// DryRun renders the chart with a server-side dry-run instead of installing or upgrading the release.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
@@ -3,13 +3,20 @@ package helm
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/cloudogu/k8s-component-operator/pkg/config"
 	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"helm.sh/helm/v3/pkg/chart"
@@ -32,23 +39,43 @@ func TestNew(t *testing.T) {
 			return &rest.Config{}
 		}
 
-		helmClient, err := NewClient(namespace, &config.HelmRepositoryData{PlainHttp: true}, false, nil)
+		registries := &config.HelmRegistries{Registries: []*config.HelmRepositoryData{
+			{Name: "default", PlainHttp: true},
+			{Name: "internal", Schema: config.EndpointSchemaHTTPS},
+		}}
+
+		helmClient, err := NewClient(namespace, registries, false, nil)
+
+		require.NoError(t, err)
+		require.NotNil(t, helmClient)
+		assert.Same(t, registries.Registries[0], helmClient.helmRepoData)
+		assert.Same(t, helmClient.registryClients["default"], helmClient.helmClient)
+		assert.Len(t, helmClient.registryClients, 1)
 
+		internalClient, err := helmClient.registryClient(registries.Registries[1])
 		require.NoError(t, err)
-		assert.NotNil(t, helmClient)
+		assert.Same(t, helmClient.registryClients["internal"], internalClient)
+		assert.Len(t, helmClient.registryClients, 2)
+	})
+
+	t.Run("should fail without registry", func(t *testing.T) {
+		_, err := NewClient("ecosystem", &config.HelmRegistries{}, false, nil)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "no registry configured")
 	})
 }
 
 func TestNewClientFactory(t *testing.T) {
 	t.Run("should create client factory", func(t *testing.T) {
 		debugLog := func(string, ...interface{}) {}
-		helmRepoData := &config.HelmRepositoryData{PlainHttp: true}
+		registries := &config.HelmRegistries{Registries: []*config.HelmRepositoryData{{Name: "default", PlainHttp: true}}}
 
-		actual := NewClientFactory("ecosystem", helmRepoData, true, debugLog)
+		actual := NewClientFactory("ecosystem", registries, true, debugLog)
 
 		require.NotNil(t, actual)
 		assert.Equal(t, "ecosystem", actual.namespace)
-		assert.Same(t, helmRepoData, actual.helmRepoData)
+		assert.Same(t, registries, actual.registries)
 		assert.True(t, actual.debug)
 		assert.NotNil(t, actual.debugLog)
 	})
@@ -62,13 +89,118 @@ func TestClientFactory_NewHelmClient(t *testing.T) {
 			return &rest.Config{}
 		}
 
-		sut := NewClientFactory("ecosystem", &config.HelmRepositoryData{PlainHttp: true}, false, nil)
+		sut := NewClientFactory("ecosystem", &config.HelmRegistries{Registries: []*config.HelmRepositoryData{{Name: "default", PlainHttp: true}}}, false, nil)
 
 		actual, err := sut.NewHelmClient()
 
 		require.NoError(t, err)
 		require.NotNil(t, actual)
-		assert.Equal(t, sut.helmRepoData, actual.helmRepoData)
+		assert.Equal(t, sut.registries, actual.registries)
+	})
+
+	t.Run("should create helm client with replaced registries", func(t *testing.T) {
+		oldGetConfigOrDieDelegate := ctrl.GetConfigOrDie
+		defer func() { ctrl.GetConfigOrDie = oldGetConfigOrDieDelegate }()
+		ctrl.GetConfigOrDie = func() *rest.Config {
+			return &rest.Config{}
+		}
+
+		sut := NewClientFactory("ecosystem", &config.HelmRegistries{Registries: []*config.HelmRepositoryData{{Name: "default", PlainHttp: true}}}, false, nil)
+		rotatedRegistries := &config.HelmRegistries{Registries: []*config.HelmRepositoryData{{Name: "default", Username: "user", Password: "rotated"}}}
+
+		sut.SetRegistries(rotatedRegistries)
+		actual, err := sut.NewHelmClient()
+
+		require.NoError(t, err)
+		assert.Same(t, rotatedRegistries, actual.registries)
+	})
+
+	t.Run("should fail without registries", func(t *testing.T) {
+		sut := NewClientFactory("ecosystem", nil, false, nil)
+
+		_, err := sut.NewHelmClient()
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "registries are not loaded yet")
+	})
+}
+
+type releaseGetter interface {
+	GetRelease(name string) (*release.Release, error)
+}
+
+type otherClient interface {
+	Other()
+}
+
+func TestNewHelmClientFunc_NewHelmClient(t *testing.T) {
+	t.Run("should return helm client as interface of package", func(t *testing.T) {
+		helmClient := &Client{}
+		sut := NewHelmClientFunc[releaseGetter](func() (*Client, error) { return helmClient, nil })
+
+		actual, err := sut.NewHelmClient()
+
+		require.NoError(t, err)
+		assert.Same(t, helmClient, actual)
+	})
+
+	t.Run("should fail to create helm client", func(t *testing.T) {
+		sut := NewHelmClientFunc[releaseGetter](func() (*Client, error) { return nil, assert.AnError })
+
+		actual, err := sut.NewHelmClient()
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should fail if helm client does not implement interface", func(t *testing.T) {
+		sut := NewHelmClientFunc[otherClient](func() (*Client, error) { return &Client{}, nil })
+
+		_, err := sut.NewHelmClient()
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "helm client does not implement *helm.otherClient")
+	})
+}
+
+func TestClientFactory_VerifyRegistries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+
+	t.Run("should verify all registries", func(t *testing.T) {
+		sut := NewClientFactory("ecosystem", nil, false, nil)
+		registries := &config.HelmRegistries{Registries: []*config.HelmRepositoryData{
+			{Name: "default", Endpoint: endpoint + "/k8s", Schema: config.EndpointSchemaHTTPS, PlainHttp: true, Username: "user", Password: "secret"},
+		}}
+
+		err := sut.VerifyRegistries(testCtx, registries)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail for registries rejecting their credentials", func(t *testing.T) {
+		sut := NewClientFactory("ecosystem", nil, false, nil)
+		registries := &config.HelmRegistries{Registries: []*config.HelmRepositoryData{
+			{Name: "default", Endpoint: endpoint + "/k8s", Schema: config.EndpointSchemaHTTPS, PlainHttp: true, Username: "user", Password: "secret"},
+			{Name: "internal", Endpoint: endpoint + "/internal", Schema: config.EndpointSchemaHTTPS, PlainHttp: true, Username: "user", Password: "invalid"},
+		}}
+
+		err := sut.VerifyRegistries(testCtx, registries)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to verify registry internal")
+		assert.ErrorContains(t, err, "rejected the credentials")
+		assert.NotContains(t, err.Error(), "registry default")
 	})
 }
 
@@ -83,6 +215,7 @@ func TestClient_InstallOrUpgrade(t *testing.T) {
 
 		helmRepoData := &config.HelmRepositoryData{Endpoint: "https://staging.cloudogu.com"}
 		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChartSpecValues(chartSpec).Return(nil, nil)
 		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(nil, nil)
 
 		helmClient := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
@@ -92,6 +225,35 @@ func TestClient_InstallOrUpgrade(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("should install pulled chart archive without looking it up in the registries", func(t *testing.T) {
+		chartSpec := &client.ChartSpec{
+			ReleaseName: "testComponent",
+			ChartName:   filepath.Join(t.TempDir(), "testComponent-0.1.1.tgz"),
+			Namespace:   "testNS",
+			Version:     "0.1.1",
+		}
+
+		helmRepoData := &config.HelmRepositoryData{Name: "prod", Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChartSpecValues(chartSpec).Return(nil, nil)
+		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(nil, nil)
+
+		helmClient := &Client{
+			helmClient:   mockHelmClient,
+			helmRepoData: helmRepoData,
+			registries: &config.HelmRegistries{Registries: []*config.HelmRepositoryData{
+				helmRepoData,
+				{Name: "internal", Endpoint: "registry.internal.example.com", Schema: config.EndpointSchemaOCI},
+			}},
+			registryClients: map[string]HelmClient{"prod": mockHelmClient},
+		}
+
+		err := helmClient.InstallOrUpgrade(testCtx, chartSpec)
+
+		require.NoError(t, err)
+		assert.Len(t, helmClient.registryClients, 1)
+	})
+
 	t.Run("should install or upgrade chart with oci-endpoint in chart-name", func(t *testing.T) {
 		chartSpec := &client.ChartSpec{
 			ReleaseName: "testComponent",
@@ -102,6 +264,7 @@ func TestClient_InstallOrUpgrade(t *testing.T) {
 
 		helmRepoData := &config.HelmRepositoryData{Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
 		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChartSpecValues(chartSpec).Return(nil, nil)
 		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(nil, nil)
 
 		helmClient := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
@@ -111,6 +274,32 @@ func TestClient_InstallOrUpgrade(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("should install or upgrade chart from https repository", func(t *testing.T) {
+		chartSpec := &client.ChartSpec{
+			ReleaseName: "testComponent",
+			ChartName:   "testing/testComponent",
+			Namespace:   "testNS",
+			Version:     "0.1.1",
+		}
+		expectedSpec := &client.ChartSpec{
+			ReleaseName: "testComponent",
+			ChartName:   "https://charts.cloudogu.com/testing/testComponent",
+			Namespace:   "testNS",
+			Version:     "0.1.1",
+		}
+
+		helmRepoData := &config.HelmRepositoryData{Endpoint: "charts.cloudogu.com", Schema: config.EndpointSchemaHTTPS}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChartSpecValues(expectedSpec).Return(nil, nil)
+		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, expectedSpec).Return(nil, nil)
+
+		helmClient := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
+
+		err := helmClient.InstallOrUpgrade(testCtx, chartSpec)
+
+		require.NoError(t, err)
+	})
+
 	t.Run("should fail to install or upgrade chart with empty version", func(t *testing.T) {
 		chartSpec := &client.ChartSpec{
 			ReleaseName: "testComponent",
@@ -138,6 +327,7 @@ func TestClient_InstallOrUpgrade(t *testing.T) {
 
 		helmRepoData := &config.HelmRepositoryData{Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
 		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChartSpecValues(chartSpec).Return(nil, nil)
 		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(nil, assert.AnError)
 
 		helmClient := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
@@ -148,6 +338,86 @@ func TestClient_InstallOrUpgrade(t *testing.T) {
 		assert.ErrorIs(t, err, assert.AnError)
 		assert.ErrorContains(t, err, "error while installOrUpgrade chart oci://staging.cloudogu.com/testing/testComponent:")
 	})
+
+	t.Run("should fail to install or upgrade chart with invalid values", func(t *testing.T) {
+		chartSpec := &client.ChartSpec{
+			ReleaseName:         "testComponent",
+			ChartName:           "testing/testComponent",
+			Namespace:           "testNS",
+			Version:             "0.1.1",
+			ValuesYamlOverwrite: "invalid YAML",
+		}
+
+		helmRepoData := &config.HelmRepositoryData{Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChartSpecValues(chartSpec).Return(nil, assert.AnError)
+
+		helmClient := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
+
+		err := helmClient.InstallOrUpgrade(testCtx, chartSpec)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.True(t, IsValuesError(err))
+	})
+}
+
+func TestClient_DryRunInstallOrUpgrade(t *testing.T) {
+	t.Run("should render chart with dry-run", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{
+			ReleaseName: "testComponent",
+			ChartName:   "testing/testComponent",
+			Namespace:   "testNS",
+			Version:     "0.1.1",
+		}
+
+		helmRepoData := &config.HelmRepositoryData{Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(&release.Release{Manifest: "manifest"}, nil)
+
+		sut := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
+
+		// when
+		actual, err := sut.DryRunInstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "manifest", actual)
+		assert.True(t, chartSpec.DryRun)
+		assert.Equal(t, "oci://staging.cloudogu.com/testing/testComponent", chartSpec.ChartName)
+	})
+	t.Run("should fail to render chart without version", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "testComponent", ChartName: "testing/testComponent"}
+		helmRepoData := &config.HelmRepositoryData{Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
+
+		sut := &Client{helmRepoData: helmRepoData}
+
+		// when
+		_, err := sut.DryRunInstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "cannot render chart \"oci://staging.cloudogu.com/testing/testComponent\" without version")
+	})
+	t.Run("should fail to render chart for error in helmClient", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "testComponent", ChartName: "testing/testComponent", Version: "0.1.1"}
+		helmRepoData := &config.HelmRepositoryData{Endpoint: "staging.cloudogu.com", Schema: config.EndpointSchemaOCI}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(nil, assert.AnError)
+
+		sut := &Client{helmClient: mockHelmClient, helmRepoData: helmRepoData}
+
+		// when
+		_, err := sut.DryRunInstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "error while rendering chart oci://staging.cloudogu.com/testing/testComponent with dry-run")
+	})
 }
 
 func TestClient_Uninstall(t *testing.T) {
@@ -234,6 +504,80 @@ func Test_dependencyUnsatisfiedError_Error(t *testing.T) {
 	assert.Equal(t, expected, sut.Error())
 }
 
+func TestIsDependencyUnsatisfiedError(t *testing.T) {
+	assert.True(t, IsDependencyUnsatisfiedError(fmt.Errorf("wrapped: %w", &dependencyUnsatisfiedError{assert.AnError})))
+	assert.False(t, IsDependencyUnsatisfiedError(assert.AnError))
+	assert.False(t, IsDependencyUnsatisfiedError(&registryError{assert.AnError}))
+}
+
+func Test_registryError(t *testing.T) {
+	sut := &registryError{assert.AnError}
+
+	assert.Equal(t, assert.AnError.Error(), sut.Error())
+	assert.ErrorIs(t, sut, assert.AnError)
+}
+
+func TestIsRegistryError(t *testing.T) {
+	assert.True(t, IsRegistryError(fmt.Errorf("wrapped: %w", &registryError{assert.AnError})))
+	assert.False(t, IsRegistryError(assert.AnError))
+}
+
+func Test_valuesError(t *testing.T) {
+	sut := &valuesError{assert.AnError}
+
+	assert.Equal(t, assert.AnError.Error(), sut.Error())
+	assert.ErrorIs(t, sut, assert.AnError)
+}
+
+func TestIsValuesError(t *testing.T) {
+	assert.True(t, IsValuesError(fmt.Errorf("wrapped: %w", &valuesError{assert.AnError})))
+	assert.False(t, IsValuesError(assert.AnError))
+}
+
+func TestClient_SatisfiesDependents(t *testing.T) {
+	t.Run("should succeed if all dependents accept the version", func(t *testing.T) {
+		// given
+		releases := []*release.Release{createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-longhorn")}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(releases, nil)
+		sut := &Client{helmClient: mockHelmClient}
+
+		// when
+		err := sut.SatisfiesDependents(testCtx, "k8s-longhorn", "1.5.8-2")
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail if a dependent does not accept the version", func(t *testing.T) {
+		// given
+		releases := []*release.Release{createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-longhorn")}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(releases, nil)
+		sut := &Client{helmClient: mockHelmClient}
+
+		// when
+		err := sut.SatisfiesDependents(testCtx, "k8s-longhorn", "2.0.0-1")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "version 2.0.0-1 of component k8s-longhorn does not satisfy the requirements of dependent components: installed component k8s-dogu-operator requires k8s-longhorn with version 1.x.x-0")
+	})
+	t.Run("should fail to list deployed releases", func(t *testing.T) {
+		// given
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().ListDeployedReleases().Return(nil, assert.AnError)
+		sut := &Client{helmClient: mockHelmClient}
+
+		// when
+		err := sut.SatisfiesDependents(testCtx, "k8s-longhorn", "2.0.0-1")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list deployed releases")
+	})
+}
+
 func TestClient_SatisfiesDependencies(t *testing.T) {
 	t.Run("should fail to get chart", func(t *testing.T) {
 		// given
@@ -265,6 +609,7 @@ func TestClient_SatisfiesDependencies(t *testing.T) {
 		require.Error(t, err)
 		assert.ErrorIs(t, err, assert.AnError)
 		assert.ErrorContains(t, err, "failed to get chart oci://some.where/testing/testComponent: error while getting chart for oci://some.where/testing/testComponent:0.1.1")
+		assert.True(t, IsRegistryError(err))
 	})
 
 	t.Run("should fail to list deployed releases", func(t *testing.T) {
@@ -470,6 +815,28 @@ func Test_GetLatestVersion(t *testing.T) {
 		assert.Equal(t, "1.2.3", version)
 	})
 
+	t.Run("should resolve version from index of https repository", func(t *testing.T) {
+		// given
+		repoConfigData := &config.HelmRepositoryData{
+			Endpoint: "charts.endpoint",
+			Schema:   config.EndpointSchemaHTTPS,
+		}
+
+		mockedHelmClient := NewMockHelmClient(t)
+		mockedHelmClient.EXPECT().Tags("https://charts.endpoint/testing/myChart").Return([]string{"1.0.5", "1.2.3"}, nil)
+
+		sut := &Client{
+			helmClient:   mockedHelmClient,
+			helmRepoData: repoConfigData,
+		}
+
+		// when
+		version, err := sut.GetLatestVersion("testing/myChart")
+
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3", version)
+	})
+
 	t.Run("should fail when tag-list is empty", func(t *testing.T) {
 		// given
 		repoConfigData := &config.HelmRepositoryData{
@@ -515,6 +882,76 @@ func Test_GetLatestVersion(t *testing.T) {
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
 		assert.ErrorContains(t, err, "error resolving tags for chart oci://some.endpoint/testing/myChart: ")
+		assert.True(t, IsRegistryError(err))
+	})
+}
+
+func TestClient_ResolveVersion(t *testing.T) {
+	repoConfigData := &config.HelmRepositoryData{
+		Endpoint: "some.endpoint",
+		Schema:   config.EndpointSchemaOCI,
+	}
+
+	t.Run("should return exact version without accessing the registry", func(t *testing.T) {
+		// given
+		selector, err := version.ParseSelector("1.2.3")
+		require.NoError(t, err)
+		sut := &Client{helmClient: NewMockHelmClient(t), helmRepoData: repoConfigData}
+
+		// when
+		actual, err := sut.ResolveVersion("testing/myChart", selector, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3", actual)
+	})
+
+	t.Run("should resolve version range", func(t *testing.T) {
+		// given
+		selector, err := version.ParseSelector("~1.2")
+		require.NoError(t, err)
+		mockedHelmClient := NewMockHelmClient(t)
+		mockedHelmClient.EXPECT().Tags("some.endpoint/testing/myChart").Return([]string{"1.2.3", "1.2.4-1", "1.3.0"}, nil)
+		sut := &Client{helmClient: mockedHelmClient, helmRepoData: repoConfigData}
+
+		// when
+		actual, err := sut.ResolveVersion("testing/myChart", selector, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.4-1", actual)
+	})
+
+	t.Run("should fail if no version matches", func(t *testing.T) {
+		// given
+		selector, err := version.ParseSelector("^2.0")
+		require.NoError(t, err)
+		mockedHelmClient := NewMockHelmClient(t)
+		mockedHelmClient.EXPECT().Tags("some.endpoint/testing/myChart").Return([]string{"1.2.3"}, nil)
+		sut := &Client{helmClient: mockedHelmClient, helmRepoData: repoConfigData}
+
+		// when
+		_, err = sut.ResolveVersion("testing/myChart", selector, "")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to resolve version ^2.0 for chart testing/myChart: no version matches \"^2.0\"")
+	})
+
+	t.Run("should fail on registry error", func(t *testing.T) {
+		// given
+		selector, err := version.ParseSelector("stable")
+		require.NoError(t, err)
+		mockedHelmClient := NewMockHelmClient(t)
+		mockedHelmClient.EXPECT().Tags("some.endpoint/testing/myChart").Return(nil, assert.AnError)
+		sut := &Client{helmClient: mockedHelmClient, helmRepoData: repoConfigData}
+
+		// when
+		_, err = sut.ResolveVersion("testing/myChart", selector, "")
+
+		// then
+		require.ErrorIs(t, err, assert.AnError)
+		assert.True(t, IsRegistryError(err))
 	})
 }
 
@@ -535,15 +972,30 @@ func Test_sortByVersionDescending(t *testing.T) {
 			expected: []string{"1.5.6", "1.2.5"},
 		},
 		{
-			name:     "should sort by version with pre-release",
+			name:     "should sort by version with build number",
 			tags:     []string{"1.3.7", "2.0.0-2", "3.5.7-4"},
 			expected: []string{"3.5.7-4", "2.0.0-2", "1.3.7"},
 		},
 		{
-			name:     "should sort by version with pre-release and same major, minor & patch",
+			name:     "should sort by version with build number and same major, minor & patch",
 			tags:     []string{"3.5.7-4", "3.5.7-3", "3.5.7-11", "3.5.7-2"},
 			expected: []string{"3.5.7-11", "3.5.7-4", "3.5.7-3", "3.5.7-2"},
 		},
+		{
+			name:     "should sort build numbers after the version without build number",
+			tags:     []string{"1.5.1", "1.5.2", "1.5.1-8"},
+			expected: []string{"1.5.2", "1.5.1-8", "1.5.1"},
+		},
+		{
+			name:     "should ignore pre-releases if there are releases",
+			tags:     []string{"1.5.1", "1.6.0-rc.1", "1.5.1-2"},
+			expected: []string{"1.5.1-2", "1.5.1"},
+		},
+		{
+			name:     "should sort pre-releases if there are no releases",
+			tags:     []string{"1.6.0-rc.1", "1.6.0-rc.10", "1.6.0-rc.2"},
+			expected: []string{"1.6.0-rc.10", "1.6.0-rc.2", "1.6.0-rc.1"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -573,11 +1025,86 @@ func TestClient_GetChartSpecValues(t *testing.T) {
 
 		require.Error(t, err)
 		require.ErrorIs(t, err, assert.AnError)
+		assert.True(t, IsValuesError(err))
 		assert.Equal(t, 1, len(values))
 		assert.Equal(t, "val", values["key"])
 	})
 }
 
+func TestClient_PullChart(t *testing.T) {
+	repoConfigData := &config.HelmRepositoryData{
+		Endpoint: "some.where/testing",
+		Schema:   config.EndpointSchemaOCI,
+	}
+
+	t.Run("should return digest of chart archive and set its path as chart name", func(t *testing.T) {
+		// given
+		chartPath := filepath.Join(t.TempDir(), "dogu-op-1.0.0.tgz")
+		require.NoError(t, os.WriteFile(chartPath, []byte("chart"), 0600))
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "1.0.0"}
+
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChart(mock.Anything).RunAndReturn(func(spec *client.ChartSpec) (*chart.Chart, string, error) {
+			assert.Equal(t, "oci://some.where/testing/k8s/dogu-op", spec.ChartName)
+			assert.Equal(t, "1.0.0", spec.Version)
+			return &chart.Chart{Metadata: &chart.Metadata{Name: "dogu-op"}}, chartPath, nil
+		}).Once()
+
+		sut := &Client{helmClient: mockHelmClient, helmRepoData: repoConfigData}
+
+		// when
+		digest, err := sut.PullChart(testCtx, chartSpec)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:cc57fc1903e444cf6a726490b43b27ee9f87facc037f86872201847c565b45fb", digest)
+		assert.Equal(t, chartPath, chartSpec.ChartName)
+	})
+
+	t.Run("should fail without version", func(t *testing.T) {
+		// given
+		sut := &Client{helmClient: NewMockHelmClient(t), helmRepoData: repoConfigData}
+
+		// when
+		_, err := sut.PullChart(testCtx, &client.ChartSpec{ChartName: "k8s/dogu-op"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "cannot pull chart \"oci://some.where/testing/k8s/dogu-op\" without version")
+	})
+
+	t.Run("should fail to get chart", func(t *testing.T) {
+		// given
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChart(mock.Anything).Return(nil, "", assert.AnError)
+
+		sut := &Client{helmClient: mockHelmClient, helmRepoData: repoConfigData}
+
+		// when
+		_, err := sut.PullChart(testCtx, &client.ChartSpec{ChartName: "k8s/dogu-op", Version: "1.0.0"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.True(t, IsRegistryError(err))
+	})
+
+	t.Run("should fail to open chart archive", func(t *testing.T) {
+		// given
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().GetChart(mock.Anything).Return(&chart.Chart{Metadata: &chart.Metadata{Name: "dogu-op"}}, filepath.Join(t.TempDir(), "missing.tgz"), nil)
+
+		sut := &Client{helmClient: mockHelmClient, helmRepoData: repoConfigData}
+
+		// when
+		_, err := sut.PullChart(testCtx, &client.ChartSpec{ChartName: "k8s/dogu-op", Version: "1.0.0"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to open chart archive")
+	})
+}
+
 func TestClient_GetRelease(t *testing.T) {
 	t.Run("should call HelmClient", func(t *testing.T) {
 		// given
@@ -596,6 +1123,60 @@ func TestClient_GetRelease(t *testing.T) {
 	})
 }
 
+func TestClient_GetReleaseHistory(t *testing.T) {
+	t.Run("should call HelmClient", func(t *testing.T) {
+		// given
+		history := []*release.Release{{Name: "releasename", Version: 1}, {Name: "releasename", Version: 2}}
+		mockedHelmClient := NewMockHelmClient(t)
+		mockedHelmClient.EXPECT().GetReleaseHistory("releasename").Return(history, nil)
+
+		sut := &Client{
+			helmClient: mockedHelmClient,
+		}
+
+		// when
+		actual, err := sut.GetReleaseHistory("releasename")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, history, actual)
+	})
+}
+
+func TestClient_RollbackRelease(t *testing.T) {
+	t.Run("should rollback release to revision", func(t *testing.T) {
+		// given
+		spec := &client.ChartSpec{ReleaseName: "testComponent"}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().RollbackReleaseToRevision(spec, 2).Return(nil)
+
+		sut := &Client{helmClient: mockHelmClient}
+
+		// when
+		err := sut.RollbackRelease(spec, 2)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail to rollback release for error in helmClient", func(t *testing.T) {
+		// given
+		spec := &client.ChartSpec{ReleaseName: "testComponent"}
+		mockHelmClient := NewMockHelmClient(t)
+		mockHelmClient.EXPECT().RollbackReleaseToRevision(spec, 2).Return(assert.AnError)
+
+		sut := &Client{helmClient: mockHelmClient}
+
+		// when
+		err := sut.RollbackRelease(spec, 2)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "error while rolling back helm-release testComponent to revision 2")
+	})
+}
+
 func TestClient_GetReleaseValues(t *testing.T) {
 	t.Run("should call HelmClient", func(t *testing.T) {
 		// given
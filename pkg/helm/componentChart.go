@@ -17,6 +17,9 @@ import (
 const defaultHelmClientTimeoutMins = time.Duration(15) * time.Minute
 const mappingMetadataFileName = "component-values-metadata.yaml"
 
+// RegistryAnnotation selects the helm registry by its name in which the chart of a component is looked up first.
+const RegistryAnnotation = "k8s.cloudogu.com/helm-registry"
+
 type ChartGetter interface {
 	GetChart(ctx context.Context, spec *client.ChartSpec) (*chart.Chart, error)
 }
@@ -88,13 +91,16 @@ func GetHelmChartSpec(ctx context.Context, c *componentV1.Component, opts ...Hel
 	if len(opts) > 0 {
 		var err error
 		chartSpec.MappedValuesYaml, err = getMappedValuesYaml(ctx, c, chartSpec, chartGetter, yamlSerializer)
-		if err != nil {
+		if err != nil && IsRegistryError(err) {
 			return nil, fmt.Errorf("failed to create mapped values: %w", err)
 		}
+		if err != nil {
+			return nil, &valuesError{fmt.Errorf("failed to create mapped values: %w", err)}
+		}
 
 		chartSpec.ValuesConfigRefYaml, err = reader.GetValues(ctx, c.Spec.ValuesConfigRef)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create values config references: %w", err)
+			return nil, &valuesError{fmt.Errorf("failed to create values config references: %w", err)}
 		}
 	}
 
@@ -161,6 +167,13 @@ func getMappedValuesYaml(ctx context.Context, component *componentV1.Component,
 	return string(serialized), nil
 }
 
+// GetHelmChartName returns the chart name of the component. The chart name contains the registry selected by the
+// annotation RegistryAnnotation, e.g. "registry://internal/k8s/dogu-op".
 func GetHelmChartName(c *componentV1.Component) string {
-	return fmt.Sprintf("%s/%s", c.Spec.Namespace, c.Spec.Name)
+	chartName := fmt.Sprintf("%s/%s", c.Spec.Namespace, c.Spec.Name)
+	if registryName := c.Annotations[RegistryAnnotation]; registryName != "" {
+		return fmt.Sprintf("%s%s/%s", registrySchemePrefix, registryName, chartName)
+	}
+
+	return chartName
 }
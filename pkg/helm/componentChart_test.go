@@ -103,6 +103,7 @@ func TestComponent_GetHelmChartSpec(t *testing.T) {
 			}
 			if tt.wantErr {
 				assert.Error(t, err)
+				assert.True(t, IsValuesError(err))
 				return
 			}
 			if got := spec.Namespace; !reflect.DeepEqual(got, tt.want) {
@@ -494,3 +495,30 @@ func (s *InvalidMarshaller) Unmarshal(y []byte, o interface{}, opts ...originaly
 	}
 	return s.orignalMarshaler.Unmarshal(y, opts)
 }
+
+func TestGetHelmChartName(t *testing.T) {
+	t.Run("should return namespace and name", func(t *testing.T) {
+		// given
+		component := &componentV1.Component{Spec: componentV1.ComponentSpec{Namespace: "k8s", Name: "dogu-op"}}
+
+		// when
+		actual := GetHelmChartName(component)
+
+		// then
+		assert.Equal(t, "k8s/dogu-op", actual)
+	})
+
+	t.Run("should contain registry selected by annotation", func(t *testing.T) {
+		// given
+		component := &componentV1.Component{
+			ObjectMeta: v1.ObjectMeta{Annotations: map[string]string{RegistryAnnotation: "internal"}},
+			Spec:       componentV1.ComponentSpec{Namespace: "k8s", Name: "dogu-op"},
+		}
+
+		// when
+		actual := GetHelmChartName(component)
+
+		// then
+		assert.Equal(t, "registry://internal/k8s/dogu-op", actual)
+	})
+}
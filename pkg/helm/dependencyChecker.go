@@ -8,7 +8,7 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
 
-	"github.com/Masterminds/semver/v3"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
 )
 
 const cesDependencyAnnotationIdentifier = "k8s.cloudogu.com/ces-dependency/"
@@ -46,14 +46,19 @@ func (d *installedDependencyChecker) CheckSatisfied(dependencies []Dependency, d
 			if dependency.Name == deployedRelease.Chart.Name() {
 				isInstalled = true
 				err := checkVersion(dependency, deployedRelease.Chart)
-				errs = append(errs, err)
+				if err != nil {
+					errs = append(errs, &dependencyError{dependency: dependency.Name, err: err})
+				}
 
 				break
 			}
 		}
 
 		if !isInstalled {
-			errs = append(errs, fmt.Errorf("dependency %s with version %s is not installed", dependency.Name, dependency.Version))
+			errs = append(errs, &dependencyError{
+				dependency: dependency.Name,
+				err:        fmt.Errorf("dependency %s with version %s is not installed", dependency.Name, dependency.Version),
+			})
 		}
 	}
 
@@ -61,20 +66,74 @@ func (d *installedDependencyChecker) CheckSatisfied(dependencies []Dependency, d
 }
 
 func checkVersion(dependency Dependency, deployedChart *chart.Chart) error {
-	constraint, err := semver.NewConstraint(dependency.Version)
+	constraint, err := version.ParseConstraint(dependency.Version)
 	if err != nil {
 		return fmt.Errorf("failed to parse constraint for dependency %s with version requirement %s: %w", dependency.Name, dependency.Version, err)
 	}
 
-	version, err := semver.NewVersion(deployedChart.Metadata.Version)
+	deployedVersion := getChartVersion(deployedChart)
+	installedVersion, err := version.Parse(deployedVersion)
 	if err != nil {
-		return fmt.Errorf("failed to parse version of installed component %s with version %s: %w", deployedChart.Metadata.Name, deployedChart.Metadata.Version, err)
+		return fmt.Errorf("failed to parse version of installed component %s with version %s: %w", deployedChart.Metadata.Name, deployedVersion, err)
 	}
 
-	isSatisfied := constraint.Check(version)
+	isSatisfied := constraint.Check(installedVersion)
 	if !isSatisfied {
-		return fmt.Errorf("installed dependency %s with version %s does not satisfy version requirement %s", deployedChart.Metadata.Name, deployedChart.Metadata.Version, dependency.Version)
+		return fmt.Errorf("installed dependency %s with version %s does not satisfy version requirement %s", deployedChart.Metadata.Name, deployedVersion, dependency.Version)
 	}
 
 	return nil
 }
+
+// getChartVersion returns the app version of the chart which is the version of the component. Charts without app
+// version fall back to the chart version.
+func getChartVersion(deployedChart *chart.Chart) string {
+	if deployedChart.AppVersion() != "" {
+		return deployedChart.AppVersion()
+	}
+
+	return deployedChart.Metadata.Version
+}
+
+// dependencyError describes why a single dependency is not satisfied.
+type dependencyError struct {
+	dependency string
+	err        error
+}
+
+// Error returns the string representation of the wrapped error.
+func (de *dependencyError) Error() string {
+	return de.err.Error()
+}
+
+// Unwrap returns the root error.
+func (de *dependencyError) Unwrap() error {
+	return de.err
+}
+
+// GetUnsatisfiedDependencies returns the names of all dependencies which are not satisfied according to the given error.
+func GetUnsatisfiedDependencies(err error) []string {
+	var unsatisfiedErr *dependencyUnsatisfiedError
+	if !errors.As(err, &unsatisfiedErr) {
+		return nil
+	}
+
+	return collectDependencyNames(unsatisfiedErr.err)
+}
+
+func collectDependencyNames(err error) []string {
+	switch typedErr := err.(type) {
+	case *dependencyError:
+		return []string{typedErr.dependency}
+	case interface{ Unwrap() []error }:
+		var names []string
+		for _, wrappedErr := range typedErr.Unwrap() {
+			names = append(names, collectDependencyNames(wrappedErr)...)
+		}
+		return names
+	case interface{ Unwrap() error }:
+		return collectDependencyNames(typedErr.Unwrap())
+	default:
+		return nil
+	}
+}
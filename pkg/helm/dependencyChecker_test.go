@@ -131,12 +131,42 @@ func Test_installedDependencyChecker_CheckSatisfied(t *testing.T) {
 		{
 			name: "should succeed for version-range with pre-release",
 			args: args{
-				// the upper bound must be a pre-release as well due to a bug, see https://github.com/Masterminds/semver/issues/177
+				// the suffix -0 is the build number 0 and therefore equal to the version without suffix
 				dependencies:     []Dependency{createDependency("k8s-etcd", ">=3.0.0-0  <4.0-0")},
 				deployedReleases: []*release.Release{createRelease("k8s-etcd", "3.0.0-2")},
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "should treat numeric suffix as build number",
+			args: args{
+				dependencies:     []Dependency{createDependency("k8s-etcd", ">=1.5.0 <1.5.2")},
+				deployedReleases: []*release.Release{createRelease("k8s-etcd", "1.5.1-8")},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "should fail for pre-release if constraint does not contain a pre-release",
+			args: args{
+				dependencies:     []Dependency{createDependency("k8s-etcd", ">=1.5.0")},
+				deployedReleases: []*release.Release{createRelease("k8s-etcd", "1.6.0-rc.1")},
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "installed dependency k8s-etcd with version 1.6.0-rc.1 does not satisfy version requirement >=1.5.0", i)
+			},
+		},
+		{
+			name: "should prefer app version over chart version",
+			args: args{
+				dependencies: []Dependency{createDependency("k8s-etcd", "~3.5.0")},
+				deployedReleases: []*release.Release{{Chart: &chart.Chart{Metadata: &chart.Metadata{
+					Name:       "k8s-etcd",
+					Version:    "1.0.0",
+					AppVersion: "3.5.9",
+				}}}},
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "should fail if one dependency is not installed",
 			args: args{
@@ -204,6 +234,29 @@ func Test_installedDependencyChecker_CheckSatisfied(t *testing.T) {
 	}
 }
 
+func TestGetUnsatisfiedDependencies(t *testing.T) {
+	t.Run("should return names of unsatisfied dependencies", func(t *testing.T) {
+		// given
+		dependencies := []Dependency{createDependency("k8s-etcd", "~3.0.0"), createDependency("k8s-dogu-operator", ">1.2.3"), createDependency("k8s-longhorn", "1.x.x")}
+		deployedReleases := []*release.Release{createRelease("k8s-dogu-operator", "1.2.2"), createRelease("k8s-longhorn", "1.5.0")}
+		checkErr := (&installedDependencyChecker{}).CheckSatisfied(dependencies, deployedReleases)
+		err := fmt.Errorf("failed to check dependencies: %w", &dependencyUnsatisfiedError{err: checkErr})
+
+		// when
+		actual := GetUnsatisfiedDependencies(err)
+
+		// then
+		assert.Equal(t, []string{"k8s-etcd", "k8s-dogu-operator"}, actual)
+	})
+	t.Run("should return nil for other errors", func(t *testing.T) {
+		assert.Nil(t, GetUnsatisfiedDependencies(assert.AnError))
+		assert.Nil(t, GetUnsatisfiedDependencies(nil))
+	})
+	t.Run("should return nil for dependency errors without dependency information", func(t *testing.T) {
+		assert.Nil(t, GetUnsatisfiedDependencies(&dependencyUnsatisfiedError{err: assert.AnError}))
+	})
+}
+
 func createDependency(name, version string) Dependency {
 	return Dependency{
 		Name:    name,
@@ -0,0 +1,174 @@
+package helm
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"sigs.k8s.io/yaml"
+)
+
+// ResourceChange describes how a resource of a release changes.
+type ResourceChange string
+
+const (
+	// ResourceAdded marks resources which only exist in the rendered manifest.
+	ResourceAdded = ResourceChange("added")
+	// ResourceRemoved marks resources which only exist in the deployed manifest.
+	ResourceRemoved = ResourceChange("removed")
+	// ResourceChanged marks resources which differ between the deployed and the rendered manifest.
+	ResourceChanged = ResourceChange("changed")
+	// ResourceUnchanged marks resources which are equal in the deployed and the rendered manifest.
+	ResourceUnchanged = ResourceChange("unchanged")
+)
+
+// diffContextLines is the number of unchanged lines shown around every change.
+const diffContextLines = 3
+
+// ResourceDiff contains the change of a single resource of a release.
+type ResourceDiff struct {
+	// Resource identifies the resource in the form kind/namespace/name. The namespace is omitted if the manifest
+	// does not contain one.
+	Resource string
+	Change   ResourceChange
+	// Diff is the unified diff of the resource. It is empty for unchanged resources.
+	Diff string
+}
+
+// ManifestDiff contains the changes of all resources of a release sorted by resource.
+type ManifestDiff []ResourceDiff
+
+type manifestResource struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+}
+
+// DiffManifests compares the manifest of a deployed release with a rendered manifest resource by resource.
+// Resources are matched by kind, namespace and name, so that a changed apiVersion shows up as change and not as
+// removal and addition.
+func DiffManifests(deployedManifest string, renderedManifest string) (ManifestDiff, error) {
+	deployed, err := splitManifest(deployedManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split deployed manifest: %w", err)
+	}
+
+	rendered, err := splitManifest(renderedManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split rendered manifest: %w", err)
+	}
+
+	var result ManifestDiff
+	for resource, deployedContent := range deployed {
+		renderedContent, exists := rendered[resource]
+		change := ResourceChanged
+		switch {
+		case !exists:
+			change = ResourceRemoved
+		case deployedContent == renderedContent:
+			change = ResourceUnchanged
+		}
+
+		resourceDiff, err := diffResource(resource, change, deployedContent, renderedContent)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resourceDiff)
+	}
+
+	for resource, renderedContent := range rendered {
+		if _, exists := deployed[resource]; exists {
+			continue
+		}
+
+		resourceDiff, err := diffResource(resource, ResourceAdded, "", renderedContent)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resourceDiff)
+	}
+
+	slices.SortFunc(result, func(a, b ResourceDiff) int {
+		return strings.Compare(a.Resource, b.Resource)
+	})
+
+	return result, nil
+}
+
+func diffResource(resource string, change ResourceChange, deployedContent string, renderedContent string) (ResourceDiff, error) {
+	resourceDiff := ResourceDiff{Resource: resource, Change: change}
+	if change == ResourceUnchanged {
+		return resourceDiff, nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(deployedContent),
+		B:        difflib.SplitLines(renderedContent),
+		FromFile: resource + " (deployed)",
+		ToFile:   resource + " (dry-run)",
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		return ResourceDiff{}, fmt.Errorf("failed to diff resource %s: %w", resource, err)
+	}
+	resourceDiff.Diff = diff
+
+	return resourceDiff, nil
+}
+
+// splitManifest returns the documents of the manifest by their resource identifier. Empty documents are skipped.
+func splitManifest(manifest string) (map[string]string, error) {
+	resources := map[string]string{}
+	for _, document := range releaseutil.SplitManifests(manifest) {
+		var resource manifestResource
+		err := yaml.Unmarshal([]byte(document), &resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse resource: %w", err)
+		}
+
+		if resource.Kind == "" {
+			continue
+		}
+
+		identifier := strings.Join([]string{resource.Kind, resource.Metadata.Namespace, resource.Metadata.Name}, "/")
+		if resource.Metadata.Namespace == "" {
+			identifier = resource.Kind + "/" + resource.Metadata.Name
+		}
+
+		resources[identifier] = strings.TrimSpace(document) + "\n"
+	}
+
+	return resources, nil
+}
+
+// HasChanges returns true if at least one resource is added, removed or changed.
+func (d ManifestDiff) HasChanges() bool {
+	return slices.ContainsFunc(d, func(resourceDiff ResourceDiff) bool {
+		return resourceDiff.Change != ResourceUnchanged
+	})
+}
+
+// Summary counts the resources by their change, e.g. "1 added, 2 changed, 0 removed, 5 unchanged".
+func (d ManifestDiff) Summary() string {
+	counts := map[ResourceChange]int{}
+	for _, resourceDiff := range d {
+		counts[resourceDiff.Change]++
+	}
+
+	return fmt.Sprintf("%d added, %d changed, %d removed, %d unchanged",
+		counts[ResourceAdded], counts[ResourceChanged], counts[ResourceRemoved], counts[ResourceUnchanged])
+}
+
+// String returns the unified diffs of all added, changed and removed resources.
+func (d ManifestDiff) String() string {
+	var builder strings.Builder
+	for _, resourceDiff := range d {
+		builder.WriteString(resourceDiff.Diff)
+	}
+
+	return builder.String()
+}
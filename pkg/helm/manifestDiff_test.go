@@ -0,0 +1,117 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deployedTestManifest = `---
+# Source: test/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  level: info
+---
+# Source: test/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: test
+  namespace: ecosystem
+spec:
+  ports:
+    - port: 8080
+---
+# Source: test/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-secret
+`
+
+const renderedTestManifest = `---
+# Source: test/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  level: debug
+---
+# Source: test/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: test
+  namespace: ecosystem
+spec:
+  ports:
+    - port: 8080
+---
+# Source: test/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+`
+
+func TestDiffManifests(t *testing.T) {
+	t.Run("should diff every resource", func(t *testing.T) {
+		// when
+		actual, err := DiffManifests(deployedTestManifest, renderedTestManifest)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, actual, 4)
+
+		assert.Equal(t, "ConfigMap/test-config", actual[0].Resource)
+		assert.Equal(t, ResourceChanged, actual[0].Change)
+		assert.Contains(t, actual[0].Diff, "--- ConfigMap/test-config (deployed)\n+++ ConfigMap/test-config (dry-run)\n")
+		assert.Contains(t, actual[0].Diff, "-  level: info\n+  level: debug\n")
+
+		assert.Equal(t, "Deployment/test", actual[1].Resource)
+		assert.Equal(t, ResourceAdded, actual[1].Change)
+		assert.Contains(t, actual[1].Diff, "+kind: Deployment\n")
+
+		assert.Equal(t, "Secret/test-secret", actual[2].Resource)
+		assert.Equal(t, ResourceRemoved, actual[2].Change)
+		assert.Contains(t, actual[2].Diff, "-kind: Secret\n")
+
+		assert.Equal(t, "Service/ecosystem/test", actual[3].Resource)
+		assert.Equal(t, ResourceUnchanged, actual[3].Change)
+		assert.Empty(t, actual[3].Diff)
+
+		assert.True(t, actual.HasChanges())
+		assert.Equal(t, "1 added, 1 changed, 1 removed, 1 unchanged", actual.Summary())
+		assert.Equal(t, actual[0].Diff+actual[1].Diff+actual[2].Diff, actual.String())
+	})
+	t.Run("should add all resources without deployed release", func(t *testing.T) {
+		// when
+		actual, err := DiffManifests("", renderedTestManifest)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "3 added, 0 changed, 0 removed, 0 unchanged", actual.Summary())
+	})
+	t.Run("should report no changes for equal manifests", func(t *testing.T) {
+		// when
+		actual, err := DiffManifests(deployedTestManifest, deployedTestManifest)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, actual.HasChanges())
+		assert.Empty(t, actual.String())
+	})
+	t.Run("should fail for invalid manifest", func(t *testing.T) {
+		// when
+		_, err := DiffManifests(deployedTestManifest, "kind: [invalid")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to split rendered manifest")
+	})
+}
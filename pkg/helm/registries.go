@@ -0,0 +1,141 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+)
+
+// registrySchemePrefix marks chart names which select the registry to look up the chart in first,
+// e.g. "registry://internal/k8s/dogu-op".
+const registrySchemePrefix = "registry://"
+
+// chartLocation describes a registry which possibly serves a chart together with the reference of the chart in it.
+type chartLocation struct {
+	helmClient   HelmClient
+	helmRepoData *config.HelmRepositoryData
+	ref          string
+}
+
+// splitRegistryName splits a chart name into the name of the selected registry and the chart name without it.
+func splitRegistryName(chartName string) (registryName string, name string) {
+	if !strings.HasPrefix(chartName, registrySchemePrefix) {
+		return "", chartName
+	}
+
+	registryName, name, _ = strings.Cut(strings.TrimPrefix(chartName, registrySchemePrefix), "/")
+	return registryName, name
+}
+
+// locations returns the locations of the chart in the order in which the registries are tried. The registry
+// selected by the chart name or mapped to the namespace of the chart comes first. Charts with a full URL are only
+// looked up at this URL.
+func (c *Client) locations(chartName string) ([]chartLocation, error) {
+	registryName, name := splitRegistryName(chartName)
+	if c.registries == nil || strings.Contains(name, "://") {
+		return []chartLocation{{helmClient: c.helmClient, helmRepoData: c.helmRepoData, ref: c.patchEndpoint(name)}}, nil
+	}
+
+	namespace, _, _ := strings.Cut(name, "/")
+	registries, err := c.registries.Select(registryName, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select registry for chart %s: %w", chartName, err)
+	}
+
+	locations := make([]chartLocation, 0, len(registries))
+	for _, helmRepoData := range registries {
+		locations = append(locations, chartLocation{
+			helmRepoData: helmRepoData,
+			ref:          fmt.Sprintf("%s/%s", helmRepoData.URL(), name),
+		})
+	}
+
+	return locations, nil
+}
+
+// tryLocations calls the function with every location of the chart until it succeeds. If no registry serves the
+// chart, the errors of all registries are returned.
+func (c *Client) tryLocations(chartName string, fn func(location chartLocation) error) error {
+	locations, err := c.locations(chartName)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, location := range locations {
+		location, err = c.withHelmClient(location)
+		if err == nil {
+			err = fn(location)
+		}
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	return errors.Join(errs...)
+}
+
+// withHelmClient sets the helm client of the registry of the location unless the location already has one. The helm
+// clients of the registries are only created when they are needed.
+func (c *Client) withHelmClient(location chartLocation) (chartLocation, error) {
+	if location.helmClient != nil {
+		return location, nil
+	}
+
+	helmClient, err := c.registryClient(location.helmRepoData)
+	if err != nil {
+		return location, err
+	}
+
+	location.helmClient = helmClient
+	return location, nil
+}
+
+// locateChart gets the chart from the first registry serving it and returns it with the path of its archive. The chart
+// name of the spec is set to the reference of the chart in this registry.
+func (c *Client) locateChart(ctx context.Context, spec *client.ChartSpec) (chartLocation, *chart.Chart, string, error) {
+	chartName := spec.ChartName
+
+	var servingLocation chartLocation
+	var componentChart *chart.Chart
+	var chartPath string
+	err := c.tryLocations(chartName, func(location chartLocation) error {
+		spec.ChartName = location.ref
+
+		var err error
+		componentChart, chartPath, err = c.getChart(ctx, location, spec)
+		servingLocation = location
+		return err
+	})
+
+	return servingLocation, componentChart, chartPath, err
+}
+
+// findServingLocation returns the location of the first registry serving the version of the chart. The registries are
+// only asked if there is more than one possible location.
+func (c *Client) findServingLocation(ctx context.Context, spec *client.ChartSpec) (chartLocation, error) {
+	locations, err := c.locations(spec.ChartName)
+	if err != nil {
+		return chartLocation{}, err
+	}
+
+	if len(locations) == 1 {
+		return c.withHelmClient(locations[0])
+	}
+
+	probeSpec := *spec
+	location, _, _, err := c.locateChart(ctx, &probeSpec)
+	return location, err
+}
@@ -0,0 +1,231 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+)
+
+var (
+	prodRegistry     = &config.HelmRepositoryData{Name: "prod", Endpoint: "registry.example.com", Schema: config.EndpointSchemaOCI}
+	internalRegistry = &config.HelmRepositoryData{Name: "internal", Endpoint: "charts.internal/helm", Schema: config.EndpointSchemaHTTPS}
+)
+
+func newMultiRegistryClient(prodClient *MockHelmClient, internalClient *MockHelmClient) *Client {
+	return &Client{
+		helmClient:   prodClient,
+		helmRepoData: prodRegistry,
+		registries: &config.HelmRegistries{
+			Registries:       []*config.HelmRepositoryData{prodRegistry, internalRegistry},
+			NamespaceMapping: map[string]string{"k8s-testing": "internal"},
+		},
+		registryClients: map[string]HelmClient{"prod": prodClient, "internal": internalClient},
+	}
+}
+
+func Test_splitRegistryName(t *testing.T) {
+	registryName, name := splitRegistryName("registry://internal/k8s/dogu-op")
+	assert.Equal(t, "internal", registryName)
+	assert.Equal(t, "k8s/dogu-op", name)
+
+	registryName, name = splitRegistryName("k8s/dogu-op")
+	assert.Empty(t, registryName)
+	assert.Equal(t, "k8s/dogu-op", name)
+}
+
+func TestClient_locations(t *testing.T) {
+	tests := []struct {
+		name      string
+		chartName string
+		wantRefs  []string
+		wantErr   string
+	}{
+		{
+			name:      "should try registries in configured order",
+			chartName: "k8s/dogu-op",
+			wantRefs:  []string{"oci://registry.example.com/k8s/dogu-op", "https://charts.internal/helm/k8s/dogu-op"},
+		},
+		{
+			name:      "should start with registry mapped to namespace",
+			chartName: "k8s-testing/dogu-op",
+			wantRefs:  []string{"https://charts.internal/helm/k8s-testing/dogu-op", "oci://registry.example.com/k8s-testing/dogu-op"},
+		},
+		{
+			name:      "should start with selected registry",
+			chartName: "registry://internal/k8s/dogu-op",
+			wantRefs:  []string{"https://charts.internal/helm/k8s/dogu-op", "oci://registry.example.com/k8s/dogu-op"},
+		},
+		{
+			name:      "should only use full chart URL",
+			chartName: "oci://other.example.com/k8s/dogu-op",
+			wantRefs:  []string{"oci://other.example.com/k8s/dogu-op"},
+		},
+		{
+			name:      "should fail for unknown registry",
+			chartName: "registry://unknown/k8s/dogu-op",
+			wantErr:   "failed to select registry for chart registry://unknown/k8s/dogu-op: helm registry \"unknown\" is not configured",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := newMultiRegistryClient(NewMockHelmClient(t), NewMockHelmClient(t))
+
+			locations, err := sut.locations(tt.chartName)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			var refs []string
+			for _, location := range locations {
+				refs = append(refs, location.ref)
+			}
+			assert.Equal(t, tt.wantRefs, refs)
+		})
+	}
+}
+
+func TestClient_GetAvailableVersions_fallback(t *testing.T) {
+	t.Run("should not create helm client of next registry if first registry serves chart", func(t *testing.T) {
+		// given
+		prodClient := NewMockHelmClient(t)
+		prodClient.EXPECT().Tags("registry.example.com/k8s/dogu-op").Return([]string{"1.0.0"}, nil)
+
+		sut := newMultiRegistryClient(prodClient, nil)
+		delete(sut.registryClients, "internal")
+
+		// when
+		tags, err := sut.GetAvailableVersions("k8s/dogu-op")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.0.0"}, tags)
+		assert.Len(t, sut.registryClients, 1)
+	})
+
+	t.Run("should fall back to next registry", func(t *testing.T) {
+		// given
+		prodClient := NewMockHelmClient(t)
+		prodClient.EXPECT().Tags("registry.example.com/k8s/dogu-op").Return(nil, assert.AnError)
+		internalClient := NewMockHelmClient(t)
+		internalClient.EXPECT().Tags("https://charts.internal/helm/k8s/dogu-op").Return([]string{"1.0.0"}, nil)
+
+		sut := newMultiRegistryClient(prodClient, internalClient)
+
+		// when
+		tags, err := sut.GetAvailableVersions("k8s/dogu-op")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.0.0"}, tags)
+	})
+
+	t.Run("should not ask further registries", func(t *testing.T) {
+		// given
+		internalClient := NewMockHelmClient(t)
+		internalClient.EXPECT().Tags("https://charts.internal/helm/k8s-testing/dogu-op").Return([]string{"1.1.0-testing"}, nil)
+
+		sut := newMultiRegistryClient(NewMockHelmClient(t), internalClient)
+
+		// when
+		tags, err := sut.GetAvailableVersions("k8s-testing/dogu-op")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.1.0-testing"}, tags)
+	})
+
+	t.Run("should return errors of all registries", func(t *testing.T) {
+		// given
+		prodClient := NewMockHelmClient(t)
+		prodClient.EXPECT().Tags(mock.Anything).Return(nil, assert.AnError)
+		internalClient := NewMockHelmClient(t)
+		internalClient.EXPECT().Tags(mock.Anything).Return(nil, assert.AnError)
+
+		sut := newMultiRegistryClient(prodClient, internalClient)
+
+		// when
+		_, err := sut.GetAvailableVersions("k8s/dogu-op")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.True(t, IsRegistryError(err))
+		assert.ErrorContains(t, err, "error resolving tags for chart oci://registry.example.com/k8s/dogu-op")
+		assert.ErrorContains(t, err, "error resolving tags for chart https://charts.internal/helm/k8s/dogu-op")
+	})
+}
+
+func TestClient_InstallOrUpgrade_fallback(t *testing.T) {
+	t.Run("should install chart from first registry serving it", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "1.0.0"}
+
+		prodClient := NewMockHelmClient(t)
+		prodClient.EXPECT().GetChart(mock.Anything).Return(nil, "", assert.AnError)
+		prodClient.EXPECT().GetChartSpecValues(chartSpec).Return(nil, nil)
+		internalClient := NewMockHelmClient(t)
+		internalClient.EXPECT().GetChart(mock.Anything).Return(&chart.Chart{}, "", nil)
+		internalClient.EXPECT().InstallOrUpgradeChart(testCtx, chartSpec).Return(&release.Release{}, nil)
+
+		sut := newMultiRegistryClient(prodClient, internalClient)
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "https://charts.internal/helm/k8s/dogu-op", chartSpec.ChartName)
+	})
+
+	t.Run("should fail if no registry serves the chart", func(t *testing.T) {
+		// given
+		chartSpec := &client.ChartSpec{ReleaseName: "dogu-op", ChartName: "k8s/dogu-op", Version: "1.0.0"}
+
+		prodClient := NewMockHelmClient(t)
+		prodClient.EXPECT().GetChart(mock.Anything).Return(nil, "", assert.AnError)
+		internalClient := NewMockHelmClient(t)
+		internalClient.EXPECT().GetChart(mock.Anything).Return(nil, "", assert.AnError)
+
+		sut := newMultiRegistryClient(prodClient, internalClient)
+
+		// when
+		err := sut.InstallOrUpgrade(testCtx, chartSpec)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.True(t, IsRegistryError(err))
+		assert.ErrorContains(t, err, "failed to find registry serving chart k8s/dogu-op")
+	})
+}
+
+func TestClient_GetChart_fallback(t *testing.T) {
+	// given
+	chartSpec := &client.ChartSpec{ChartName: "registry://internal/k8s/dogu-op", Version: "1.0.0"}
+	expectedChart := &chart.Chart{Metadata: &chart.Metadata{Name: "dogu-op"}}
+
+	internalClient := NewMockHelmClient(t)
+	internalClient.EXPECT().GetChart(mock.Anything).Return(nil, "", assert.AnError)
+	prodClient := NewMockHelmClient(t)
+	prodClient.EXPECT().GetChart(mock.Anything).Return(expectedChart, "", nil)
+
+	sut := newMultiRegistryClient(prodClient, internalClient)
+
+	// when
+	actual, err := sut.GetChart(testCtx, chartSpec)
+
+	// then
+	require.NoError(t, err)
+	assert.Same(t, expectedChart, actual)
+	assert.Equal(t, "oci://registry.example.com/k8s/dogu-op", chartSpec.ChartName)
+}
@@ -0,0 +1,113 @@
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+// GetDependents returns the names of all installed releases which declare a dependency on the component with the
+// given name.
+func GetDependents(componentName string, releases []*release.Release) []string {
+	var dependents []string
+	for _, installedRelease := range releases {
+		if installedRelease.Name == componentName || !isInstalled(installedRelease) {
+			continue
+		}
+
+		for _, dependency := range getComponentDependencies(installedRelease.Chart) {
+			if dependency.Name == componentName {
+				dependents = append(dependents, installedRelease.Name)
+				break
+			}
+		}
+	}
+
+	slices.Sort(dependents)
+	return dependents
+}
+
+// GetDependentsInDeletionOrder returns the names of all installed releases which depend directly or transitively on
+// the component with the given name. Every release is ordered before the releases it depends on so that the releases
+// can be uninstalled in the returned order.
+func GetDependentsInDeletionOrder(componentName string, releases []*release.Release) ([]string, error) {
+	var order []string
+	// finished is false while the dependents of a release are visited and true afterward.
+	finished := map[string]bool{componentName: false}
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		for _, dependent := range GetDependents(name, releases) {
+			done, seen := finished[dependent]
+			if seen && !done {
+				return fmt.Errorf("found dependency cycle between %s and %s", name, dependent)
+			}
+			if done {
+				continue
+			}
+
+			finished[dependent] = false
+			err := visit(dependent)
+			if err != nil {
+				return err
+			}
+			finished[dependent] = true
+			order = append(order, dependent)
+		}
+
+		return nil
+	}
+
+	err := visit(componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// checkDependentConstraints validates that the given version of the component satisfies the version requirements of
+// all installed releases depending on it.
+func checkDependentConstraints(componentName string, targetVersion string, releases []*release.Release) error {
+	parsedVersion, err := version.Parse(targetVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse version %s of component %s: %w", targetVersion, componentName, err)
+	}
+
+	var errs []error
+	for _, installedRelease := range releases {
+		if installedRelease.Name == componentName || !isInstalled(installedRelease) {
+			continue
+		}
+
+		for _, dependency := range getComponentDependencies(installedRelease.Chart) {
+			if dependency.Name != componentName {
+				continue
+			}
+
+			constraint, err := version.ParseConstraint(dependency.Version)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse constraint of dependent component %s with version requirement %s: %w", installedRelease.Name, dependency.Version, err))
+				continue
+			}
+
+			if !constraint.Check(parsedVersion) {
+				errs = append(errs, fmt.Errorf("installed component %s requires %s with version %s", installedRelease.Name, componentName, dependency.Version))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isInstalled(rel *release.Release) bool {
+	if rel.Chart == nil || rel.Chart.Metadata == nil {
+		return false
+	}
+
+	return rel.Info == nil || (rel.Info.Status != release.StatusUninstalled && rel.Info.Status != release.StatusUninstalling)
+}
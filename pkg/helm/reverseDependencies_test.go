@@ -0,0 +1,135 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func createDependentRelease(name string, status release.Status, dependencies ...string) *release.Release {
+	annotations := map[string]string{}
+	for _, dependency := range dependencies {
+		annotations[cesDependencyAnnotationIdentifier+dependency] = "1.x.x-0"
+	}
+
+	return &release.Release{
+		Name:  name,
+		Info:  &release.Info{Status: status},
+		Chart: &chart.Chart{Metadata: &chart.Metadata{Name: name, Version: "1.0.0", Annotations: annotations}},
+	}
+}
+
+func TestGetDependents(t *testing.T) {
+	t.Run("should return installed dependents", func(t *testing.T) {
+		// given
+		releases := []*release.Release{
+			createDependentRelease("k8s-etcd", release.StatusDeployed),
+			createDependentRelease("k8s-service-discovery", release.StatusFailed, "k8s-etcd"),
+			createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-etcd", "k8s-longhorn"),
+			createDependentRelease("k8s-backup-operator", release.StatusUninstalled, "k8s-etcd"),
+			createDependentRelease("k8s-longhorn", release.StatusDeployed),
+			{Name: "no-chart"},
+		}
+
+		// when
+		actual := GetDependents("k8s-etcd", releases)
+
+		// then
+		assert.Equal(t, []string{"k8s-dogu-operator", "k8s-service-discovery"}, actual)
+	})
+	t.Run("should return nothing without dependents", func(t *testing.T) {
+		releases := []*release.Release{createDependentRelease("k8s-etcd", release.StatusDeployed, "k8s-etcd")}
+
+		assert.Empty(t, GetDependents("k8s-etcd", releases))
+	})
+}
+
+func TestGetDependentsInDeletionOrder(t *testing.T) {
+	t.Run("should order dependents before their dependencies", func(t *testing.T) {
+		// given
+		releases := []*release.Release{
+			createDependentRelease("k8s-etcd", release.StatusDeployed),
+			createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-etcd"),
+			createDependentRelease("k8s-service-discovery", release.StatusDeployed, "k8s-etcd", "k8s-dogu-operator"),
+			createDependentRelease("k8s-blueprint-operator", release.StatusDeployed, "k8s-service-discovery"),
+			createDependentRelease("k8s-longhorn", release.StatusDeployed),
+		}
+
+		// when
+		actual, err := GetDependentsInDeletionOrder("k8s-etcd", releases)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"k8s-blueprint-operator", "k8s-service-discovery", "k8s-dogu-operator"}, actual)
+	})
+	t.Run("should return nothing without dependents", func(t *testing.T) {
+		releases := []*release.Release{createDependentRelease("k8s-etcd", release.StatusDeployed)}
+
+		actual, err := GetDependentsInDeletionOrder("k8s-etcd", releases)
+
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+	})
+	t.Run("should fail on dependency cycle", func(t *testing.T) {
+		// given
+		releases := []*release.Release{
+			createDependentRelease("k8s-etcd", release.StatusDeployed, "k8s-service-discovery"),
+			createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-etcd"),
+			createDependentRelease("k8s-service-discovery", release.StatusDeployed, "k8s-dogu-operator"),
+		}
+
+		// when
+		_, err := GetDependentsInDeletionOrder("k8s-etcd", releases)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "found dependency cycle")
+	})
+}
+
+func Test_checkDependentConstraints(t *testing.T) {
+	releases := []*release.Release{
+		createDependentRelease("k8s-longhorn", release.StatusDeployed),
+		createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-longhorn"),
+		createDependentRelease("k8s-backup-operator", release.StatusDeployed, "k8s-longhorn"),
+		createDependentRelease("k8s-etcd", release.StatusDeployed),
+	}
+	releases[1].Chart.Metadata.Annotations[cesDependencyAnnotationIdentifier+"k8s-longhorn"] = ">=1.5.0-0 <1.6.0-0"
+	releases[2].Chart.Metadata.Annotations[cesDependencyAnnotationIdentifier+"k8s-longhorn"] = "1.x.x-0"
+
+	t.Run("should succeed if all dependents accept the version", func(t *testing.T) {
+		err := checkDependentConstraints("k8s-longhorn", "1.5.8-2", releases)
+
+		require.NoError(t, err)
+	})
+	t.Run("should name all dependents which do not accept the version", func(t *testing.T) {
+		err := checkDependentConstraints("k8s-longhorn", "2.0.0-1", releases)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "installed component k8s-dogu-operator requires k8s-longhorn with version >=1.5.0-0 <1.6.0-0\ninstalled component k8s-backup-operator requires k8s-longhorn with version 1.x.x-0")
+	})
+	t.Run("should name the dependent which does not accept the version", func(t *testing.T) {
+		err := checkDependentConstraints("k8s-longhorn", "1.6.0-1", releases)
+
+		require.Error(t, err)
+		assert.Equal(t, "installed component k8s-dogu-operator requires k8s-longhorn with version >=1.5.0-0 <1.6.0-0", err.Error())
+	})
+	t.Run("should fail to parse version", func(t *testing.T) {
+		err := checkDependentConstraints("k8s-longhorn", "invalid", releases)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse version invalid of component k8s-longhorn")
+	})
+	t.Run("should fail to parse constraint", func(t *testing.T) {
+		invalidReleases := []*release.Release{createDependentRelease("k8s-dogu-operator", release.StatusDeployed, "k8s-longhorn")}
+		invalidReleases[0].Chart.Metadata.Annotations[cesDependencyAnnotationIdentifier+"k8s-longhorn"] = "invalid"
+
+		err := checkDependentConstraints("k8s-longhorn", "1.5.8-2", invalidReleases)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse constraint of dependent component k8s-dogu-operator with version requirement invalid")
+	})
+}
@@ -0,0 +1,87 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+)
+
+// tlsFilesDir is the directory the CA bundles and client certificates of the registries are written to, as helm only
+// reads them from files.
+var tlsFilesDir = "/tmp/.helmtls"
+
+// registryTlsFiles contains the paths of the TLS files of a registry. Paths are empty if the registry has no such data.
+type registryTlsFiles struct {
+	caFile   string
+	certFile string
+	keyFile  string
+}
+
+// writeRegistryTlsFiles writes the CA bundle and the client certificate of the registry to files.
+func writeRegistryTlsFiles(helmRepoData *config.HelmRepositoryData) (registryTlsFiles, error) {
+	var files registryTlsFiles
+	var err error
+
+	files.caFile, err = writeTlsFile(helmRepoData.CAData)
+	if err != nil {
+		return registryTlsFiles{}, fmt.Errorf("failed to write CA bundle of registry %s: %w", helmRepoData.Name, err)
+	}
+
+	files.certFile, err = writeTlsFile(helmRepoData.CertData)
+	if err != nil {
+		return registryTlsFiles{}, fmt.Errorf("failed to write client certificate of registry %s: %w", helmRepoData.Name, err)
+	}
+
+	files.keyFile, err = writeTlsFile(helmRepoData.KeyData)
+	if err != nil {
+		return registryTlsFiles{}, fmt.Errorf("failed to write client key of registry %s: %w", helmRepoData.Name, err)
+	}
+
+	return files, nil
+}
+
+// writeTlsFile writes the PEM data to a file named after its hash. Existing files are never changed, so that helm
+// clients created before a rotation keep reading their data while new clients use the new file.
+func writeTlsFile(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+
+	hash := sha256.Sum256(data)
+	path := filepath.Join(tlsFilesDir, hex.EncodeToString(hash[:])+".pem")
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	err := os.MkdirAll(tlsFilesDir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	// write to a temporary file first as concurrently created clients must never read a partially written file
+	tmpFile, err := os.CreateTemp(tlsFilesDir, "tmp-")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	_, err = tmpFile.Write(data)
+	closeErr := tmpFile.Close()
+	if err != nil {
+		return "", err
+	}
+	if closeErr != nil {
+		return "", closeErr
+	}
+
+	err = os.Rename(tmpFile.Name(), path)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
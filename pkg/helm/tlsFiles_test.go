@@ -0,0 +1,71 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+)
+
+func Test_writeRegistryTlsFiles(t *testing.T) {
+	originalTlsFilesDir := tlsFilesDir
+	defer func() { tlsFilesDir = originalTlsFilesDir }()
+
+	t.Run("should write CA bundle and client certificate to files named after their hash", func(t *testing.T) {
+		// given
+		tlsFilesDir = t.TempDir()
+		helmRepoData := &config.HelmRepositoryData{Name: "internal", CAData: []byte("ca"), CertData: []byte("cert"), KeyData: []byte("key")}
+
+		// when
+		files, err := writeRegistryTlsFiles(helmRepoData)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tlsFilesDir, filepath.Dir(files.caFile))
+		for file, content := range map[string]string{files.caFile: "ca", files.certFile: "cert", files.keyFile: "key"} {
+			actual, err := os.ReadFile(file)
+			require.NoError(t, err)
+			assert.Equal(t, content, string(actual))
+		}
+
+		rotatedFiles, err := writeRegistryTlsFiles(&config.HelmRepositoryData{Name: "internal", CAData: []byte("rotated ca")})
+		require.NoError(t, err)
+		assert.NotEqual(t, files.caFile, rotatedFiles.caFile)
+		assert.Empty(t, rotatedFiles.certFile)
+		assert.Empty(t, rotatedFiles.keyFile)
+	})
+
+	t.Run("should reuse existing file with same content", func(t *testing.T) {
+		// given
+		tlsFilesDir = t.TempDir()
+
+		// when
+		first, err := writeTlsFile([]byte("ca"))
+		require.NoError(t, err)
+		second, err := writeTlsFile([]byte("ca"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		entries, err := os.ReadDir(tlsFilesDir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("should fail to write files", func(t *testing.T) {
+		// given
+		tlsFilesDir = filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(tlsFilesDir, nil, 0600))
+
+		// when
+		_, err := writeRegistryTlsFiles(&config.HelmRepositoryData{Name: "internal", CAData: []byte("ca")})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to write CA bundle of registry internal")
+	})
+}
@@ -0,0 +1,175 @@
+// Package journal persists the helm actions which are in flight, so that actions interrupted by a crash or restart
+// of the operator can be recovered deliberately instead of being guessed from pending releases.
+package journal
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+const (
+	// ConfigMapName is the name of the config map containing the journal. Every key is the name of a component with
+	// a helm action in flight.
+	ConfigMapName = "k8s-component-operator-journal"
+	// operatorName is used as value of the component name label of the journal config map.
+	operatorName = "k8s-component-operator"
+	// maxTries is the number of attempts to write the journal if it was changed concurrently.
+	maxTries = 5
+)
+
+// Operation is the kind of helm action recorded in the journal.
+type Operation string
+
+const (
+	// OperationInstall installs a new release.
+	OperationInstall = Operation("install")
+	// OperationUpgrade upgrades an existing release to the same or a newer version.
+	OperationUpgrade = Operation("upgrade")
+	// OperationDowngrade upgrades an existing release to an older version.
+	OperationDowngrade = Operation("downgrade")
+	// OperationRollback rolls an existing release back to a previous revision.
+	OperationRollback = Operation("rollback")
+	// OperationUninstall uninstalls a release.
+	OperationUninstall = Operation("uninstall")
+)
+
+// Entry describes a helm action which was started but not yet finished.
+type Entry struct {
+	// Operation is the kind of the helm action.
+	Operation Operation `json:"operation"`
+	// Namespace is the namespace the release is deployed to.
+	Namespace string `json:"namespace,omitempty"`
+	// TargetVersion is the chart version the release is changed to. It is empty for uninstallations.
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// ChartDigest is the digest of the chart archive that is installed. It is empty for rollbacks and uninstallations.
+	ChartDigest string `json:"chartDigest,omitempty"`
+	// StartedAt is the time the helm action was started.
+	StartedAt metav1.Time `json:"startedAt"`
+	// Revision is the revision of the release before the helm action. It is 0 if there was no release.
+	Revision int `json:"revision"`
+	// TargetRevision is the revision a rollback returns to.
+	TargetRevision int `json:"targetRevision,omitempty"`
+}
+
+// Journal stores the entries of the helm actions in flight in the config map ConfigMapName.
+type Journal struct {
+	configMapClient configMapInterface
+}
+
+// New creates a new Journal. The config map client must access the namespace of the operator.
+func New(configMapClient configMapInterface) *Journal {
+	return &Journal{configMapClient: configMapClient}
+}
+
+// Begin records that a helm action for the given component is about to start. An existing entry of the component
+// is replaced.
+func (j *Journal) Begin(ctx context.Context, component string, entry Entry) error {
+	entryBytes, err := yaml.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal journal entry of component %q: %w", component, err)
+	}
+
+	err = j.update(ctx, func(data map[string]string) bool {
+		data[component] = string(entryBytes)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record %s of component %q in journal: %w", entry.Operation, component, err)
+	}
+
+	return nil
+}
+
+// Complete removes the entry of the given component after its helm action finished.
+func (j *Journal) Complete(ctx context.Context, component string) error {
+	err := j.update(ctx, func(data map[string]string) bool {
+		if _, exists := data[component]; !exists {
+			return false
+		}
+
+		delete(data, component)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove entry of component %q from journal: %w", component, err)
+	}
+
+	return nil
+}
+
+// Get returns the entry of the given component or nil if the component has no helm action in flight.
+func (j *Journal) Get(ctx context.Context, component string) (*Entry, error) {
+	configMap, err := j.configMapClient.Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal config map %s: %w", ConfigMapName, err)
+	}
+
+	rawEntry, exists := configMap.Data[component]
+	if !exists {
+		return nil, nil
+	}
+
+	entry := &Entry{}
+	err = yaml.Unmarshal([]byte(rawEntry), entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse journal entry of component %q: %w", component, err)
+	}
+
+	return entry, nil
+}
+
+// update applies the change to the data of the journal config map. The config map is created if it does not exist.
+// The change returns false if the data is unchanged and does not need to be written.
+func (j *Journal) update(ctx context.Context, change func(data map[string]string) bool) error {
+	return retry.OnError(maxTries, isConcurrentModification, func() error {
+		configMap, err := j.configMapClient.Get(ctx, ConfigMapName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			configMap = newConfigMap()
+			if !change(configMap.Data) {
+				return nil
+			}
+
+			_, err = j.configMapClient.Create(ctx, configMap, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		if !change(configMap.Data) {
+			return nil
+		}
+
+		_, err = j.configMapClient.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// isConcurrentModification detects changes of the journal by the reconciliation of another component.
+func isConcurrentModification(err error) bool {
+	return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err)
+}
+
+func newConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ConfigMapName,
+			Labels: map[string]string{k8sv1.ComponentNameLabelKey: operatorName},
+		},
+		Data: map[string]string{},
+	}
+}
@@ -0,0 +1,237 @@
+package journal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+var testCtx = context.Background()
+
+var testEntry = Entry{
+	Operation:     OperationUpgrade,
+	Namespace:     "ecosystem",
+	TargetVersion: "1.2.0",
+	ChartDigest:   "sha256:abc",
+	StartedAt:     metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+	Revision:      3,
+}
+
+const testEntryYaml = `chartDigest: sha256:abc
+namespace: ecosystem
+operation: upgrade
+revision: 3
+startedAt: "2024-05-01T10:00:00Z"
+targetVersion: 1.2.0
+`
+
+var notFoundErr = k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, ConfigMapName)
+
+func TestJournal_Begin(t *testing.T) {
+	t.Run("should create journal config map", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(nil, notFoundErr)
+		configMapMock.EXPECT().Create(testCtx, mock.Anything, metav1.CreateOptions{}).RunAndReturn(func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.CreateOptions) (*corev1.ConfigMap, error) {
+			assert.Equal(t, ConfigMapName, configMap.Name)
+			assert.Equal(t, "k8s-component-operator", configMap.Labels[k8sv1.ComponentNameLabelKey])
+			assert.Equal(t, map[string]string{"dogu-op": testEntryYaml}, configMap.Data)
+			return configMap, nil
+		})
+
+		sut := New(configMapMock)
+
+		// when
+		err := sut.Begin(testCtx, "dogu-op", testEntry)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should add entry to journal config map", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName},
+			Data:       map[string]string{"k8s-etcd": "operation: install\n"},
+		}, nil)
+		configMapMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.UpdateOptions) (*corev1.ConfigMap, error) {
+			assert.Equal(t, map[string]string{"k8s-etcd": "operation: install\n", "dogu-op": testEntryYaml}, configMap.Data)
+			return configMap, nil
+		})
+
+		sut := New(configMapMock)
+
+		// when
+		err := sut.Begin(testCtx, "dogu-op", testEntry)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail to update journal config map", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName}}, nil)
+		configMapMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+
+		sut := New(configMapMock)
+
+		// when
+		err := sut.Begin(testCtx, "dogu-op", testEntry)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to record upgrade of component \"dogu-op\" in journal")
+	})
+}
+
+func TestJournal_Complete(t *testing.T) {
+	t.Run("should remove entry", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName},
+			Data:       map[string]string{"k8s-etcd": "operation: install\n", "dogu-op": testEntryYaml},
+		}, nil)
+		configMapMock.EXPECT().Update(testCtx, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(func(_ context.Context, configMap *corev1.ConfigMap, _ metav1.UpdateOptions) (*corev1.ConfigMap, error) {
+			assert.Equal(t, map[string]string{"k8s-etcd": "operation: install\n"}, configMap.Data)
+			return configMap, nil
+		})
+
+		sut := New(configMapMock)
+
+		// when
+		err := sut.Complete(testCtx, "dogu-op")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should not write journal without entry", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(nil, notFoundErr)
+
+		sut := New(configMapMock)
+
+		// when
+		err := sut.Complete(testCtx, "dogu-op")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail to get journal config map", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(nil, assert.AnError)
+
+		sut := New(configMapMock)
+
+		// when
+		err := sut.Complete(testCtx, "dogu-op")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to remove entry of component \"dogu-op\" from journal")
+	})
+}
+
+func TestJournal_Get(t *testing.T) {
+	t.Run("should return entry", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{
+			Data: map[string]string{"dogu-op": testEntryYaml},
+		}, nil)
+
+		sut := New(configMapMock)
+
+		// when
+		entry, err := sut.Get(testCtx, "dogu-op")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		assert.Equal(t, testEntry.Operation, entry.Operation)
+		assert.Equal(t, testEntry.TargetVersion, entry.TargetVersion)
+		assert.Equal(t, testEntry.ChartDigest, entry.ChartDigest)
+		assert.Equal(t, testEntry.Revision, entry.Revision)
+		assert.True(t, testEntry.StartedAt.Equal(&entry.StartedAt))
+	})
+
+	t.Run("should return nil without journal config map", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(nil, notFoundErr)
+
+		sut := New(configMapMock)
+
+		// when
+		entry, err := sut.Get(testCtx, "dogu-op")
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("should return nil without entry", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{}, nil)
+
+		sut := New(configMapMock)
+
+		// when
+		entry, err := sut.Get(testCtx, "dogu-op")
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("should fail on invalid entry", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(&corev1.ConfigMap{
+			Data: map[string]string{"dogu-op": "revision: [invalid"},
+		}, nil)
+
+		sut := New(configMapMock)
+
+		// when
+		_, err := sut.Get(testCtx, "dogu-op")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse journal entry of component \"dogu-op\"")
+	})
+
+	t.Run("should fail to get journal config map", func(t *testing.T) {
+		// given
+		configMapMock := newMockConfigMapInterface(t)
+		configMapMock.EXPECT().Get(testCtx, ConfigMapName, metav1.GetOptions{}).Return(nil, assert.AnError)
+
+		sut := New(configMapMock)
+
+		// when
+		_, err := sut.Get(testCtx, "dogu-op")
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
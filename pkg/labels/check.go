@@ -0,0 +1,75 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+
+	yamlutil "github.com/cloudogu/k8s-component-operator/pkg/yaml"
+)
+
+// podTemplateLabelPaths contains the paths to the labels of the pod templates of all kinds labeled by the PostRenderer.
+var podTemplateLabelPaths = map[string][]string{
+	deploymentKind:  {"spec", "template", "metadata", "labels"},
+	statefulSetKind: {"spec", "template", "metadata", "labels"},
+	daemonSetKind:   {"spec", "template", "metadata", "labels"},
+	jobKind:         {"spec", "template", "metadata", "labels"},
+	cronJobKind:     {"spec", "jobTemplate", "spec", "template", "metadata", "labels"},
+}
+
+// HasLabels checks if all resources of the rendered manifest and the pod templates of their workloads contain the
+// given labels just like the PostRenderer would add them.
+func HasLabels(manifest string, labels map[string]string) (bool, error) {
+	documentSplitter := yamlutil.NewDocumentSplitter().WithReader(bytes.NewBufferString(manifest))
+	decoder := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+
+	for documentSplitter.Next() {
+		documentBytes := documentSplitter.Bytes()
+		if len(documentBytes) == 0 {
+			continue
+		}
+
+		k8sObject := &unstructured.Unstructured{}
+		_, _, err := decoder.Decode(documentBytes, nil, k8sObject)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse yaml resources: %w", err)
+		}
+
+		if !containsLabels(k8sObject.GetLabels(), labels) {
+			return false, nil
+		}
+
+		kind := fmt.Sprintf("%s/%s", k8sObject.GetAPIVersion(), k8sObject.GetKind())
+		labelPath, isWorkload := podTemplateLabelPaths[kind]
+		if !isWorkload {
+			continue
+		}
+
+		templateLabels, _, err := unstructured.NestedStringMap(k8sObject.Object, labelPath...)
+		if err != nil {
+			return false, fmt.Errorf("failed to read pod template labels of %s %q: %w", k8sObject.GetKind(), k8sObject.GetName(), err)
+		}
+
+		if !containsLabels(templateLabels, labels) {
+			return false, nil
+		}
+	}
+
+	if err := documentSplitter.Err(); err != nil {
+		return false, fmt.Errorf("failed to split yaml document: %w", err)
+	}
+
+	return true, nil
+}
+
+func containsLabels(actual map[string]string, expected map[string]string) bool {
+	for key, value := range expected {
+		if actualValue, ok := actual[key]; !ok || actualValue != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasLabels(t *testing.T) {
+	testLabels := map[string]string{
+		"k8s.cloudogu.com/component.name":    "k8s-test",
+		"k8s.cloudogu.com/component.version": "1.2.3-4",
+	}
+	doguOpLabels := map[string]string{
+		"k8s.cloudogu.com/component.name":    "k8s-dogu-operator",
+		"k8s.cloudogu.com/component.version": "1.2.3-4",
+	}
+
+	tests := []struct {
+		name     string
+		manifest string
+		labels   map[string]string
+		want     bool
+	}{
+		{name: "should find labels on all resources", manifest: doguOpWithLabelsStr, labels: doguOpLabels, want: true},
+		{name: "should miss labels on unlabeled resources", manifest: string(doguOpBytes), labels: doguOpLabels, want: false},
+		{name: "should miss labels with other version", manifest: doguOpWithLabelsStr, labels: map[string]string{"k8s.cloudogu.com/component.version": "1.2.4-1"}, want: false},
+		{name: "should find labels on deployment and its pod template", manifest: deploymentWithLabelsString, labels: testLabels, want: true},
+		{name: "should miss labels on deployment pod template", manifest: string(deploymentBytes), labels: testLabels, want: false},
+		{name: "should miss labels on cron job pod template", manifest: string(cronJobBytes), labels: testLabels, want: false},
+		{name: "should accept empty manifest", manifest: "", labels: doguOpLabels, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got, err := HasLabels(tt.manifest, tt.labels)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("should fail to parse manifest", func(t *testing.T) {
+		// when
+		_, err := HasLabels("kind: [Deployment", doguOpLabels)
+
+		// then
+		require.Error(t, err)
+	})
+}
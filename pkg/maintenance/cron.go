@@ -0,0 +1,245 @@
+package maintenance
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronDuration limits the duration of cron periods so that checking whether a period is open stays cheap.
+const maxCronDuration = 7 * day
+
+// maxCronSearchDays limits the search for the next start of a cron period. Eight years include at least one leap year
+// for every day of week.
+const maxCronSearchDays = 8 * 366
+
+var months = []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// daysInMonth contains the maximum number of days of every month including leap years.
+var daysInMonth = []int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// cronPeriod starts at every minute matching a cron expression and lasts for a fixed duration.
+type cronPeriod struct {
+	minutes       []bool
+	hours         []bool
+	daysOfMonth   []bool
+	months        []bool
+	daysOfWeek    []bool
+	domRestricted bool
+	dowRestricted bool
+	duration      time.Duration
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	minuteField     = cronField{name: "minute", min: 0, max: 59}
+	hourField       = cronField{name: "hour", min: 0, max: 23}
+	dayOfMonthField = cronField{name: "day of month", min: 1, max: 31}
+	monthField      = cronField{name: "month", min: 1, max: 12, names: months}
+	// day of week allows 7 as an alias for Sunday
+	dayOfWeekField = cronField{name: "day of week", min: 0, max: 7, names: weekdays}
+)
+
+func parseCronPeriod(fields []string) (period, error) {
+	p := cronPeriod{
+		domRestricted: !strings.HasPrefix(fields[2], "*"),
+		dowRestricted: !strings.HasPrefix(fields[4], "*"),
+	}
+
+	var err error
+	if p.minutes, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if p.hours, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if p.daysOfMonth, err = dayOfMonthField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if p.months, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if p.daysOfWeek, err = dayOfWeekField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if p.daysOfWeek[7] {
+		p.daysOfWeek[0] = true
+	}
+
+	p.duration, err = time.ParseDuration(fields[5])
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %q: %w", fields[5], err)
+	}
+	if p.duration <= 0 || p.duration > maxCronDuration {
+		return nil, fmt.Errorf("duration %q must be positive and at most %s", fields[5], maxCronDuration)
+	}
+
+	if !p.hasValidDay() {
+		return nil, fmt.Errorf("cron expression %q never matches", strings.Join(fields[:5], " "))
+	}
+
+	return p, nil
+}
+
+func (f cronField) parse(raw string) ([]bool, error) {
+	values := make([]bool, f.max+1)
+	for _, item := range strings.Split(raw, ",") {
+		rawRange, rawStep, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(rawStep)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s %q", rawStep, f.name, raw)
+			}
+		}
+
+		from, to, err := f.parseRange(rawRange, hasStep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", f.name, raw, err)
+		}
+
+		for i := from; i <= to; i += step {
+			values[i] = true
+		}
+	}
+
+	return values, nil
+}
+
+func (f cronField) parseRange(raw string, hasStep bool) (int, int, error) {
+	if raw == "*" {
+		return f.min, f.max, nil
+	}
+
+	rawFrom, rawTo, isRange := strings.Cut(raw, "-")
+	from, err := f.parseValue(rawFrom)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	to := from
+	if isRange {
+		to, err = f.parseValue(rawTo)
+		if err != nil {
+			return 0, 0, err
+		}
+	} else if hasStep {
+		to = f.max
+	}
+
+	if from > to {
+		return 0, 0, fmt.Errorf("range %q ends before it starts", raw)
+	}
+
+	return from, to, nil
+}
+
+func (f cronField) parseValue(raw string) (int, error) {
+	if index := slices.Index(f.names, strings.ToLower(raw)); index >= 0 {
+		return index, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("value %q is not between %d and %d", raw, f.min, f.max)
+	}
+
+	return value, nil
+}
+
+// hasValidDay returns true if the combination of day of month and month matches at least one existing date.
+func (p cronPeriod) hasValidDay() bool {
+	if p.dowRestricted || !p.domRestricted {
+		return true
+	}
+
+	for month := 1; month <= 12; month++ {
+		if p.months[month] && slices.Contains(p.daysOfMonth[1:daysInMonth[month]+1], true) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesDay follows the cron semantics: if both day of month and day of week are restricted, a day matches if
+// either of them matches.
+func (p cronPeriod) matchesDay(t time.Time) bool {
+	if !p.months[t.Month()] {
+		return false
+	}
+
+	domMatches := p.daysOfMonth[t.Day()]
+	dowMatches := p.daysOfWeek[t.Weekday()]
+	switch {
+	case p.domRestricted && p.dowRestricted:
+		return domMatches || dowMatches
+	case p.domRestricted:
+		return domMatches
+	case p.dowRestricted:
+		return dowMatches
+	default:
+		return true
+	}
+}
+
+// startsOn returns all starts of the period on the day beginning at the given midnight in ascending order.
+func (p cronPeriod) startsOn(midnight time.Time) []time.Time {
+	if !p.matchesDay(midnight) {
+		return nil
+	}
+
+	var starts []time.Time
+	for hour, hourMatches := range p.hours {
+		for minute, minuteMatches := range p.minutes {
+			if hourMatches && minuteMatches {
+				starts = append(starts, midnight.Add(time.Duration(hour)*time.Hour+time.Duration(minute)*time.Minute))
+			}
+		}
+	}
+
+	return starts
+}
+
+func (p cronPeriod) contains(t time.Time) bool {
+	t = t.UTC()
+	earliest := t.Add(-p.duration)
+	for midnight := truncateToDay(earliest); !midnight.After(t); midnight = midnight.AddDate(0, 0, 1) {
+		for _, start := range p.startsOn(midnight) {
+			if start.After(earliest) && !start.After(t) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p cronPeriod) nextStart(t time.Time) time.Time {
+	t = t.UTC()
+	midnight := truncateToDay(t)
+	for i := 0; i <= maxCronSearchDays; i++ {
+		for _, start := range p.startsOn(midnight.AddDate(0, 0, i)) {
+			if start.After(t) {
+				return start
+			}
+		}
+	}
+
+	// not reachable because parseCronPeriod rejects expressions which never match
+	return time.Time{}
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
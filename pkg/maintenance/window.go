@@ -0,0 +1,250 @@
+// Package maintenance implements maintenance windows which restrict when disruptive operations on components may run.
+package maintenance
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+)
+
+const day = 24 * time.Hour
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Window is a set of recurring periods in UTC, e.g. "Mon-Fri 22:00-02:00; Sat,Sun 00:00-24:00".
+//
+// Periods are separated by semicolons. A period is given either in weekday/time-range form or in cron-like form.
+//
+// The weekday/time-range form consists of the weekdays the period starts on and a time range. Weekdays are given as a
+// single day (Mon), a list (Sat,Sun), a range (Mon-Fri) or "*" for every day. A time range which ends before it starts
+// continues on the next day.
+//
+// The cron-like form consists of a cron expression with the five fields minute, hour, day of month, month and day of
+// week followed by the duration of the period, e.g. "0 22 * * Mon-Fri 4h".
+//
+// An empty window is always open.
+type Window struct {
+	raw     string
+	periods []period
+}
+
+type period interface {
+	// contains returns true if the given time lies within the period.
+	contains(t time.Time) bool
+	// nextStart returns the first start of the period after the given time.
+	nextStart(t time.Time) time.Time
+}
+
+type weeklyPeriod struct {
+	days  [7]bool
+	start time.Duration
+	end   time.Duration
+}
+
+// ParseWindow parses the given raw string into a maintenance window.
+func ParseWindow(raw string) (Window, error) {
+	window := Window{raw: raw}
+	for _, rawPeriod := range strings.Split(raw, ";") {
+		if strings.TrimSpace(rawPeriod) == "" {
+			continue
+		}
+
+		p, err := parsePeriod(rawPeriod)
+		if err != nil {
+			return Window{}, fmt.Errorf("failed to parse maintenance window %q: %w", raw, err)
+		}
+		window.periods = append(window.periods, p)
+	}
+
+	return window, nil
+}
+
+// GetWindow returns the maintenance window of the component given by the MaintenanceWindowAnnotation or the default
+// window if the component has no such annotation.
+func GetWindow(component *k8sv1.Component, defaultWindow Window) (Window, error) {
+	raw, ok := component.Annotations[annotations.MaintenanceWindowAnnotation]
+	if !ok {
+		return defaultWindow, nil
+	}
+
+	return ParseWindow(raw)
+}
+
+// IsAlwaysOpen returns true if the window does not restrict operations at all.
+func (w Window) IsAlwaysOpen() bool {
+	return len(w.periods) == 0
+}
+
+// IsOpen returns true if the given time lies within the window.
+func (w Window) IsOpen(t time.Time) bool {
+	if w.IsAlwaysOpen() {
+		return true
+	}
+
+	return slices.ContainsFunc(w.periods, func(p period) bool {
+		return p.contains(t)
+	})
+}
+
+// NextStart returns the given time if the window is open or the start of the next period otherwise.
+func (w Window) NextStart(t time.Time) time.Time {
+	if w.IsOpen(t) {
+		return t
+	}
+
+	var next time.Time
+	for _, p := range w.periods {
+		start := p.nextStart(t)
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+
+	return next
+}
+
+// String returns the window as it was parsed.
+func (w Window) String() string {
+	return w.raw
+}
+
+func (p weeklyPeriod) contains(t time.Time) bool {
+	t = t.UTC()
+	offset := sinceMidnight(t)
+	weekday := t.Weekday()
+
+	if p.start < p.end {
+		return p.days[weekday] && offset >= p.start && offset < p.end
+	}
+
+	// the period continues on the next day
+	previousWeekday := (weekday + 6) % 7
+	return (p.days[weekday] && offset >= p.start) || (p.days[previousWeekday] && offset < p.end)
+}
+
+func (p weeklyPeriod) nextStart(t time.Time) time.Time {
+	t = t.UTC()
+	midnight := t.Add(-sinceMidnight(t))
+	for i := 0; i <= 7; i++ {
+		start := midnight.Add(time.Duration(i)*day + p.start)
+		if start.After(t) && p.days[start.Weekday()] {
+			return start
+		}
+	}
+
+	// not reachable because every period has at least one weekday
+	return time.Time{}
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+}
+
+func parsePeriod(raw string) (period, error) {
+	fields := strings.Fields(raw)
+	switch len(fields) {
+	case 2:
+		return parseWeeklyPeriod(fields)
+	case 6:
+		return parseCronPeriod(fields)
+	default:
+		return nil, fmt.Errorf("period %q must consist of weekdays and a time range or of a cron expression and a duration", strings.TrimSpace(raw))
+	}
+}
+
+func parseWeeklyPeriod(fields []string) (period, error) {
+	days, err := parseWeekdays(fields[0])
+	if err != nil {
+		return nil, err
+	}
+
+	rawStart, rawEnd, found := strings.Cut(fields[1], "-")
+	if !found {
+		return nil, fmt.Errorf("time range %q must have the form HH:MM-HH:MM", fields[1])
+	}
+
+	start, err := parseTimeOfDay(rawStart)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseTimeOfDay(rawEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if start == end || start == day {
+		return nil, fmt.Errorf("time range %q is empty", fields[1])
+	}
+
+	return weeklyPeriod{days: days, start: start, end: end}, nil
+}
+
+func parseWeekdays(raw string) ([7]bool, error) {
+	var days [7]bool
+	if raw == "*" {
+		for i := range days {
+			days[i] = true
+		}
+		return days, nil
+	}
+
+	for _, item := range strings.Split(raw, ",") {
+		rawFrom, rawTo, isRange := strings.Cut(item, "-")
+		from, err := parseWeekday(rawFrom)
+		if err != nil {
+			return days, err
+		}
+
+		to := from
+		if isRange {
+			to, err = parseWeekday(rawTo)
+			if err != nil {
+				return days, err
+			}
+		}
+
+		for i := from; ; i = (i + 1) % 7 {
+			days[i] = true
+			if i == to {
+				break
+			}
+		}
+	}
+
+	return days, nil
+}
+
+func parseWeekday(raw string) (int, error) {
+	index := slices.Index(weekdays, strings.ToLower(raw))
+	if index < 0 {
+		return 0, fmt.Errorf("unknown weekday %q", raw)
+	}
+
+	return index, nil
+}
+
+func parseTimeOfDay(raw string) (time.Duration, error) {
+	rawHours, rawMinutes, found := strings.Cut(raw, ":")
+	if !found {
+		return 0, fmt.Errorf("time %q must have the form HH:MM", raw)
+	}
+
+	hours, err := strconv.Atoi(rawHours)
+	if err != nil || hours < 0 || hours > 24 {
+		return 0, fmt.Errorf("invalid hours in time %q", raw)
+	}
+
+	minutes, err := strconv.Atoi(rawMinutes)
+	if err != nil || minutes < 0 || minutes > 59 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("invalid minutes in time %q", raw)
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
@@ -0,0 +1,176 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// 2026-10-16 is a Friday.
+var friday = time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+func at(weekdayOffset int, hour int, minute int) time.Time {
+	return friday.AddDate(0, 0, weekdayOffset).Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "empty window", raw: ""},
+		{name: "single period", raw: "Sat 02:00-04:00"},
+		{name: "multiple periods", raw: "Mon-Fri 22:00-02:00; Sat,Sun 00:00-24:00"},
+		{name: "every day", raw: "* 03:00-04:00"},
+		{name: "missing time range", raw: "Sat", wantErr: "period \"Sat\" must consist of weekdays and a time range or of a cron expression and a duration"},
+		{name: "unknown weekday", raw: "Someday 02:00-04:00", wantErr: "unknown weekday \"Someday\""},
+		{name: "invalid time range", raw: "Sat 02:00", wantErr: "time range \"02:00\" must have the form HH:MM-HH:MM"},
+		{name: "invalid time", raw: "Sat 2-04:00", wantErr: "time \"2\" must have the form HH:MM"},
+		{name: "invalid hours", raw: "Sat 25:00-04:00", wantErr: "invalid hours in time \"25:00\""},
+		{name: "invalid minutes", raw: "Sat 02:60-04:00", wantErr: "invalid minutes in time \"02:60\""},
+		{name: "empty time range", raw: "Sat 02:00-02:00", wantErr: "time range \"02:00-02:00\" is empty"},
+		{name: "cron period", raw: "0 22 * * Mon-Fri 4h"},
+		{name: "cron period with lists and steps", raw: "0,30 */6 1-15 jan-jun,12 * 30m"},
+		{name: "mixed periods", raw: "0 22 * * 1-5 4h; Sat,Sun 00:00-24:00"},
+		{name: "invalid cron minute", raw: "60 22 * * * 4h", wantErr: "invalid minute \"60\": value \"60\" is not between 0 and 59"},
+		{name: "invalid cron step", raw: "*/0 22 * * * 4h", wantErr: "invalid step \"0\" in minute \"*/0\""},
+		{name: "invalid cron range", raw: "0 22 * * Fri-Mon 4h", wantErr: "range \"Fri-Mon\" ends before it starts"},
+		{name: "invalid cron duration", raw: "0 22 * * * forever", wantErr: "invalid duration \"forever\""},
+		{name: "too long cron duration", raw: "0 22 * * * 200h", wantErr: "duration \"200h\" must be positive and at most 168h0m0s"},
+		{name: "cron expression never matches", raw: "0 0 30 feb * 1h", wantErr: "cron expression \"0 0 30 feb *\" never matches"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			window, err := ParseWindow(tt.raw)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.raw, window.String())
+		})
+	}
+}
+
+func TestWindow_IsOpen(t *testing.T) {
+	tests := []struct {
+		name   string
+		window string
+		time   time.Time
+		want   bool
+	}{
+		{name: "empty window is always open", window: "", time: at(0, 12, 0), want: true},
+		{name: "within period", window: "Fri 02:00-04:00", time: at(0, 3, 0), want: true},
+		{name: "start is included", window: "Fri 02:00-04:00", time: at(0, 2, 0), want: true},
+		{name: "end is excluded", window: "Fri 02:00-04:00", time: at(0, 4, 0), want: false},
+		{name: "other weekday", window: "Sat 02:00-04:00", time: at(0, 3, 0), want: false},
+		{name: "weekday range", window: "Mon-Fri 02:00-04:00", time: at(0, 3, 0), want: true},
+		{name: "weekday range across the weekend", window: "Sat-Mon 02:00-04:00", time: at(2, 3, 0), want: true},
+		{name: "period continues on the next day", window: "Fri 22:00-02:00", time: at(1, 1, 0), want: true},
+		{name: "period starting on the previous day has ended", window: "Fri 22:00-02:00", time: at(1, 2, 0), want: false},
+		{name: "whole day", window: "Sat,Sun 00:00-24:00", time: at(2, 23, 59), want: true},
+		{name: "time in other location", window: "Fri 02:00-04:00", time: at(0, 3, 0).In(time.FixedZone("UTC+5", 5*60*60)), want: true},
+		{name: "within cron period", window: "0 22 * * Mon-Fri 4h", time: at(0, 23, 0), want: true},
+		{name: "cron period continues on the next day", window: "0 22 * * Mon-Fri 4h", time: at(1, 1, 59), want: true},
+		{name: "cron period has ended", window: "0 22 * * Mon-Fri 4h", time: at(1, 2, 0), want: false},
+		{name: "cron period on other weekday", window: "0 22 * * Mon-Fri 4h", time: at(1, 23, 0), want: false},
+		{name: "cron period with Sunday as 7", window: "0 2 * * 7 1h", time: at(2, 2, 30), want: true},
+		{name: "cron period on day of month", window: "0 2 16 * * 1h", time: at(0, 2, 30), want: true},
+		{name: "cron period in other month", window: "0 2 * 11 * 1h", time: at(0, 2, 30), want: false},
+		{name: "cron period matches day of month or day of week", window: "0 2 1 * Fri 1h", time: at(0, 2, 30), want: true},
+		{name: "multiple cron starts", window: "*/15 3 * * * 5m", time: at(0, 3, 32), want: true},
+		{name: "between multiple cron starts", window: "*/15 3 * * * 5m", time: at(0, 3, 40), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			window, err := ParseWindow(tt.window)
+			require.NoError(t, err)
+
+			// when
+			got := window.IsOpen(tt.time)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWindow_NextStart(t *testing.T) {
+	tests := []struct {
+		name   string
+		window string
+		time   time.Time
+		want   time.Time
+	}{
+		{name: "open window", window: "Fri 02:00-04:00", time: at(0, 3, 0), want: at(0, 3, 0)},
+		{name: "later the same day", window: "Fri 02:00-04:00", time: at(0, 1, 0), want: at(0, 2, 0)},
+		{name: "next week", window: "Fri 02:00-04:00", time: at(0, 5, 0), want: at(7, 2, 0)},
+		{name: "earliest of multiple periods", window: "Mon 02:00-04:00; Sun 23:00-01:00", time: at(0, 5, 0), want: at(2, 23, 0)},
+		{name: "cron later the same day", window: "0 22 * * Mon-Fri 4h", time: at(0, 12, 0), want: at(0, 22, 0)},
+		{name: "cron after the weekend", window: "0 22 * * Mon-Fri 4h", time: at(1, 12, 0), want: at(3, 22, 0)},
+		{name: "cron next month", window: "30 1 1 * * 1h", time: at(0, 12, 0), want: time.Date(2026, 11, 1, 1, 30, 0, 0, time.UTC)},
+		{name: "cron leap day", window: "0 0 29 2 * 1h", time: at(0, 12, 0), want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			window, err := ParseWindow(tt.window)
+			require.NoError(t, err)
+
+			// when
+			got := window.NextStart(tt.time)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetWindow(t *testing.T) {
+	defaultWindow, err := ParseWindow("Sat 02:00-04:00")
+	require.NoError(t, err)
+
+	t.Run("should return default window", func(t *testing.T) {
+		// when
+		window, err := GetWindow(&k8sv1.Component{}, defaultWindow)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, defaultWindow, window)
+	})
+
+	t.Run("should return window of annotation", func(t *testing.T) {
+		// given
+		component := &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotations.MaintenanceWindowAnnotation: "Sun 02:00-04:00"}}}
+
+		// when
+		window, err := GetWindow(component, defaultWindow)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Sun 02:00-04:00", window.String())
+	})
+
+	t.Run("should fail to parse window of annotation", func(t *testing.T) {
+		// given
+		component := &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotations.MaintenanceWindowAnnotation: "invalid"}}}
+
+		// when
+		_, err := GetWindow(component, defaultWindow)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse maintenance window \"invalid\"")
+	})
+}
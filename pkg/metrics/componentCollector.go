@@ -0,0 +1,106 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+const (
+	listTimeout   = 10 * time.Second
+	pendingHealth = "pending"
+)
+
+var (
+	componentHealthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, "", "component_health"),
+		"Health of the component. The value is 1 for the current health.",
+		[]string{"component", "health"}, nil,
+	)
+	componentStatusDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, "", "component_status"),
+		"Status of the component. The value is 1 for the current status.",
+		[]string{"component", "status"}, nil,
+	)
+	componentVersionDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, "", "component_version_info"),
+		"Installed and desired version of the component. The value is always 1.",
+		[]string{"component", "installed_version", "desired_version"}, nil,
+	)
+	componentRequeueBackoffDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, "", "component_requeue_backoff_seconds"),
+		"Current requeue time of the failed operation of the component. The value is 0 if no operation waits to be retried.",
+		[]string{"component"}, nil,
+	)
+)
+
+// componentLister lists components, usually from the cache of the manager.
+type componentLister interface {
+	List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error
+}
+
+// componentCollector collects the state of all components in the namespace of the operator on every scrape.
+type componentCollector struct {
+	lister    componentLister
+	namespace string
+}
+
+// RegisterComponentCollector registers a collector for the health, status, versions and requeue backoff of all
+// components in the given namespace. A previously registered collector is replaced.
+func RegisterComponentCollector(lister componentLister, namespace string) error {
+	collector := &componentCollector{lister: lister, namespace: namespace}
+
+	err := metrics.Registry.Register(collector)
+	var alreadyRegisteredErr prometheus.AlreadyRegisteredError
+	if errors.As(err, &alreadyRegisteredErr) {
+		metrics.Registry.Unregister(alreadyRegisteredErr.ExistingCollector)
+		err = metrics.Registry.Register(collector)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to register component metrics: %w", err)
+	}
+
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (cc *componentCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- componentHealthDesc
+	ch <- componentStatusDesc
+	ch <- componentVersionDesc
+	ch <- componentRequeueBackoffDesc
+}
+
+// Collect implements prometheus.Collector.
+func (cc *componentCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+
+	list := &v1.ComponentList{}
+	err := cc.lister.List(ctx, list, client.InNamespace(cc.namespace))
+	if err != nil {
+		err = fmt.Errorf("failed to list components: %w", err)
+		ch <- prometheus.NewInvalidMetric(componentHealthDesc, err)
+		return
+	}
+
+	for _, component := range list.Items {
+		name := component.Spec.Name
+
+		health := string(component.Status.Health)
+		if health == "" {
+			health = pendingHealth
+		}
+		ch <- prometheus.MustNewConstMetric(componentHealthDesc, prometheus.GaugeValue, 1, name, health)
+		ch <- prometheus.MustNewConstMetric(componentStatusDesc, prometheus.GaugeValue, 1, name, component.Status.Status)
+		ch <- prometheus.MustNewConstMetric(componentVersionDesc, prometheus.GaugeValue, 1, name, component.Status.InstalledVersion, component.Spec.Version)
+		ch <- prometheus.MustNewConstMetric(componentRequeueBackoffDesc, prometheus.GaugeValue, component.Status.RequeueTimeNanos.Seconds(), name)
+	}
+}
@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	v1 "github.com/cloudogu/k8s-component-lib/api/v1"
+)
+
+const testNamespace = "ecosystem"
+
+func TestComponentCollector_Collect(t *testing.T) {
+	t.Run("should collect state of all components", func(t *testing.T) {
+		// given
+		listerMock := newMockComponentLister(t)
+		listerMock.EXPECT().List(mock.Anything, mock.Anything, client.InNamespace(testNamespace)).
+			RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				list.(*v1.ComponentList).Items = []v1.Component{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "k8s-dogu-operator"},
+						Spec:       v1.ComponentSpec{Name: "k8s-dogu-operator", Version: "3.2.0"},
+						Status:     v1.ComponentStatus{Status: v1.ComponentStatusInstalled, Health: v1.AvailableHealthStatus, InstalledVersion: "3.1.0"},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "k8s-longhorn"},
+						Spec:       v1.ComponentSpec{Name: "k8s-longhorn"},
+						Status:     v1.ComponentStatus{Status: v1.ComponentStatusTryToInstall, RequeueTimeNanos: 10 * time.Second},
+					},
+				}
+				return nil
+			})
+		sut := &componentCollector{lister: listerMock, namespace: testNamespace}
+
+		expected := `
+# HELP k8s_component_operator_component_health Health of the component. The value is 1 for the current health.
+# TYPE k8s_component_operator_component_health gauge
+k8s_component_operator_component_health{component="k8s-dogu-operator",health="available"} 1
+k8s_component_operator_component_health{component="k8s-longhorn",health="pending"} 1
+# HELP k8s_component_operator_component_requeue_backoff_seconds Current requeue time of the failed operation of the component. The value is 0 if no operation waits to be retried.
+# TYPE k8s_component_operator_component_requeue_backoff_seconds gauge
+k8s_component_operator_component_requeue_backoff_seconds{component="k8s-dogu-operator"} 0
+k8s_component_operator_component_requeue_backoff_seconds{component="k8s-longhorn"} 10
+# HELP k8s_component_operator_component_status Status of the component. The value is 1 for the current status.
+# TYPE k8s_component_operator_component_status gauge
+k8s_component_operator_component_status{component="k8s-dogu-operator",status="installed"} 1
+k8s_component_operator_component_status{component="k8s-longhorn",status="tryToInstall"} 1
+# HELP k8s_component_operator_component_version_info Installed and desired version of the component. The value is always 1.
+# TYPE k8s_component_operator_component_version_info gauge
+k8s_component_operator_component_version_info{component="k8s-dogu-operator",desired_version="3.2.0",installed_version="3.1.0"} 1
+k8s_component_operator_component_version_info{component="k8s-longhorn",desired_version="",installed_version=""} 1
+`
+
+		// when
+		err := testutil.CollectAndCompare(sut, strings.NewReader(expected))
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should report error if components cannot be listed", func(t *testing.T) {
+		// given
+		listerMock := newMockComponentLister(t)
+		listerMock.EXPECT().List(mock.Anything, mock.Anything, client.InNamespace(testNamespace)).Return(assert.AnError)
+		sut := &componentCollector{lister: listerMock, namespace: testNamespace}
+
+		// when
+		_, err := testutil.CollectAndLint(sut)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to list components")
+	})
+}
+
+func TestRegisterComponentCollector(t *testing.T) {
+	// when
+	err := RegisterComponentCollector(newMockComponentLister(t), testNamespace)
+	require.NoError(t, err)
+	err = RegisterComponentCollector(newMockComponentLister(t), testNamespace)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, metrics.Registry.Unregister(&componentCollector{}))
+}
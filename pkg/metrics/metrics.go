@@ -0,0 +1,117 @@
+// Package metrics contains the Prometheus metrics of the component operator. All metrics are registered on the
+// metrics registry of the controller-runtime and exposed by the metrics endpoint of the manager.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricNamespace = "k8s_component_operator"
+
+const (
+	// OutcomeSuccess labels operations and registry requests which succeeded.
+	OutcomeSuccess = "success"
+	// OutcomeFailure labels operations and registry requests which failed.
+	OutcomeFailure = "failure"
+)
+
+const (
+	// RegistryRequestTags labels requests listing the tags of a chart.
+	RegistryRequestTags = "tags"
+	// RegistryRequestPull labels requests pulling a chart.
+	RegistryRequestPull = "pull"
+)
+
+var (
+	operationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricNamespace,
+		Name:      "operations_total",
+		Help:      "Number of finished operations on components by component, operation and outcome.",
+	}, []string{"component", "operation", "outcome"})
+
+	operationDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricNamespace,
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of operations on components by component, operation and outcome.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 900, 1800},
+	}, []string{"component", "operation", "outcome"})
+
+	dependencyCheckFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricNamespace,
+		Name:      "dependency_check_failures_total",
+		Help:      "Number of operations on components which failed because of unsatisfied dependencies.",
+	}, []string{"component"})
+
+	registryRequestDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricNamespace,
+		Name:      "helm_registry_request_duration_seconds",
+		Help:      "Latency of requests to the Helm registry by request type.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"request"})
+
+	registryRequestErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricNamespace,
+		Name:      "helm_registry_request_errors_total",
+		Help:      "Number of failed requests to the Helm registry by request type.",
+	}, []string{"request"})
+
+	registriesValid = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricNamespace,
+		Name:      "helm_registries_valid",
+		Help:      "Whether the Helm registries, their credentials, TLS data and proxy are valid and the registries are reachable (1) or not (0).",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		operationsTotal,
+		operationDurationSeconds,
+		dependencyCheckFailuresTotal,
+		registryRequestDurationSeconds,
+		registryRequestErrorsTotal,
+		registriesValid,
+	)
+}
+
+// ObserveOperation records an operation on the component which started at the given time and finished with the given
+// error.
+func ObserveOperation(component string, operation string, start time.Time, err error) {
+	outcome := outcomeOf(err)
+	operationsTotal.WithLabelValues(component, operation, outcome).Inc()
+	operationDurationSeconds.WithLabelValues(component, operation, outcome).Observe(time.Since(start).Seconds())
+}
+
+// IncDependencyCheckFailures records an operation on the component which failed because of unsatisfied dependencies.
+func IncDependencyCheckFailures(component string) {
+	dependencyCheckFailuresTotal.WithLabelValues(component).Inc()
+}
+
+// ObserveRegistryRequest records a request of the given type to the Helm registry which started at the given time and
+// finished with the given error.
+func ObserveRegistryRequest(request string, start time.Time, err error) {
+	registryRequestDurationSeconds.WithLabelValues(request).Observe(time.Since(start).Seconds())
+	if err != nil {
+		registryRequestErrorsTotal.WithLabelValues(request).Inc()
+	}
+}
+
+// SetRegistriesValid records whether the Helm registries were loaded and verified successfully.
+func SetRegistriesValid(valid bool) {
+	if valid {
+		registriesValid.Set(1)
+		return
+	}
+
+	registriesValid.Set(0)
+}
+
+func outcomeOf(err error) string {
+	if err != nil {
+		return OutcomeFailure
+	}
+
+	return OutcomeSuccess
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveOperation(t *testing.T) {
+	t.Run("should count successful operation", func(t *testing.T) {
+		// given
+		before := testutil.ToFloat64(operationsTotal.WithLabelValues("k8s-dogu-operator", "upgrade", OutcomeSuccess))
+
+		// when
+		ObserveOperation("k8s-dogu-operator", "upgrade", time.Now().Add(-time.Second), nil)
+
+		// then
+		assert.Equal(t, before+1, testutil.ToFloat64(operationsTotal.WithLabelValues("k8s-dogu-operator", "upgrade", OutcomeSuccess)))
+		assert.Equal(t, 1, testutil.CollectAndCount(operationDurationSeconds, metricNamespace+"_operation_duration_seconds"))
+	})
+	t.Run("should count failed operation", func(t *testing.T) {
+		// given
+		before := testutil.ToFloat64(operationsTotal.WithLabelValues("k8s-dogu-operator", "installation", OutcomeFailure))
+
+		// when
+		ObserveOperation("k8s-dogu-operator", "installation", time.Now(), assert.AnError)
+
+		// then
+		assert.Equal(t, before+1, testutil.ToFloat64(operationsTotal.WithLabelValues("k8s-dogu-operator", "installation", OutcomeFailure)))
+	})
+}
+
+func TestIncDependencyCheckFailures(t *testing.T) {
+	// given
+	before := testutil.ToFloat64(dependencyCheckFailuresTotal.WithLabelValues("k8s-dogu-operator"))
+
+	// when
+	IncDependencyCheckFailures("k8s-dogu-operator")
+
+	// then
+	assert.Equal(t, before+1, testutil.ToFloat64(dependencyCheckFailuresTotal.WithLabelValues("k8s-dogu-operator")))
+}
+
+func TestObserveRegistryRequest(t *testing.T) {
+	t.Run("should only observe latency of successful request", func(t *testing.T) {
+		// given
+		before := testutil.ToFloat64(registryRequestErrorsTotal.WithLabelValues(RegistryRequestTags))
+
+		// when
+		ObserveRegistryRequest(RegistryRequestTags, time.Now(), nil)
+
+		// then
+		assert.Equal(t, before, testutil.ToFloat64(registryRequestErrorsTotal.WithLabelValues(RegistryRequestTags)))
+	})
+	t.Run("should count failed request", func(t *testing.T) {
+		// given
+		before := testutil.ToFloat64(registryRequestErrorsTotal.WithLabelValues(RegistryRequestPull))
+
+		// when
+		ObserveRegistryRequest(RegistryRequestPull, time.Now(), assert.AnError)
+
+		// then
+		assert.Equal(t, before+1, testutil.ToFloat64(registryRequestErrorsTotal.WithLabelValues(RegistryRequestPull)))
+	})
+}
+
+func TestSetRegistriesValid(t *testing.T) {
+	t.Run("should set valid registries", func(t *testing.T) {
+		// when
+		SetRegistriesValid(true)
+
+		// then
+		assert.Equal(t, float64(1), testutil.ToFloat64(registriesValid))
+	})
+	t.Run("should set invalid registries", func(t *testing.T) {
+		// when
+		SetRegistriesValid(false)
+
+		// then
+		assert.Equal(t, float64(0), testutil.ToFloat64(registriesValid))
+	})
+}
@@ -0,0 +1,24 @@
+package registries
+
+import (
+	"context"
+
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+)
+
+type configMapInterface interface {
+	v1.ConfigMapInterface
+}
+
+type secretInterface interface {
+	v1.SecretInterface
+}
+
+type registriesReceiver interface {
+	// SetRegistries replaces the registries used to access charts.
+	SetRegistries(registries *config.HelmRegistries)
+	// VerifyRegistries checks that all registries are reachable and accept their credentials.
+	VerifyRegistries(ctx context.Context, registries *config.HelmRegistries) error
+}
@@ -0,0 +1,250 @@
+package registries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+	"github.com/cloudogu/k8s-component-operator/pkg/metrics"
+)
+
+// helmReleaseSecretType is the type of the secrets helm stores its releases in. These secrets are never watched.
+const helmReleaseSecretType = "helm.sh/release.v1"
+
+// resyncPeriod is the interval in which all watched objects are delivered again. The watcher ignores these
+// deliveries as long as the objects did not change.
+const resyncPeriod = 10 * time.Minute
+
+// retryPeriod is the interval in which invalid or unreachable registries are reloaded and verified again.
+const retryPeriod = time.Minute
+
+// Watcher watches the helm repository config map and the secrets and config maps referenced by it, i.e. credentials,
+// CA bundles, client certificates and the proxy. The registries are read again on every change and handed to the
+// receiver so that rotated credentials, certificates and proxy settings are used without a restart.
+type Watcher struct {
+	namespace       string
+	clientSet       kubernetes.Interface
+	configMapClient configMapInterface
+	secretClient    secretInterface
+	receiver        registriesReceiver
+	reloadRequests  chan struct{}
+	retryPeriod     time.Duration
+
+	mutex          sync.RWMutex
+	secretNames    []string
+	configMapNames []string
+	lastErr        error
+}
+
+// NewWatcher creates a new Watcher for the registries configured in the given namespace.
+func NewWatcher(namespace string, clientSet kubernetes.Interface, receiver registriesReceiver) *Watcher {
+	return &Watcher{
+		namespace:       namespace,
+		clientSet:       clientSet,
+		configMapClient: clientSet.CoreV1().ConfigMaps(namespace),
+		secretClient:    clientSet.CoreV1().Secrets(namespace),
+		receiver:        receiver,
+		reloadRequests:  make(chan struct{}, 1),
+		retryPeriod:     retryPeriod,
+	}
+}
+
+// Reload reads the registries with their credentials, TLS data and proxy, hands them to the receiver and verifies them
+// against the registries. An error is only returned if the registries cannot be read at all. The registries are still
+// handed over if credentials, TLS data or the proxy are invalid or the registries reject them; these errors are only
+// logged and reported by the metric of valid registries. They do not affect the readiness of the operator, so that the
+// webhooks keep serving requests while a registry is not available.
+func (w *Watcher) Reload(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	registries, err := config.GetHelmRegistries(ctx, w.configMapClient)
+	if err != nil {
+		w.setLastErr(err)
+		return err
+	}
+
+	credentialsErr := registries.ResolveCredentials(ctx, w.secretClient)
+	if credentialsErr != nil {
+		logger.Error(credentialsErr, "failed to resolve credentials of helm registries")
+	}
+
+	tlsErr := registries.ResolveTLS(ctx, w.configMapClient, w.secretClient)
+	if tlsErr != nil {
+		logger.Error(tlsErr, "failed to resolve TLS configuration of helm registries")
+	}
+
+	proxyErr := registries.ResolveProxy(ctx, w.secretClient)
+	if proxyErr != nil {
+		logger.Error(proxyErr, "failed to resolve proxy of helm registries")
+	}
+
+	w.mutex.Lock()
+	w.secretNames = registries.ReferencedSecrets()
+	w.configMapNames = registries.ReferencedConfigMaps()
+	w.mutex.Unlock()
+
+	w.receiver.SetRegistries(registries)
+
+	verifyErr := w.receiver.VerifyRegistries(ctx, registries)
+	if verifyErr != nil {
+		logger.Error(verifyErr, "failed to verify helm registries")
+	}
+
+	w.setLastErr(errors.Join(credentialsErr, tlsErr, proxyErr, verifyErr))
+	return nil
+}
+
+// NeedLeaderElection lets the watcher run on every replica as all of them create helm clients, e.g. for webhooks.
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start watches the helm repository config map and the referenced secrets and config maps until the context is done.
+// Invalid or unreachable registries are reloaded periodically so that they recover without a change.
+func (w *Watcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).
+		WithName("helm registry watcher")
+	logger.Info(fmt.Sprintf("started watching config map %s and the secrets and config maps referenced by it", config.HelmRepositoryConfigMapName))
+
+	configMapInformer := coreinformers.NewConfigMapInformer(w.clientSet, w.namespace, resyncPeriod, cache.Indexers{})
+	secretInformer := coreinformers.NewFilteredSecretInformer(w.clientSet, w.namespace, resyncPeriod, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermNotEqualSelector("type", helmReleaseSecretType).String()
+	})
+
+	_, err := configMapInformer.AddEventHandler(w.eventHandler(w.isWatchedConfigMap))
+	if err != nil {
+		return fmt.Errorf("failed to watch config maps: %w", err)
+	}
+	_, err = secretInformer.AddEventHandler(w.eventHandler(w.isReferencedSecret))
+	if err != nil {
+		return fmt.Errorf("failed to watch secrets: %w", err)
+	}
+
+	go configMapInformer.Run(ctx.Done())
+	go secretInformer.Run(ctx.Done())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.reloadRequests:
+			logger.Info("helm registries changed, reloading...")
+			w.reload(ctx)
+		case <-w.retryTimer():
+			logger.Info("helm registries are invalid, reloading...")
+			w.reload(ctx)
+		}
+	}
+}
+
+func (w *Watcher) reload(ctx context.Context) {
+	err := w.Reload(ctx)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to reload helm registries, keeping the previous registries")
+	}
+}
+
+// retryTimer returns a channel firing after the retry period if the last reload failed. Otherwise, it returns nil so
+// that no retry takes place.
+func (w *Watcher) retryTimer() <-chan time.Time {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	if w.lastErr == nil {
+		return nil
+	}
+
+	return time.After(w.retryPeriod)
+}
+
+func (w *Watcher) lastError() error {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return w.lastErr
+}
+
+// eventHandler requests a reload for every added, changed or deleted object matching the filter.
+func (w *Watcher) eventHandler(filter func(obj interface{}) bool) cache.ResourceEventHandler {
+	return cache.FilteringResourceEventHandler{
+		FilterFunc: filter,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(interface{}) { w.requestReload() },
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				if !isResync(oldObj, newObj) {
+					w.requestReload()
+				}
+			},
+			DeleteFunc: func(interface{}) { w.requestReload() },
+		},
+	}
+}
+
+// requestReload requests a reload without blocking. Requests arriving while a reload is pending are merged into it.
+func (w *Watcher) requestReload() {
+	select {
+	case w.reloadRequests <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) isWatchedConfigMap(obj interface{}) bool {
+	name := objectName(obj)
+	if name == config.HelmRepositoryConfigMapName {
+		return true
+	}
+
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return slices.Contains(w.configMapNames, name)
+}
+
+func (w *Watcher) isReferencedSecret(obj interface{}) bool {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return slices.Contains(w.secretNames, objectName(obj))
+}
+
+func (w *Watcher) setLastErr(err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.lastErr = err
+	metrics.SetRegistriesValid(err == nil)
+}
+
+func objectName(obj interface{}) string {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	switch object := obj.(type) {
+	case *v1.ConfigMap:
+		return object.Name
+	case *v1.Secret:
+		return object.Name
+	default:
+		return ""
+	}
+}
+
+// isResync checks whether an update is only a periodic delivery of an unchanged object.
+func isResync(oldObj interface{}, newObj interface{}) bool {
+	oldMeta, oldOk := oldObj.(metav1.Object)
+	newMeta, newOk := newObj.(metav1.Object)
+	return oldOk && newOk && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion()
+}
@@ -0,0 +1,370 @@
+package registries
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/config"
+)
+
+const testNamespace = "ecosystem"
+
+var testCtx = context.Background()
+
+func newRepositoryConfigMap() *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: config.HelmRepositoryConfigMapName, Namespace: testNamespace},
+		Data:       map[string]string{"endpoint": "registry.example.com", "schema": "oci", "credentialsSecret": "registry-credentials"},
+	}
+}
+
+func newCredentialsSecret(password string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: testNamespace},
+		Type:       v1.SecretTypeBasicAuth,
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte(password)},
+	}
+}
+
+// expectNoProxySecret lets the secret client report that the default proxy secret does not exist.
+func expectNoProxySecret(secretClient *mockSecretInterface) {
+	secretClient.EXPECT().Get(testCtx, config.DefaultProxySecretName, metav1.GetOptions{}).
+		Return(nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, config.DefaultProxySecretName))
+}
+
+func newTestWatcher(t *testing.T, configMapClient configMapInterface, secretClient secretInterface, receiver registriesReceiver) *Watcher {
+	t.Setenv("RUNTIME", "")
+	return &Watcher{
+		namespace:       testNamespace,
+		configMapClient: configMapClient,
+		secretClient:    secretClient,
+		receiver:        receiver,
+		reloadRequests:  make(chan struct{}, 1),
+		retryPeriod:     retryPeriod,
+	}
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	t.Run("should hand registries with credentials to receiver", func(t *testing.T) {
+		// given
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, config.HelmRepositoryConfigMapName, metav1.GetOptions{}).Return(newRepositoryConfigMap(), nil)
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-credentials", metav1.GetOptions{}).Return(newCredentialsSecret("secret"), nil)
+		expectNoProxySecret(secretClient)
+		receiver := newMockRegistriesReceiver(t)
+		receiver.EXPECT().SetRegistries(mock.MatchedBy(func(registries *config.HelmRegistries) bool {
+			return registries.Registries[0].Username == "user" && registries.Registries[0].Password == "secret"
+		})).Return()
+		receiver.EXPECT().VerifyRegistries(testCtx, mock.Anything).Return(nil)
+
+		sut := newTestWatcher(t, configMapClient, secretClient, receiver)
+
+		// when
+		err := sut.Reload(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"registry-credentials", "ces-proxy"}, sut.secretNames)
+		assert.NoError(t, sut.lastError())
+	})
+
+	t.Run("should hand registries to receiver and report invalid credentials", func(t *testing.T) {
+		// given
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, config.HelmRepositoryConfigMapName, metav1.GetOptions{}).Return(newRepositoryConfigMap(), nil)
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-credentials", metav1.GetOptions{}).Return(nil, assert.AnError)
+		expectNoProxySecret(secretClient)
+		receiver := newMockRegistriesReceiver(t)
+		receiver.EXPECT().SetRegistries(mock.Anything).Return()
+		receiver.EXPECT().VerifyRegistries(testCtx, mock.Anything).Return(nil)
+
+		sut := newTestWatcher(t, configMapClient, secretClient, receiver)
+
+		// when
+		err := sut.Reload(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"registry-credentials", "ces-proxy"}, sut.secretNames)
+		lastErr := sut.lastError()
+		require.Error(t, lastErr)
+		assert.ErrorIs(t, lastErr, assert.AnError)
+		assert.ErrorContains(t, lastErr, "failed to get credentials secret registry-credentials of registry default")
+	})
+
+	t.Run("should hand registries to receiver and report missing CA bundle", func(t *testing.T) {
+		// given
+		repositoryConfigMap := newRepositoryConfigMap()
+		repositoryConfigMap.Data = map[string]string{"endpoint": "registry.example.com", "schema": "oci", "caBundleConfigMap": "registry-ca"}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, config.HelmRepositoryConfigMapName, metav1.GetOptions{}).Return(repositoryConfigMap, nil)
+		configMapClient.EXPECT().Get(testCtx, "registry-ca", metav1.GetOptions{}).Return(nil, assert.AnError)
+		secretClient := newMockSecretInterface(t)
+		expectNoProxySecret(secretClient)
+		receiver := newMockRegistriesReceiver(t)
+		receiver.EXPECT().SetRegistries(mock.Anything).Return()
+		receiver.EXPECT().VerifyRegistries(testCtx, mock.Anything).Return(nil)
+
+		sut := newTestWatcher(t, configMapClient, secretClient, receiver)
+
+		// when
+		err := sut.Reload(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"registry-ca"}, sut.configMapNames)
+		lastErr := sut.lastError()
+		require.Error(t, lastErr)
+		assert.ErrorIs(t, lastErr, assert.AnError)
+		assert.ErrorContains(t, lastErr, "failed to get CA bundle config map registry-ca of registry default")
+	})
+
+	t.Run("should hand registries to receiver and report invalid proxy", func(t *testing.T) {
+		// given
+		repositoryConfigMap := newRepositoryConfigMap()
+		repositoryConfigMap.Data = map[string]string{"endpoint": "registry.example.com", "schema": "oci", "proxySecret": "registry-proxy"}
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, config.HelmRepositoryConfigMapName, metav1.GetOptions{}).Return(repositoryConfigMap, nil)
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-proxy", metav1.GetOptions{}).Return(&v1.Secret{Data: map[string][]byte{"url": []byte("proxy:3128")}}, nil)
+		receiver := newMockRegistriesReceiver(t)
+		receiver.EXPECT().SetRegistries(mock.MatchedBy(func(registries *config.HelmRegistries) bool {
+			return registries.Proxy == nil
+		})).Return()
+		receiver.EXPECT().VerifyRegistries(testCtx, mock.Anything).Return(nil)
+
+		sut := newTestWatcher(t, configMapClient, secretClient, receiver)
+
+		// when
+		err := sut.Reload(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"registry-proxy"}, sut.secretNames)
+		assert.ErrorContains(t, sut.lastError(), "proxy secret registry-proxy is invalid")
+	})
+
+	t.Run("should hand registries to receiver and report error if registry rejects credentials", func(t *testing.T) {
+		// given
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, config.HelmRepositoryConfigMapName, metav1.GetOptions{}).Return(newRepositoryConfigMap(), nil)
+		secretClient := newMockSecretInterface(t)
+		secretClient.EXPECT().Get(testCtx, "registry-credentials", metav1.GetOptions{}).Return(newCredentialsSecret("invalid"), nil)
+		expectNoProxySecret(secretClient)
+		receiver := newMockRegistriesReceiver(t)
+		receiver.EXPECT().SetRegistries(mock.Anything).Return()
+		receiver.EXPECT().VerifyRegistries(testCtx, mock.Anything).Return(assert.AnError)
+
+		sut := newTestWatcher(t, configMapClient, secretClient, receiver)
+
+		// when
+		err := sut.Reload(testCtx)
+
+		// then
+		require.NoError(t, err)
+		assert.ErrorIs(t, sut.lastError(), assert.AnError)
+	})
+
+	t.Run("should keep previous registries if config map cannot be read", func(t *testing.T) {
+		// given
+		configMapClient := newMockConfigMapInterface(t)
+		configMapClient.EXPECT().Get(testCtx, config.HelmRepositoryConfigMapName, metav1.GetOptions{}).Return(nil, assert.AnError)
+
+		sut := newTestWatcher(t, configMapClient, newMockSecretInterface(t), newMockRegistriesReceiver(t))
+		sut.secretNames = []string{"registry-credentials"}
+
+		// when
+		err := sut.Reload(testCtx)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, []string{"registry-credentials"}, sut.secretNames)
+		assert.ErrorIs(t, sut.lastError(), assert.AnError)
+	})
+}
+
+func TestWatcher_eventHandler(t *testing.T) {
+	sut := newTestWatcher(t, nil, nil, nil)
+	sut.secretNames = []string{"registry-credentials"}
+	sut.configMapNames = []string{"registry-ca"}
+
+	tests := []struct {
+		name        string
+		notify      func(handler cache.ResourceEventHandler)
+		wantRequest bool
+	}{
+		{
+			name: "should request reload for changed credentials secret",
+			notify: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(newVersion(newCredentialsSecret("old"), "1"), newVersion(newCredentialsSecret("new"), "2"))
+			},
+			wantRequest: true,
+		},
+		{
+			name: "should request reload for deleted credentials secret",
+			notify: func(h cache.ResourceEventHandler) {
+				h.OnDelete(cache.DeletedFinalStateUnknown{Obj: newCredentialsSecret("old")})
+			},
+			wantRequest: true,
+		},
+		{
+			name:        "should request reload for changed config map",
+			notify:      func(h cache.ResourceEventHandler) { h.OnAdd(newRepositoryConfigMap(), false) },
+			wantRequest: true,
+		},
+		{
+			name: "should request reload for changed CA bundle config map",
+			notify: func(h cache.ResourceEventHandler) {
+				h.OnAdd(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "registry-ca", Namespace: testNamespace}}, false)
+			},
+			wantRequest: true,
+		},
+		{
+			name: "should ignore unreferenced config map",
+			notify: func(h cache.ResourceEventHandler) {
+				h.OnAdd(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNamespace}}, false)
+			},
+		},
+		{
+			name: "should ignore resync of unchanged secret",
+			notify: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(newVersion(newCredentialsSecret("old"), "1"), newVersion(newCredentialsSecret("old"), "1"))
+			},
+		},
+		{
+			name: "should ignore unreferenced secret",
+			notify: func(h cache.ResourceEventHandler) {
+				h.OnAdd(&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNamespace}}, false)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			handler := sut.eventHandler(func(obj interface{}) bool {
+				return sut.isWatchedConfigMap(obj) || sut.isReferencedSecret(obj)
+			})
+
+			// when
+			tt.notify(handler)
+
+			// then
+			select {
+			case <-sut.reloadRequests:
+				assert.True(t, tt.wantRequest)
+			default:
+				assert.False(t, tt.wantRequest)
+			}
+		})
+	}
+}
+
+func TestWatcher_Start(t *testing.T) {
+	t.Run("should reload registries when credentials secret rotates", func(t *testing.T) {
+		// given
+		t.Setenv("RUNTIME", "")
+		clientSet := fake.NewSimpleClientset(newRepositoryConfigMap(), newCredentialsSecret("secret"))
+		receiver := &recordingReceiver{registries: make(chan *config.HelmRegistries, 10)}
+
+		sut := NewWatcher(testNamespace, clientSet, receiver)
+		require.NoError(t, sut.Reload(testCtx))
+		require.Equal(t, "secret", (<-receiver.registries).Registries[0].Password)
+
+		ctx, cancel := context.WithCancel(testCtx)
+		defer cancel()
+
+		// when
+		go func() { _ = sut.Start(ctx) }()
+		_, err := clientSet.CoreV1().Secrets(testNamespace).Update(testCtx, newVersion(newCredentialsSecret("rotated"), "2"), metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		// then
+		assert.Eventually(t, func() bool {
+			select {
+			case registries := <-receiver.registries:
+				return registries.Registries[0].Password == "rotated"
+			default:
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestWatcher_Start_retry(t *testing.T) {
+	t.Run("should reload registries periodically while they cannot be verified", func(t *testing.T) {
+		// given
+		t.Setenv("RUNTIME", "")
+		clientSet := fake.NewSimpleClientset(newRepositoryConfigMap(), newCredentialsSecret("secret"))
+		receiver := &recordingReceiver{registries: make(chan *config.HelmRegistries, 10), verifyErr: assert.AnError}
+
+		sut := NewWatcher(testNamespace, clientSet, receiver)
+		sut.retryPeriod = 10 * time.Millisecond
+		require.NoError(t, sut.Reload(testCtx))
+		<-receiver.registries
+		require.Error(t, sut.lastError())
+
+		ctx, cancel := context.WithCancel(testCtx)
+		defer cancel()
+		receiver.setVerifyErr(nil)
+
+		// when
+		go func() { _ = sut.Start(ctx) }()
+
+		// then
+		assert.Eventually(t, func() bool {
+			return sut.lastError() == nil
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestWatcher_NeedLeaderElection(t *testing.T) {
+	assert.False(t, (&Watcher{}).NeedLeaderElection())
+}
+
+// recordingReceiver passes all received registries to a channel and fails their verification with verifyErr.
+type recordingReceiver struct {
+	registries chan *config.HelmRegistries
+	mutex      sync.Mutex
+	verifyErr  error
+}
+
+func (r *recordingReceiver) SetRegistries(registries *config.HelmRegistries) {
+	select {
+	case r.registries <- registries:
+	default:
+	}
+}
+
+func (r *recordingReceiver) VerifyRegistries(context.Context, *config.HelmRegistries) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.verifyErr
+}
+
+func (r *recordingReceiver) setVerifyErr(err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.verifyErr = err
+}
+
+func newVersion[T metav1.Object](obj T, resourceVersion string) T {
+	obj.SetResourceVersion(resourceVersion)
+	return obj
+}
@@ -0,0 +1,228 @@
+// Package update checks the registry for newer versions of installed components and upgrades them automatically
+// according to their auto-upgrade policy.
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+const (
+	// UpdateCheckEventReason is the reason of events about newer versions of a component.
+	UpdateCheckEventReason = "UpdateCheck"
+	// AutoUpgradeEventReason is the reason of events about automatic upgrades of a component.
+	AutoUpgradeEventReason = "AutoUpgrade"
+)
+
+// CheckIntervalHandler regularly checks the registry for newer versions of all installed components. Newer versions
+// are announced in the status of the component and by an event. Components are upgraded automatically within their
+// maintenance window if their auto-upgrade policy allows it.
+type CheckIntervalHandler struct {
+	componentClient   componentInterface
+	helmClientFactory helmClientFactory
+	recorder          record.EventRecorder
+	checkInterval     time.Duration
+	defaultPolicy     Policy
+	defaultWindow     maintenance.Window
+	now               func() time.Time
+}
+
+// NewCheckIntervalHandler creates a new CheckIntervalHandler.
+func NewCheckIntervalHandler(namespace string, clientSet ecosystemClientSet, newHelmClient helm.NewHelmClientFunc[helmClient], recorder record.EventRecorder, checkInterval time.Duration, defaultPolicy Policy, defaultWindow maintenance.Window) *CheckIntervalHandler {
+	return &CheckIntervalHandler{
+		componentClient:   clientSet.ComponentV1Alpha1().Components(namespace),
+		helmClientFactory: newHelmClient,
+		recorder:          recorder,
+		checkInterval:     checkInterval,
+		defaultPolicy:     defaultPolicy,
+		defaultWindow:     defaultWindow,
+		now:               time.Now,
+	}
+}
+
+// Start regularly checks for updates until the context is done.
+func (h *CheckIntervalHandler) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).
+		WithName("update check interval handler")
+	logger.Info(fmt.Sprintf("started regularly checking for component updates with interval %s", h.checkInterval))
+
+	ticker := time.NewTicker(h.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			logger.Info("regularly checking for component updates...")
+			err := h.checkAll(ctx)
+			if err != nil {
+				logger.Error(err, "failed to check for component updates")
+			}
+		}
+	}
+}
+
+func (h *CheckIntervalHandler) checkAll(ctx context.Context) error {
+	components, err := h.componentClient.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list components: %w", err)
+	}
+
+	hc, err := h.helmClientFactory.NewHelmClient()
+	if err != nil {
+		return fmt.Errorf("failed to create helm client: %w", err)
+	}
+
+	var errs []error
+	for i := range components.Items {
+		component := &components.Items[i]
+		err = h.check(ctx, hc, component)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to check for updates of component %s: %w", component.Spec.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *CheckIntervalHandler) check(ctx context.Context, hc helmClient, component *k8sv1.Component) error {
+	if component.DeletionTimestamp != nil || component.Status.Status != k8sv1.ComponentStatusInstalled || component.Status.InstalledVersion == "" {
+		return nil
+	}
+
+	installedVersion, err := version.Parse(component.Status.InstalledVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse installed version: %w", err)
+	}
+
+	availableVersions, err := hc.GetAvailableVersions(helm.GetHelmChartName(component))
+	if err != nil {
+		return err
+	}
+
+	newestVersion := findNewest(availableVersions, func(v version.Version) bool {
+		return !v.IsPreRelease() && v.IsNewerThan(installedVersion)
+	})
+	changed := setAvailableVersion(component, newestVersion)
+	if changed && newestVersion != "" {
+		h.recorder.Eventf(component, corev1.EventTypeNormal, UpdateCheckEventReason, "Version %s is available.", newestVersion)
+	}
+
+	upgraded, err := h.autoUpgrade(ctx, component, installedVersion, availableVersions)
+	if err != nil {
+		return err
+	}
+
+	if upgraded {
+		updatedComponent, err := h.componentClient.Update(ctx, component, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to update component: %w", err)
+		}
+		// the update of the resource ignores the status
+		updatedComponent.Status.AvailableVersion = component.Status.AvailableVersion
+		component = updatedComponent
+	}
+
+	if changed {
+		_, err = h.componentClient.UpdateStatus(ctx, component, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to update available version of component: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// autoUpgrade sets the expected version of the component to the newest version allowed by its auto-upgrade policy if
+// its maintenance window is open. It returns true if the version of the component was changed.
+func (h *CheckIntervalHandler) autoUpgrade(ctx context.Context, component *k8sv1.Component, installedVersion version.Version, availableVersions []string) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	policy, err := GetPolicy(component, h.defaultPolicy)
+	if err != nil {
+		return false, err
+	}
+	if policy == PolicyNone {
+		return false, nil
+	}
+
+	// version ranges and channels are resolved by the component reconciler
+	selector, err := version.ParseSelector(component.Spec.Version)
+	if err != nil || !selector.IsExact() {
+		return false, nil
+	}
+
+	expectedVersion, err := version.Parse(component.Spec.Version)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse expected version: %w", err)
+	}
+
+	targetVersion := findNewest(availableVersions, func(v version.Version) bool {
+		return policy.allows(installedVersion, v) && v.IsNewerThan(expectedVersion)
+	})
+	if targetVersion == "" {
+		return false, nil
+	}
+
+	window, err := maintenance.GetWindow(component, h.defaultWindow)
+	if err != nil {
+		return false, err
+	}
+
+	now := h.now()
+	if !window.IsOpen(now) {
+		logger.Info(fmt.Sprintf("Postponing automatic upgrade of component %s to version %s until the maintenance window starts at %s",
+			component.Spec.Name, targetVersion, window.NextStart(now).Format(time.RFC3339)))
+		return false, nil
+	}
+
+	h.recorder.Eventf(component, corev1.EventTypeNormal, AutoUpgradeEventReason, "Upgrading from version %s to %s according to auto-upgrade policy %s.", component.Spec.Version, targetVersion, policy)
+	component.Spec.Version = targetVersion
+
+	return true, nil
+}
+
+// setAvailableVersion sets the available version in the status of the component. It returns true if it was changed.
+func setAvailableVersion(component *k8sv1.Component, availableVersion string) bool {
+	if component.Status.AvailableVersion == availableVersion {
+		return false
+	}
+
+	component.Status.AvailableVersion = availableVersion
+	return true
+}
+
+// findNewest returns the newest of the given versions matching the filter or an empty string if no version matches.
+// Versions which cannot be parsed are ignored.
+func findNewest(rawVersions []string, filter func(version.Version) bool) string {
+	var newest *version.Version
+	for _, raw := range rawVersions {
+		v, err := version.Parse(raw)
+		if err != nil || !filter(v) {
+			continue
+		}
+
+		if newest == nil || v.IsNewerThan(*newest) {
+			newest = &v
+		}
+	}
+
+	if newest == nil {
+		return ""
+	}
+
+	return newest.Raw
+}
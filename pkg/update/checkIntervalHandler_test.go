@@ -0,0 +1,354 @@
+package update
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/maintenance"
+)
+
+const (
+	testNamespace = "ecosystem"
+	testChartName = "k8s/k8s-dogu-operator"
+)
+
+// testNow is a Monday.
+var testNow = time.Date(2024, time.January, 1, 3, 0, 0, 0, time.UTC)
+
+func newTestComponent(specVersion, installedVersion, availableVersion string, annotations map[string]string) *k8sv1.Component {
+	return &k8sv1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "k8s-dogu-operator", Namespace: testNamespace, Annotations: annotations},
+		Spec:       k8sv1.ComponentSpec{Namespace: "k8s", Name: "k8s-dogu-operator", Version: specVersion},
+		Status:     k8sv1.ComponentStatus{Status: k8sv1.ComponentStatusInstalled, InstalledVersion: installedVersion, AvailableVersion: availableVersion},
+	}
+}
+
+func newTestHandler(componentClient componentInterface, recorder *mockEventRecorder, defaultPolicy Policy, rawWindow string) *CheckIntervalHandler {
+	window, _ := maintenance.ParseWindow(rawWindow)
+	return &CheckIntervalHandler{
+		componentClient: componentClient,
+		recorder:        recorder,
+		checkInterval:   time.Hour,
+		defaultPolicy:   defaultPolicy,
+		defaultWindow:   window,
+		now:             func() time.Time { return testNow },
+	}
+}
+
+func TestNewCheckIntervalHandler(t *testing.T) {
+	// given
+	clientSetMock := newMockEcosystemClientSet(t)
+	componentV1Mock := newMockComponentV1Alpha1Client(t)
+	componentMock := newMockComponentInterface(t)
+	componentV1Mock.EXPECT().Components(testNamespace).Return(componentMock)
+	clientSetMock.EXPECT().ComponentV1Alpha1().Return(componentV1Mock)
+	recorderMock := newMockEventRecorder(t)
+
+	// when
+	actual := NewCheckIntervalHandler(testNamespace, clientSetMock, nil, recorderMock, time.Hour, PolicyPatch, maintenance.Window{})
+
+	// then
+	require.NotNil(t, actual)
+	assert.Equal(t, componentMock, actual.componentClient)
+	assert.Equal(t, time.Hour, actual.checkInterval)
+	assert.Equal(t, PolicyPatch, actual.defaultPolicy)
+	assert.NotNil(t, actual.now)
+}
+
+func TestCheckIntervalHandler_Start(t *testing.T) {
+	t.Run("should stop when context is done", func(t *testing.T) {
+		// given
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyNone, "")
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// when
+		err := sut.Start(ctx)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckIntervalHandler_checkAll(t *testing.T) {
+	t.Run("should fail to list components", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, newMockEventRecorder(t), PolicyNone, "")
+
+		// when
+		err := sut.checkAll(context.Background())
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list components")
+	})
+	t.Run("should fail to create helm client", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&k8sv1.ComponentList{}, nil)
+		factoryMock := newMockHelmClientFactory(t)
+		factoryMock.EXPECT().NewHelmClient().Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, newMockEventRecorder(t), PolicyNone, "")
+		sut.helmClientFactory = factoryMock
+
+		// when
+		err := sut.checkAll(context.Background())
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to create helm client")
+	})
+	t.Run("should check all components and join errors", func(t *testing.T) {
+		// given
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&k8sv1.ComponentList{Items: []k8sv1.Component{
+			*newTestComponent("1.2.3", "1.2.3", "", nil),
+			*newTestComponent("1.0.0", "", "", nil),
+		}}, nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return(nil, assert.AnError)
+		factoryMock := newMockHelmClientFactory(t)
+		factoryMock.EXPECT().NewHelmClient().Return(helmMock, nil)
+		sut := newTestHandler(componentMock, newMockEventRecorder(t), PolicyNone, "")
+		sut.helmClientFactory = factoryMock
+
+		// when
+		err := sut.checkAll(context.Background())
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to check for updates of component k8s-dogu-operator")
+	})
+}
+
+func TestCheckIntervalHandler_check(t *testing.T) {
+	t.Run("should skip component which is not installed", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "", nil)
+		component.Status.Status = k8sv1.ComponentStatusUpgrading
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyMajor, "")
+
+		// when
+		err := sut.check(context.Background(), newMockHelmClient(t), component)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail to parse installed version", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "invalid", "", nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyMajor, "")
+
+		// when
+		err := sut.check(context.Background(), newMockHelmClient(t), component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse installed version")
+	})
+	t.Run("should announce available version", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.3.0", "1.4.0-rc1", "invalid"}, nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", UpdateCheckEventReason, "Version %s is available.", "1.3.0").Return()
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().UpdateStatus(mock.Anything, component, metav1.UpdateOptions{}).Return(component, nil)
+		sut := newTestHandler(componentMock, recorderMock, PolicyNone, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.3.0", component.Status.AvailableVersion)
+		assert.Equal(t, "1.2.3", component.Spec.Version)
+	})
+	t.Run("should not update component if available version did not change", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "1.3.0", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.3.0"}, nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyNone, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should remove available version if the newest version is installed", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.3.0", "1.3.0", "1.3.0", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.3.0"}, nil)
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().UpdateStatus(mock.Anything, component, metav1.UpdateOptions{}).Return(component, nil)
+		sut := newTestHandler(componentMock, newMockEventRecorder(t), PolicyNone, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Status.AvailableVersion)
+	})
+	t.Run("should upgrade automatically within the maintenance window", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "2.0.0", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4", "1.2.5", "1.3.0", "2.0.0"}, nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", AutoUpgradeEventReason, "Upgrading from version %s to %s according to auto-upgrade policy %s.", "1.2.3", "1.2.5", PolicyPatch).Return()
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().Update(mock.Anything, component, metav1.UpdateOptions{}).Return(component, nil)
+		sut := newTestHandler(componentMock, recorderMock, PolicyPatch, "Mon 00:00-06:00")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.5", component.Spec.Version)
+	})
+	t.Run("should use auto-upgrade policy of annotation", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "2.0.0", map[string]string{annotations.AutoUpgradePolicyAnnotation: "minor"})
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.3.0", "2.0.0"}, nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", AutoUpgradeEventReason, "Upgrading from version %s to %s according to auto-upgrade policy %s.", "1.2.3", "1.3.0", PolicyMinor).Return()
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().Update(mock.Anything, component, metav1.UpdateOptions{}).Return(component, nil)
+		sut := newTestHandler(componentMock, recorderMock, PolicyNone, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.3.0", component.Spec.Version)
+	})
+	t.Run("should postpone upgrade outside of the maintenance window", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "1.2.4", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4"}, nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyPatch, "Sat,Sun 00:00-24:00")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3", component.Spec.Version)
+	})
+	t.Run("should not upgrade component with version range", func(t *testing.T) {
+		// given
+		component := newTestComponent("~1.2", "1.2.3", "1.2.4", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4"}, nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyPatch, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "~1.2", component.Spec.Version)
+	})
+	t.Run("should not upgrade if the expected version is already newer", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.4", "1.2.3", "1.2.4", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4"}, nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyPatch, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.4", component.Spec.Version)
+	})
+	t.Run("should fail for invalid auto-upgrade policy", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "1.2.4", map[string]string{annotations.AutoUpgradePolicyAnnotation: "invalid"})
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4"}, nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyPatch, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "unknown auto-upgrade policy")
+	})
+	t.Run("should fail for invalid maintenance window", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "1.2.4", map[string]string{annotations.MaintenanceWindowAnnotation: "invalid"})
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4"}, nil)
+		sut := newTestHandler(newMockComponentInterface(t), newMockEventRecorder(t), PolicyPatch, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse maintenance window")
+	})
+	t.Run("should fail to update available version", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.3.0"}, nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", UpdateCheckEventReason, "Version %s is available.", "1.3.0").Return()
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().UpdateStatus(mock.Anything, component, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, recorderMock, PolicyNone, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to update available version of component")
+	})
+	t.Run("should fail to update component", func(t *testing.T) {
+		// given
+		component := newTestComponent("1.2.3", "1.2.3", "1.2.4", nil)
+		helmMock := newMockHelmClient(t)
+		helmMock.EXPECT().GetAvailableVersions(testChartName).Return([]string{"1.2.3", "1.2.4"}, nil)
+		recorderMock := newMockEventRecorder(t)
+		recorderMock.EXPECT().Eventf(component, "Normal", AutoUpgradeEventReason, "Upgrading from version %s to %s according to auto-upgrade policy %s.", "1.2.3", "1.2.4", PolicyPatch).Return()
+		componentMock := newMockComponentInterface(t)
+		componentMock.EXPECT().Update(mock.Anything, component, metav1.UpdateOptions{}).Return(nil, assert.AnError)
+		sut := newTestHandler(componentMock, recorderMock, PolicyPatch, "")
+
+		// when
+		err := sut.check(context.Background(), helmMock, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to update component")
+	})
+}
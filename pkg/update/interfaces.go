@@ -0,0 +1,37 @@
+package update
+
+import (
+	"k8s.io/client-go/tools/record"
+
+	componentClient "github.com/cloudogu/k8s-component-lib/client"
+)
+
+type ecosystemClientSet interface {
+	componentClient.ComponentEcosystemInterface
+}
+
+type componentInterface interface {
+	componentClient.ComponentInterface
+}
+
+type helmClient interface {
+	// GetAvailableVersions returns all tags of the chart with the given name in the registry.
+	GetAvailableVersions(chartName string) ([]string, error)
+}
+
+type helmClientFactory interface {
+	NewHelmClient() (helmClient, error)
+}
+
+// eventRecorder embeds the record.EventRecorder interface for usage in this package.
+type eventRecorder interface {
+	record.EventRecorder
+}
+
+// interfaces for mocks
+
+//nolint:unused
+//goland:noinspection GoUnusedType
+type componentV1Alpha1Client interface {
+	componentClient.ComponentV1Alpha1Interface
+}
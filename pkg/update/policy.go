@@ -0,0 +1,64 @@
+package update
+
+import (
+	"fmt"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+// Policy restricts to which newer versions a component is upgraded automatically.
+type Policy string
+
+const (
+	// PolicyNone disables automatic upgrades.
+	PolicyNone Policy = "none"
+	// PolicyPatch upgrades to newer versions with the same major and minor version.
+	PolicyPatch Policy = "patch"
+	// PolicyMinor upgrades to newer versions with the same major version.
+	PolicyMinor Policy = "minor"
+	// PolicyMajor upgrades to all newer versions.
+	PolicyMajor Policy = "major"
+)
+
+// ParsePolicy parses the given raw string into an auto-upgrade policy. An empty string is PolicyNone.
+func ParsePolicy(raw string) (Policy, error) {
+	switch policy := Policy(raw); policy {
+	case "":
+		return PolicyNone, nil
+	case PolicyNone, PolicyPatch, PolicyMinor, PolicyMajor:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown auto-upgrade policy %q: valid policies are %s, %s, %s and %s", raw, PolicyNone, PolicyPatch, PolicyMinor, PolicyMajor)
+	}
+}
+
+// GetPolicy returns the auto-upgrade policy of the component given by the AutoUpgradePolicyAnnotation or the default
+// policy if the component has no such annotation.
+func GetPolicy(component *k8sv1.Component, defaultPolicy Policy) (Policy, error) {
+	raw, ok := component.Annotations[annotations.AutoUpgradePolicyAnnotation]
+	if !ok {
+		return defaultPolicy, nil
+	}
+
+	return ParsePolicy(raw)
+}
+
+// allows returns true if the policy allows to upgrade automatically from the installed to the candidate version.
+func (p Policy) allows(installed version.Version, candidate version.Version) bool {
+	if !candidate.IsNewerThan(installed) || candidate.IsPreRelease() {
+		return false
+	}
+
+	switch p {
+	case PolicyPatch:
+		return candidate.Major == installed.Major && candidate.Minor == installed.Minor
+	case PolicyMinor:
+		return candidate.Major == installed.Major
+	case PolicyMajor:
+		return true
+	default:
+		return false
+	}
+}
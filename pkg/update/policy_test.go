@@ -0,0 +1,106 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Policy
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{name: "empty string is none", raw: "", want: PolicyNone, wantErr: assert.NoError},
+		{name: "none", raw: "none", want: PolicyNone, wantErr: assert.NoError},
+		{name: "patch", raw: "patch", want: PolicyPatch, wantErr: assert.NoError},
+		{name: "minor", raw: "minor", want: PolicyMinor, wantErr: assert.NoError},
+		{name: "major", raw: "major", want: PolicyMajor, wantErr: assert.NoError},
+		{
+			name: "unknown policy",
+			raw:  "always",
+			want: "",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "unknown auto-upgrade policy \"always\"", i...)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePolicy(tt.raw)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetPolicy(t *testing.T) {
+	t.Run("should return default policy without annotation", func(t *testing.T) {
+		// when
+		actual, err := GetPolicy(&k8sv1.Component{}, PolicyMinor)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, PolicyMinor, actual)
+	})
+	t.Run("should return policy of annotation", func(t *testing.T) {
+		// given
+		component := &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotations.AutoUpgradePolicyAnnotation: "none"}}}
+
+		// when
+		actual, err := GetPolicy(component, PolicyMinor)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, PolicyNone, actual)
+	})
+	t.Run("should fail for invalid annotation", func(t *testing.T) {
+		// given
+		component := &k8sv1.Component{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotations.AutoUpgradePolicyAnnotation: "invalid"}}}
+
+		// when
+		_, err := GetPolicy(component, PolicyMinor)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "unknown auto-upgrade policy")
+	})
+}
+
+func TestPolicy_allows(t *testing.T) {
+	tests := []struct {
+		policy    Policy
+		candidate string
+		want      bool
+	}{
+		{policy: PolicyNone, candidate: "1.2.4", want: false},
+		{policy: PolicyPatch, candidate: "1.2.4", want: true},
+		{policy: PolicyPatch, candidate: "1.2.3-2", want: true},
+		{policy: PolicyPatch, candidate: "1.3.0", want: false},
+		{policy: PolicyMinor, candidate: "1.3.0", want: true},
+		{policy: PolicyMinor, candidate: "2.0.0", want: false},
+		{policy: PolicyMajor, candidate: "2.0.0", want: true},
+		{policy: PolicyMajor, candidate: "2.0.0-rc1", want: false},
+		{policy: PolicyMajor, candidate: "1.2.3", want: false},
+		{policy: PolicyMajor, candidate: "1.2.2", want: false},
+	}
+	installed, err := version.Parse("1.2.3")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy)+" "+tt.candidate, func(t *testing.T) {
+			candidate, err := version.Parse(tt.candidate)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, tt.policy.allows(installed, candidate))
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package version
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	operatorEqual          = "="
+	operatorNotEqual       = "!="
+	operatorGreater        = ">"
+	operatorGreaterOrEqual = ">="
+	operatorLess           = "<"
+	operatorLessOrEqual    = "<="
+	operatorTilde          = "~"
+	operatorCaret          = "^"
+)
+
+// operators are ordered so that longer operators are matched before their prefixes.
+var operators = []string{"==", operatorNotEqual, operatorGreaterOrEqual, operatorLessOrEqual, "~>", operatorEqual, operatorGreater, operatorLess, operatorTilde, operatorCaret}
+
+var wildcards = []string{"x", "X", "*"}
+
+// Constraint is a version requirement, e.g. of a component dependency.
+//
+// A constraint consists of groups separated by "||". A version satisfies the constraint if it satisfies all
+// comparators of at least one group. Comparators within a group are separated by spaces or commas. Supported are the
+// operators =, ==, !=, >, >=, <, <=, ~ (same minor version), ^ (same major version), wildcards (1.x, 1.5.*) and hyphen
+// ranges (1.2.3 - 1.4.0).
+//
+// Versions in constraints follow the CES version semantics: a numeric suffix is a build number, so ">=1.5.0-0" is the
+// same as ">=1.5.0" and also accepts 1.5.0-8. Missing parts are wildcards, so "<4.0" accepts all versions below 4.0.0.
+// Pre-releases only satisfy a group that contains a pre-release of the same version, e.g. ">=1.6.0-rc.1 <1.7.0"
+// accepts 1.6.0-rc.2 but ">=1.5.0" does not accept 1.6.0-rc.2.
+type Constraint struct {
+	raw    string
+	groups [][]comparator
+}
+
+type comparator struct {
+	operator string
+	version  Version
+}
+
+// ParseConstraint parses the given raw string into a constraint.
+func ParseConstraint(raw string) (Constraint, error) {
+	if strings.TrimSpace(raw) == "" {
+		return Constraint{}, fmt.Errorf("failed to parse constraint %q: constraint is empty", raw)
+	}
+
+	constraint := Constraint{raw: raw}
+	for _, rawGroup := range strings.Split(raw, "||") {
+		group, err := parseGroup(rawGroup)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("failed to parse constraint %q: %w", raw, err)
+		}
+		constraint.groups = append(constraint.groups, group)
+	}
+
+	return constraint, nil
+}
+
+// Check returns true if the version satisfies the constraint.
+func (c Constraint) Check(version Version) bool {
+	return slices.ContainsFunc(c.groups, func(group []comparator) bool {
+		return checkGroup(group, version)
+	})
+}
+
+// String returns the constraint as it was parsed.
+func (c Constraint) String() string {
+	return c.raw
+}
+
+func checkGroup(group []comparator, version Version) bool {
+	if version.IsPreRelease() && !containsPreReleaseOf(group, version) {
+		return false
+	}
+
+	for _, comp := range group {
+		if !comp.check(version) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsPreReleaseOf(group []comparator, version Version) bool {
+	return slices.ContainsFunc(group, func(comp comparator) bool {
+		return comp.version.IsPreRelease() && slices.Equal(comp.version.numericParts(), version.numericParts())
+	})
+}
+
+func (c comparator) check(version Version) bool {
+	result := version.Compare(c.version)
+	switch c.operator {
+	case operatorEqual:
+		return result == 0
+	case operatorNotEqual:
+		return result != 0
+	case operatorGreater:
+		return result > 0
+	case operatorGreaterOrEqual:
+		return result >= 0
+	case operatorLess:
+		return result < 0
+	case operatorLessOrEqual:
+		return result <= 0
+	default:
+		return false
+	}
+}
+
+func parseGroup(rawGroup string) ([]comparator, error) {
+	tokens, err := tokenize(rawGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	var group []comparator
+	for i := 0; i < len(tokens); i++ {
+		// hyphen range, e.g. "1.2.3 - 1.4.0"
+		if i+2 < len(tokens) && tokens[i+1] == "-" {
+			comparators, err := parseHyphenRange(tokens[i], tokens[i+2])
+			if err != nil {
+				return nil, err
+			}
+			group = append(group, comparators...)
+			i += 2
+			continue
+		}
+
+		comparators, err := parseComparator(tokens[i])
+		if err != nil {
+			return nil, err
+		}
+		group = append(group, comparators...)
+	}
+
+	return group, nil
+}
+
+// tokenize splits a group into its comparators and joins operators separated from their version by spaces.
+func tokenize(rawGroup string) ([]string, error) {
+	fields := strings.Fields(strings.ReplaceAll(rawGroup, ",", " "))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("found empty group")
+	}
+
+	var tokens []string
+	for i := 0; i < len(fields); i++ {
+		if slices.Contains(operators, fields[i]) {
+			if i+1 == len(fields) {
+				return nil, fmt.Errorf("operator %s without version", fields[i])
+			}
+			tokens = append(tokens, fields[i]+fields[i+1])
+			i++
+			continue
+		}
+		tokens = append(tokens, fields[i])
+	}
+
+	return tokens, nil
+}
+
+func parseHyphenRange(rawLower string, rawUpper string) ([]comparator, error) {
+	lower, err := parseComparator(operatorGreaterOrEqual + rawLower)
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := parseComparator(operatorLessOrEqual + rawUpper)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(lower, upper...), nil
+}
+
+func parseComparator(token string) ([]comparator, error) {
+	operator := ""
+	for _, candidate := range operators {
+		if strings.HasPrefix(token, candidate) {
+			operator = candidate
+			break
+		}
+	}
+
+	partial, err := parsePartialVersion(strings.TrimPrefix(token, operator))
+	if err != nil {
+		return nil, err
+	}
+
+	switch operator {
+	case "", operatorEqual, "==":
+		return partial.rangeComparators(), nil
+	case operatorNotEqual:
+		if partial.isWildcard() {
+			return nil, fmt.Errorf("operator %s does not support wildcards in %q", operatorNotEqual, token)
+		}
+		return []comparator{{operatorNotEqual, partial.lower()}}, nil
+	case operatorGreater:
+		if partial.isWildcard() {
+			return partial.upperBound(operatorGreaterOrEqual, len(partial.numbers)-1), nil
+		}
+		return []comparator{{operatorGreater, partial.lower()}}, nil
+	case operatorGreaterOrEqual:
+		return []comparator{{operatorGreaterOrEqual, partial.lower()}}, nil
+	case operatorLess:
+		return []comparator{{operatorLess, partial.lower()}}, nil
+	case operatorLessOrEqual:
+		if partial.isWildcard() {
+			return partial.upperBound(operatorLess, len(partial.numbers)-1), nil
+		}
+		return []comparator{{operatorLessOrEqual, partial.lower()}}, nil
+	case operatorTilde, "~>":
+		// same minor version, or same major version if only the major version is given
+		return partial.boundedComparators(min(len(partial.numbers)-1, 1)), nil
+	case operatorCaret:
+		// same major version, or same minor/patch version for 0.x versions
+		return partial.boundedComparators(partial.firstNonZeroIndex()), nil
+	default:
+		return nil, fmt.Errorf("unsupported operator in %q", token)
+	}
+}
+
+// partialVersion is a version in a constraint which may contain wildcards or omit parts.
+type partialVersion struct {
+	// numbers contains all numeric parts before the first wildcard or missing part.
+	numbers []int
+	// complete is true if all parts up to the patch version are given without wildcards.
+	complete bool
+	build    int
+	pre      string
+}
+
+func parsePartialVersion(raw string) (partialVersion, error) {
+	coreVersion, suffix, hasSuffix, err := splitVersion(raw)
+	if err != nil {
+		return partialVersion{}, fmt.Errorf("failed to parse version %q: %w", raw, err)
+	}
+
+	var partial partialVersion
+	parts := strings.Split(coreVersion, ".")
+	if len(parts) > maxNumericParts {
+		return partialVersion{}, fmt.Errorf("failed to parse version %q: more than %d numeric parts", raw, maxNumericParts)
+	}
+
+	for _, part := range parts {
+		if slices.Contains(wildcards, part) {
+			break
+		}
+
+		number, err := parseNumber(part)
+		if err != nil {
+			return partialVersion{}, fmt.Errorf("failed to parse version %q: %w", raw, err)
+		}
+		partial.numbers = append(partial.numbers, number)
+	}
+	if len(partial.numbers) < len(parts) && !allWildcards(parts[len(partial.numbers):]) {
+		return partialVersion{}, fmt.Errorf("failed to parse version %q: numbers after wildcard", raw)
+	}
+	partial.complete = len(partial.numbers) >= 3
+
+	if hasSuffix {
+		partial.build, partial.pre, err = parseSuffix(suffix)
+		if err != nil {
+			return partialVersion{}, fmt.Errorf("failed to parse version %q: %w", raw, err)
+		}
+	}
+
+	return partial, nil
+}
+
+func allWildcards(parts []string) bool {
+	for _, part := range parts {
+		if !slices.Contains(wildcards, part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p partialVersion) isWildcard() bool {
+	return !p.complete
+}
+
+// lower returns the lowest version matching the partial version.
+func (p partialVersion) lower() Version {
+	numbers := make([]int, maxNumericParts)
+	copy(numbers, p.numbers)
+
+	return Version{
+		Raw:        p.raw(),
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		Nano:       numbers[3],
+		Build:      p.build,
+		PreRelease: p.pre,
+	}
+}
+
+func (p partialVersion) raw() string {
+	parts := make([]string, len(p.numbers))
+	for i, number := range p.numbers {
+		parts[i] = fmt.Sprint(number)
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// upperBound returns a comparator with the given operator against the next version after incrementing the part with
+// the given index. No comparator is returned if no part is given at all.
+func (p partialVersion) upperBound(operator string, index int) []comparator {
+	if index < 0 {
+		if operator == operatorGreaterOrEqual {
+			// nothing is greater than all versions
+			return []comparator{{operatorLess, Version{}}}
+		}
+		return nil
+	}
+
+	numbers := make([]int, maxNumericParts)
+	copy(numbers, p.numbers[:index+1])
+	numbers[index]++
+
+	return []comparator{{operator, Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2], Nano: numbers[3]}}}
+}
+
+// rangeComparators returns comparators matching exactly the partial version. Missing parts and wildcards match all
+// values.
+func (p partialVersion) rangeComparators() []comparator {
+	if !p.isWildcard() {
+		return []comparator{{operatorEqual, p.lower()}}
+	}
+
+	return p.boundedComparators(len(p.numbers) - 1)
+}
+
+// boundedComparators returns comparators for all versions between the partial version and the next version after
+// incrementing the part with the given index.
+func (p partialVersion) boundedComparators(index int) []comparator {
+	if len(p.numbers) == 0 {
+		return []comparator{{operatorGreaterOrEqual, Version{}}}
+	}
+
+	return append([]comparator{{operatorGreaterOrEqual, p.lower()}}, p.upperBound(operatorLess, index)...)
+}
+
+// firstNonZeroIndex returns the index of the first part which is not 0 or the last given part if all parts are 0.
+func (p partialVersion) firstNonZeroIndex() int {
+	for i, number := range p.numbers {
+		if number != 0 {
+			return i
+		}
+	}
+
+	return len(p.numbers) - 1
+}
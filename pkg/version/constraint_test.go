@@ -0,0 +1,103 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "empty", raw: " ", wantErr: "constraint is empty"},
+		{name: "empty group", raw: ">1.0.0 || ", wantErr: "found empty group"},
+		{name: "operator without version", raw: ">=1.0.0 <", wantErr: "operator < without version"},
+		{name: "invalid version", raw: ">=1.a", wantErr: "\"a\" is not a number"},
+		{name: "numbers after wildcard", raw: "1.x.3", wantErr: "numbers after wildcard"},
+		{name: "not equal with wildcard", raw: "!=1.x", wantErr: "operator != does not support wildcards"},
+		{name: "invalid", raw: "invalid", wantErr: "failed to parse constraint \"invalid\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := ParseConstraint(tt.raw)
+
+			// then
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{constraint: "1.2.3", version: "1.2.3", want: true},
+		{constraint: "1.2.3", version: "1.2.3-0", want: true},
+		{constraint: "1.2.3", version: "1.2.3-1", want: false},
+		{constraint: "=1.2.3-1", version: "1.2.3-1", want: true},
+		{constraint: "!=1.2.3", version: "1.2.4", want: true},
+		{constraint: "!=1.2.3", version: "1.2.3", want: false},
+		{constraint: ">1.2.3", version: "1.2.3-1", want: true},
+		{constraint: ">1.2.3", version: "1.2.3", want: false},
+		{constraint: ">=1.5.0-0", version: "1.5.0", want: true},
+		{constraint: ">=1.5.0-0", version: "1.5.0-8", want: true},
+		{constraint: ">=1.5.0-2", version: "1.5.0-1", want: false},
+		{constraint: "<4.0", version: "3.9.9-9", want: true},
+		{constraint: "<4.0-0", version: "4.0.0", want: false},
+		{constraint: "<=1.2.3", version: "1.2.3-1", want: false},
+		{constraint: ">=3.0.0-0  <4.0-0", version: "3.0.0-2", want: true},
+		{constraint: ">= 3.0.0, < 4.0.0", version: "3.5.0", want: true},
+		{constraint: ">= 3.0.0, < 4.0.0", version: "4.0.0", want: false},
+		{constraint: "1.x", version: "1.9.0-3", want: true},
+		{constraint: "1.x", version: "2.0.0", want: false},
+		{constraint: "1.5", version: "1.5.9", want: true},
+		{constraint: "1.5.*", version: "1.6.0", want: false},
+		{constraint: "*", version: "42.0.0", want: true},
+		{constraint: ">1.x", version: "1.9.9", want: false},
+		{constraint: ">1.x", version: "2.0.0", want: true},
+		{constraint: "<=1.5", version: "1.5.9-1", want: true},
+		{constraint: "<=1.5", version: "1.6.0", want: false},
+		{constraint: "~3.0.0", version: "3.0.9-1", want: true},
+		{constraint: "~3.0.0", version: "3.1.0", want: false},
+		{constraint: "~3", version: "3.9.0", want: true},
+		{constraint: "~3.x.x-0", version: "3.0.0-2", want: true},
+		{constraint: "^1.2.3", version: "1.9.0", want: true},
+		{constraint: "^1.2.3", version: "1.2.2", want: false},
+		{constraint: "^1.2.3", version: "2.0.0", want: false},
+		{constraint: "^0.2.3", version: "0.2.9", want: true},
+		{constraint: "^0.2.3", version: "0.3.0", want: false},
+		{constraint: "^0.0.3", version: "0.0.4", want: false},
+		{constraint: "1.2.3 - 1.4", version: "1.4.0-5", want: true},
+		{constraint: "1.2.3 - 1.4", version: "1.2.2", want: false},
+		{constraint: "<1.0.0 || >=2.0.0", version: "2.1.0", want: true},
+		{constraint: "<1.0.0 || >=2.0.0", version: "1.5.0", want: false},
+		{constraint: ">=1.5.0", version: "1.6.0-rc.1", want: false},
+		{constraint: ">=1.6.0-rc.1 <1.7.0", version: "1.6.0-rc.2", want: true},
+		{constraint: ">=1.6.0-rc.1 <1.7.0", version: "1.6.0-alpha", want: false},
+		{constraint: ">=1.6.0-rc.1 <1.7.0", version: "1.6.1-rc.1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" with "+tt.version, func(t *testing.T) {
+			// given
+			constraint, err := ParseConstraint(tt.constraint)
+			require.NoError(t, err)
+			v, err := Parse(tt.version)
+			require.NoError(t, err)
+
+			// when
+			got := constraint.Check(v)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.constraint, constraint.String())
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package version
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Channel selects the newest version of a certain kind instead of a version range.
+type Channel string
+
+const (
+	// StableChannel selects the newest release. Pre-releases are ignored.
+	StableChannel Channel = "stable"
+	// PreReleaseChannel selects the newest version including pre-releases.
+	PreReleaseChannel Channel = "pre-release"
+	// PatchOnlyChannel selects the newest release with the same major and minor version as the installed version.
+	// It behaves like the StableChannel if no version is installed.
+	PatchOnlyChannel Channel = "patch-only"
+)
+
+var channels = []Channel{StableChannel, PreReleaseChannel, PatchOnlyChannel}
+
+// Selector describes which version of a component should be installed. It is either an exact version, a version
+// constraint like "~1.5" or "1.x" or a channel like "stable".
+type Selector struct {
+	raw        string
+	exact      bool
+	channel    Channel
+	constraint Constraint
+}
+
+// ParseSelector parses the given raw string into a selector. Every valid version is an exact version, e.g. "1.5" is the
+// exact version 1.5.0. Ranges must use operators or wildcards, e.g. "~1.5" or "1.5.x" select the newest 1.5 version.
+func ParseSelector(raw string) (Selector, error) {
+	trimmed := strings.TrimSpace(raw)
+	if slices.Contains(channels, Channel(trimmed)) {
+		return Selector{raw: raw, channel: Channel(trimmed)}, nil
+	}
+
+	if _, err := Parse(trimmed); err == nil {
+		return Selector{raw: raw, exact: true}, nil
+	}
+
+	constraint, err := ParseConstraint(trimmed)
+	if err != nil {
+		return Selector{}, fmt.Errorf("failed to parse version selector %q: %w", raw, err)
+	}
+
+	return Selector{raw: raw, constraint: constraint}, nil
+}
+
+// IsExact returns true if the selector is an exact version which does not need to be resolved.
+func (s Selector) IsExact() bool {
+	return s.exact
+}
+
+// String returns the selector as it was parsed.
+func (s Selector) String() string {
+	return s.raw
+}
+
+// Select returns the newest of the available versions matching the selector. Exact versions are returned unchanged.
+// Available versions which cannot be parsed are ignored. The installed version is only used by the PatchOnlyChannel
+// and may be empty.
+func (s Selector) Select(available []string, installed string) (string, error) {
+	if s.exact {
+		return strings.TrimSpace(s.raw), nil
+	}
+
+	matches, err := s.matcher(installed)
+	if err != nil {
+		return "", err
+	}
+
+	var newest *Version
+	for _, raw := range available {
+		v, err := Parse(raw)
+		if err != nil || !matches(v) {
+			continue
+		}
+
+		if newest == nil || v.IsNewerThan(*newest) {
+			newest = &v
+		}
+	}
+
+	if newest == nil {
+		return "", fmt.Errorf("no version matches %q", s.raw)
+	}
+
+	return newest.Raw, nil
+}
+
+func (s Selector) matcher(installed string) (func(Version) bool, error) {
+	switch s.channel {
+	case StableChannel:
+		return isRelease, nil
+	case PreReleaseChannel:
+		return func(Version) bool { return true }, nil
+	case PatchOnlyChannel:
+		if installed == "" {
+			return isRelease, nil
+		}
+
+		installedVersion, err := Parse(installed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse installed version for channel %s: %w", PatchOnlyChannel, err)
+		}
+
+		return func(v Version) bool {
+			return isRelease(v) && v.Major == installedVersion.Major && v.Minor == installedVersion.Minor
+		}, nil
+	default:
+		return s.constraint.Check, nil
+	}
+}
+
+func isRelease(v Version) bool {
+	return !v.IsPreRelease()
+}
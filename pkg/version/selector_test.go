@@ -0,0 +1,85 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		wantExact bool
+		wantErr   string
+	}{
+		{name: "exact version", raw: "1.5.1", wantExact: true},
+		{name: "exact version with build number", raw: "1.5.1-8", wantExact: true},
+		{name: "incomplete version is exact", raw: "1.5", wantExact: true},
+		{name: "constraint", raw: "~1.5", wantExact: false},
+		{name: "wildcard", raw: "1.x", wantExact: false},
+		{name: "channel", raw: "stable", wantExact: false},
+		{name: "invalid", raw: "latest-and-greatest", wantErr: "failed to parse version selector \"latest-and-greatest\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got, err := ParseSelector(tt.raw)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantExact, got.IsExact())
+			assert.Equal(t, tt.raw, got.String())
+		})
+	}
+}
+
+func TestSelector_Select(t *testing.T) {
+	available := []string{"1.4.2", "1.5.0", "1.5.1", "1.5.1-8", "1.6.0-rc.1", "2.0.0", "2.1.0", "2.2.0-alpha", "invalid"}
+
+	tests := []struct {
+		name      string
+		selector  string
+		installed string
+		want      string
+		wantErr   string
+	}{
+		{name: "exact version is returned unchanged", selector: "3.0.0", want: "3.0.0"},
+		{name: "tilde", selector: "~1.5", want: "1.5.1-8"},
+		{name: "caret", selector: "^2.0", want: "2.1.0"},
+		{name: "wildcard", selector: "1.x", want: "1.5.1-8"},
+		{name: "incomplete wildcard version", selector: "1.4.x", want: "1.4.2"},
+		{name: "pre-release only if requested", selector: ">=1.6.0-rc.1 <1.7.0", want: "1.6.0-rc.1"},
+		{name: "stable channel", selector: "stable", want: "2.1.0"},
+		{name: "pre-release channel", selector: "pre-release", want: "2.2.0-alpha"},
+		{name: "patch-only channel", selector: "patch-only", installed: "1.5.0", want: "1.5.1-8"},
+		{name: "patch-only channel without installed version", selector: "patch-only", want: "2.1.0"},
+		{name: "patch-only channel with invalid installed version", selector: "patch-only", installed: "invalid", wantErr: "failed to parse installed version for channel patch-only"},
+		{name: "no matching version", selector: "^3.0", wantErr: "no version matches \"^3.0\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			selector, err := ParseSelector(tt.selector)
+			require.NoError(t, err)
+
+			// when
+			got, err := selector.Select(available, tt.installed)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// Package version implements the version semantics of the Cloudogu EcoSystem for components.
+//
+// A version consists of up to four numeric parts (major.minor.patch.nano) and an optional suffix separated by a
+// hyphen. Missing parts are 0.
+//   - A numeric suffix is a CES build number, e.g. 1.5.1-8 is the 8th build of 1.5.1. A version without suffix is
+//     build 0. Builds are ordered after the version without build number: 1.5.1 < 1.5.1-1 < 1.5.1-8 < 1.5.2.
+//   - A non-numeric suffix marks a pre-release, e.g. 1.6.0-rc.1. Pre-releases are ordered before the release of the
+//     same version and compared like semver pre-releases: 1.6.0-alpha < 1.6.0-rc.1 < 1.6.0-rc.2 < 1.6.0.
+//
+// Neither of the existing libraries implements these semantics: Masterminds semver treats every suffix as a pre-release,
+// so 1.5.1-8 would be lower than 1.5.1, and the version of the cesapp-lib fails to parse pre-releases and only supports
+// single comparisons without ranges, wildcards or alternatives.
+package version
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const maxNumericParts = 4
+
+var preReleasePattern = regexp.MustCompile(`^[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*$`)
+
+// Version is a parsed component version.
+type Version struct {
+	// Raw contains the version as it was parsed.
+	Raw   string
+	Major int
+	Minor int
+	Patch int
+	Nano  int
+	// Build is the CES build number of the version.
+	Build int
+	// PreRelease contains the pre-release identifiers of the version. It is empty for releases.
+	PreRelease string
+}
+
+// Parse parses the given raw string into a version. A leading "v" and semver build metadata after a "+" are ignored.
+func Parse(raw string) (Version, error) {
+	coreVersion, suffix, hasSuffix, err := splitVersion(raw)
+	if err != nil {
+		return Version{}, fmt.Errorf("failed to parse version %q: %w", raw, err)
+	}
+
+	parts := strings.Split(coreVersion, ".")
+	if len(parts) > maxNumericParts {
+		return Version{}, fmt.Errorf("failed to parse version %q: more than %d numeric parts", raw, maxNumericParts)
+	}
+
+	numbers := make([]int, maxNumericParts)
+	for i, part := range parts {
+		numbers[i], err = parseNumber(part)
+		if err != nil {
+			return Version{}, fmt.Errorf("failed to parse version %q: %w", raw, err)
+		}
+	}
+
+	version := Version{Raw: raw, Major: numbers[0], Minor: numbers[1], Patch: numbers[2], Nano: numbers[3]}
+	if hasSuffix {
+		version.Build, version.PreRelease, err = parseSuffix(suffix)
+		if err != nil {
+			return Version{}, fmt.Errorf("failed to parse version %q: %w", raw, err)
+		}
+	}
+
+	return version, nil
+}
+
+// IsPreRelease returns true if the version has a non-numeric suffix.
+func (v Version) IsPreRelease() bool {
+	return v.PreRelease != ""
+}
+
+// Compare returns -1 if the version is lower than the other version, 1 if it is higher and 0 if both are equal.
+func (v Version) Compare(o Version) int {
+	for i, part := range v.numericParts() {
+		if result := cmp.Compare(part, o.numericParts()[i]); result != 0 {
+			return result
+		}
+	}
+
+	switch {
+	case v.IsPreRelease() && o.IsPreRelease():
+		return comparePreRelease(v.PreRelease, o.PreRelease)
+	case v.IsPreRelease():
+		return -1
+	case o.IsPreRelease():
+		return 1
+	default:
+		return cmp.Compare(v.Build, o.Build)
+	}
+}
+
+// IsNewerThan returns true if the version is higher than the other version.
+func (v Version) IsNewerThan(o Version) bool {
+	return v.Compare(o) > 0
+}
+
+// IsOlderThan returns true if the version is lower than the other version.
+func (v Version) IsOlderThan(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+// IsEqualTo returns true if both versions are equal according to the CES version semantics, e.g. 1.2 and 1.2.0-0.
+func (v Version) IsEqualTo(o Version) bool {
+	return v.Compare(o) == 0
+}
+
+// String returns the normalized representation of the version.
+func (v Version) String() string {
+	result := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Nano != 0 {
+		result = fmt.Sprintf("%s.%d", result, v.Nano)
+	}
+
+	switch {
+	case v.IsPreRelease():
+		return result + "-" + v.PreRelease
+	case v.Build != 0:
+		return fmt.Sprintf("%s-%d", result, v.Build)
+	default:
+		return result
+	}
+}
+
+func (v Version) numericParts() []int {
+	return []int{v.Major, v.Minor, v.Patch, v.Nano}
+}
+
+// splitVersion splits the raw version into the numeric core and the suffix.
+func splitVersion(raw string) (coreVersion string, suffix string, hasSuffix bool, err error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(raw), "v")
+	trimmed, _, _ = strings.Cut(trimmed, "+")
+	if trimmed == "" {
+		return "", "", false, fmt.Errorf("version is empty")
+	}
+
+	coreVersion, suffix, hasSuffix = strings.Cut(trimmed, "-")
+	return coreVersion, suffix, hasSuffix, nil
+}
+
+func parseSuffix(suffix string) (build int, preRelease string, err error) {
+	if suffix == "" {
+		return 0, "", fmt.Errorf("empty suffix after hyphen")
+	}
+
+	if isNumeric(suffix) {
+		build, err = parseNumber(suffix)
+		return build, "", err
+	}
+
+	if !preReleasePattern.MatchString(suffix) {
+		return 0, "", fmt.Errorf("invalid pre-release %q", suffix)
+	}
+
+	return 0, suffix, nil
+}
+
+func parseNumber(raw string) (int, error) {
+	if !isNumeric(raw) {
+		return 0, fmt.Errorf("%q is not a number", raw)
+	}
+
+	return strconv.Atoi(raw)
+}
+
+func isNumeric(raw string) bool {
+	if raw == "" {
+		return false
+	}
+
+	for _, r := range raw {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// comparePreRelease compares pre-release identifiers like semver: numeric identifiers are compared numerically and
+// are lower than alphanumeric ones; a shorter list of identifiers is lower if all others are equal.
+func comparePreRelease(a, b string) int {
+	aIdentifiers := strings.Split(a, ".")
+	bIdentifiers := strings.Split(b, ".")
+
+	for i := 0; i < len(aIdentifiers) && i < len(bIdentifiers); i++ {
+		if result := compareIdentifier(aIdentifiers[i], bIdentifiers[i]); result != 0 {
+			return result
+		}
+	}
+
+	return cmp.Compare(len(aIdentifiers), len(bIdentifiers))
+}
+
+func compareIdentifier(a, b string) int {
+	aNumeric, bNumeric := isNumeric(a), isNumeric(b)
+	switch {
+	case aNumeric && bNumeric:
+		aNumber, _ := strconv.Atoi(a)
+		bNumber, _ := strconv.Atoi(b)
+		return cmp.Compare(aNumber, bNumber)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
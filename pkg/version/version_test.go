@@ -0,0 +1,113 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Version
+		wantErr string
+	}{
+		{name: "full version", raw: "1.2.3", want: Version{Raw: "1.2.3", Major: 1, Minor: 2, Patch: 3}},
+		{name: "version with nano part", raw: "1.2.3.4", want: Version{Raw: "1.2.3.4", Major: 1, Minor: 2, Patch: 3, Nano: 4}},
+		{name: "missing parts are 0", raw: "1.2", want: Version{Raw: "1.2", Major: 1, Minor: 2}},
+		{name: "build number", raw: "1.5.1-8", want: Version{Raw: "1.5.1-8", Major: 1, Minor: 5, Patch: 1, Build: 8}},
+		{name: "pre-release", raw: "1.6.0-rc.1", want: Version{Raw: "1.6.0-rc.1", Major: 1, Minor: 6, PreRelease: "rc.1"}},
+		{name: "leading v and build metadata", raw: "v1.2.3+abc", want: Version{Raw: "v1.2.3+abc", Major: 1, Minor: 2, Patch: 3}},
+		{name: "empty", raw: "", wantErr: "failed to parse version \"\": version is empty"},
+		{name: "too many parts", raw: "1.2.3.4.5", wantErr: "more than 4 numeric parts"},
+		{name: "not a number", raw: "1.a.3", wantErr: "\"a\" is not a number"},
+		{name: "empty suffix", raw: "1.2.3-", wantErr: "empty suffix after hyphen"},
+		{name: "invalid pre-release", raw: "1.2.3-rc..1", wantErr: "invalid pre-release \"rc..1\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got, err := Parse(tt.raw)
+
+			// then
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "equal", a: "1.2.3", b: "1.2.3", want: 0},
+		{name: "missing parts equal 0", a: "1.2", b: "1.2.0-0", want: 0},
+		{name: "lower major", a: "1.9.9", b: "2.0.0", want: -1},
+		{name: "higher minor", a: "1.10.0", b: "1.9.0", want: 1},
+		{name: "higher nano", a: "1.2.3.1", b: "1.2.3", want: 1},
+		{name: "build is newer than release", a: "1.5.1-1", b: "1.5.1", want: 1},
+		{name: "higher build", a: "1.5.1-8", b: "1.5.1-10", want: -1},
+		{name: "build is older than next patch", a: "1.5.1-8", b: "1.5.2", want: -1},
+		{name: "pre-release is older than release", a: "1.6.0-rc.1", b: "1.6.0", want: -1},
+		{name: "pre-release is newer than previous build", a: "1.6.0-rc.1", b: "1.5.9-3", want: 1},
+		{name: "numeric pre-release identifier", a: "1.6.0-rc.2", b: "1.6.0-rc.10", want: -1},
+		{name: "alphanumeric pre-release identifiers", a: "1.6.0-alpha", b: "1.6.0-beta", want: -1},
+		{name: "numeric identifier is lower than alphanumeric", a: "1.6.0-rc.1", b: "1.6.0-rc.a", want: -1},
+		{name: "shorter pre-release is lower", a: "1.6.0-rc", b: "1.6.0-rc.1", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			a, err := Parse(tt.a)
+			require.NoError(t, err)
+			b, err := Parse(tt.b)
+			require.NoError(t, err)
+
+			// when
+			got := a.Compare(b)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, -tt.want, b.Compare(a))
+			assert.Equal(t, tt.want > 0, a.IsNewerThan(b))
+			assert.Equal(t, tt.want < 0, a.IsOlderThan(b))
+			assert.Equal(t, tt.want == 0, a.IsEqualTo(b))
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{raw: "1.2", want: "1.2.0"},
+		{raw: "v1.2.3.4", want: "1.2.3.4"},
+		{raw: "1.5.1-0", want: "1.5.1"},
+		{raw: "1.5.1-8", want: "1.5.1-8"},
+		{raw: "1.6.0-rc.1+abc", want: "1.6.0-rc.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			// given
+			v, err := Parse(tt.raw)
+			require.NoError(t, err)
+
+			// when
+			got := v.String()
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+// DeployNamespaceChartAnnotation is the annotation in the Chart.yaml of a component which contains the namespace the
+// component is deployed to if the component does not declare a deploy namespace.
+const DeployNamespaceChartAnnotation = "k8s.cloudogu.com/deploy-namespace"
+
+// ComponentDefaulter fills in omitted fields of components when they are applied.
+type ComponentDefaulter struct {
+	helmClientFactory helmClientFactory
+	defaultNamespace  string
+	pinLatestVersion  bool
+}
+
+// NewComponentDefaulter creates a new defaulter for components. Components without a registry namespace get the
+// given default namespace. If pinLatestVersion is set, an empty version of a new component is replaced by the latest
+// version in the registry.
+func NewComponentDefaulter(newHelmClient helm.NewHelmClientFunc[helmClient], defaultNamespace string, pinLatestVersion bool) *ComponentDefaulter {
+	return &ComponentDefaulter{
+		helmClientFactory: newHelmClient,
+		defaultNamespace:  defaultNamespace,
+		pinLatestVersion:  pinLatestVersion,
+	}
+}
+
+// SetupWebhookWithManager registers the mutating webhook for components with the webhook server of the manager.
+func (d *ComponentDefaulter) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8sv1.Component{}).
+		WithDefaulter(d).
+		Complete()
+}
+
+// Default sets the name and the registry namespace of the component if they are empty. New components additionally
+// get the deploy namespace from the annotation DeployNamespaceChartAnnotation of their chart and optionally the latest
+// version from the registry. These fields are not defaulted on updates as they must not change after the installation.
+// Errors accessing the registry are only logged and leave these fields empty, so that the component is not rejected
+// while the registry is not available.
+func (d *ComponentDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	component, err := toComponent(obj)
+	if err != nil {
+		return err
+	}
+
+	if component.Spec.Name == "" {
+		component.Spec.Name = component.Name
+	}
+
+	if component.Spec.Namespace == "" {
+		component.Spec.Namespace = d.defaultNamespace
+	}
+
+	request, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if request.Operation != admissionv1.Create {
+		return nil
+	}
+
+	err = d.defaultFromRegistry(ctx, component)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to default fields of component from registry", "component", component.Spec.Name)
+	}
+
+	return nil
+}
+
+func (d *ComponentDefaulter) defaultFromRegistry(ctx context.Context, component *k8sv1.Component) error {
+	// adopted components get the version of their release
+	pinVersion := d.pinLatestVersion && component.Spec.Version == "" && !annotations.IsTrue(component, annotations.AdoptAnnotation)
+	if !pinVersion && component.Spec.DeployNamespace != "" {
+		return nil
+	}
+
+	helmClient, err := d.helmClientFactory.NewHelmClient()
+	if err != nil {
+		return fmt.Errorf("failed to create helm client: %w", err)
+	}
+
+	chartName := helm.GetHelmChartName(component)
+	if pinVersion {
+		latestVersion, err := helmClient.GetLatestVersion(chartName)
+		if err != nil {
+			return fmt.Errorf("failed to get latest version for component %q: %w", component.Spec.Name, err)
+		}
+
+		log.FromContext(ctx).Info("Pinned empty version of component to latest version", "component", component.Spec.Name, "version", latestVersion)
+		component.Spec.Version = latestVersion
+	}
+
+	if component.Spec.DeployNamespace != "" {
+		return nil
+	}
+
+	return d.defaultDeployNamespace(ctx, helmClient, component)
+}
+
+func (d *ComponentDefaulter) defaultDeployNamespace(ctx context.Context, helmClient helmClient, component *k8sv1.Component) error {
+	chartName := helm.GetHelmChartName(component)
+	chartVersion, err := resolveChartVersion(helmClient, component)
+	if err != nil {
+		return err
+	}
+
+	componentChart, err := helmClient.GetChart(ctx, &client.ChartSpec{ChartName: chartName, Version: chartVersion})
+	if err != nil {
+		return fmt.Errorf("failed to get chart of component %q to determine its deploy namespace: %w", component.Spec.Name, err)
+	}
+
+	if componentChart.Metadata == nil {
+		return nil
+	}
+
+	deployNamespace := componentChart.Metadata.Annotations[DeployNamespaceChartAnnotation]
+	if deployNamespace != "" {
+		log.FromContext(ctx).Info("Set deploy namespace of component from chart annotation", "component", component.Spec.Name, "deployNamespace", deployNamespace)
+		component.Spec.DeployNamespace = deployNamespace
+	}
+
+	return nil
+}
+
+func resolveChartVersion(helmClient helmClient, component *k8sv1.Component) (string, error) {
+	chartName := helm.GetHelmChartName(component)
+	if component.Spec.Version == "" {
+		latestVersion, err := helmClient.GetLatestVersion(chartName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get latest version for component %q: %w", component.Spec.Name, err)
+		}
+
+		return latestVersion, nil
+	}
+
+	selector, err := version.ParseSelector(component.Spec.Version)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine chart version of component %q: %w", component.Spec.Name, err)
+	}
+
+	resolvedVersion, err := helmClient.ResolveVersion(chartName, selector, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve version for component %q: %w", component.Spec.Name, err)
+	}
+
+	return resolvedVersion, nil
+}
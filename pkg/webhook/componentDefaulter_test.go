@@ -0,0 +1,273 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+func admissionCtx(operation admissionv1.Operation) context.Context {
+	return admission.NewContextWithRequest(testCtx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: operation}})
+}
+
+func newHelmClientFactoryMock(t *testing.T, helmClient helmClient) *mockHelmClientFactory {
+	factoryMock := newMockHelmClientFactory(t)
+	factoryMock.EXPECT().NewHelmClient().Return(helmClient, nil)
+	return factoryMock
+}
+
+func chartWithAnnotations(annotations map[string]string) *chart.Chart {
+	return &chart.Chart{Metadata: &chart.Metadata{Name: "k8s-longhorn", Annotations: annotations}}
+}
+
+func TestComponentDefaulter_Default(t *testing.T) {
+	createCtx := admissionCtx(admissionv1.Create)
+	longhornChart := chartWithAnnotations(map[string]string{DeployNamespaceChartAnnotation: "longhorn-system"})
+
+	t.Run("should set name, namespace and deploy namespace", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "", "1.5.1-1")
+		component.Spec.Namespace = ""
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().ResolveVersion("k8s/k8s-longhorn", mock.Anything, "").Return("1.5.1-1", nil)
+		helmClientMock.EXPECT().GetChart(createCtx, &client.ChartSpec{ChartName: "k8s/k8s-longhorn", Version: "1.5.1-1"}).Return(longhornChart, nil)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "k8s-longhorn", component.Spec.Name)
+		assert.Equal(t, "k8s", component.Spec.Namespace)
+		assert.Equal(t, "longhorn-system", component.Spec.DeployNamespace)
+		assert.Equal(t, "1.5.1-1", component.Spec.Version)
+	})
+	t.Run("should keep given fields", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "1.5.1-1")
+		component.Spec.Namespace = "k8s-testing"
+		component.Spec.DeployNamespace = "storage"
+		sut := &ComponentDefaulter{helmClientFactory: newMockHelmClientFactory(t), defaultNamespace: "k8s", pinLatestVersion: true}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "k8s-testing", component.Spec.Namespace)
+		assert.Equal(t, "storage", component.Spec.DeployNamespace)
+		assert.Equal(t, "1.5.1-1", component.Spec.Version)
+	})
+	t.Run("should resolve version range to get the chart", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "~1.5")
+		helmClientMock := newMockHelmClient(t)
+		selector, err := version.ParseSelector("~1.5")
+		require.NoError(t, err)
+		helmClientMock.EXPECT().ResolveVersion("k8s/k8s-longhorn", selector, "").Return("1.5.3", nil)
+		helmClientMock.EXPECT().GetChart(createCtx, &client.ChartSpec{ChartName: "k8s/k8s-longhorn", Version: "1.5.3"}).Return(longhornChart, nil)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s"}
+
+		// when
+		err = sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "longhorn-system", component.Spec.DeployNamespace)
+		assert.Equal(t, "~1.5", component.Spec.Version)
+	})
+	t.Run("should use latest chart for empty version without pinning it", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "")
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetLatestVersion("k8s/k8s-longhorn").Return("1.5.3", nil)
+		helmClientMock.EXPECT().GetChart(createCtx, &client.ChartSpec{ChartName: "k8s/k8s-longhorn", Version: "1.5.3"}).Return(chartWithAnnotations(nil), nil)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.DeployNamespace)
+		assert.Empty(t, component.Spec.Version)
+	})
+	t.Run("should pin empty version to latest version", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "")
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetLatestVersion("k8s/k8s-longhorn").Return("1.5.3", nil)
+		exact, err := version.ParseSelector("1.5.3")
+		require.NoError(t, err)
+		helmClientMock.EXPECT().ResolveVersion("k8s/k8s-longhorn", exact, "").Return("1.5.3", nil)
+		helmClientMock.EXPECT().GetChart(createCtx, &client.ChartSpec{ChartName: "k8s/k8s-longhorn", Version: "1.5.3"}).Return(longhornChart, nil)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s", pinLatestVersion: true}
+
+		// when
+		err = sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.5.3", component.Spec.Version)
+		assert.Equal(t, "longhorn-system", component.Spec.DeployNamespace)
+	})
+	t.Run("should pin empty version without getting the chart if the deploy namespace is given", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "")
+		component.Spec.DeployNamespace = "storage"
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetLatestVersion("k8s/k8s-longhorn").Return("1.5.3", nil)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s", pinLatestVersion: true}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.5.3", component.Spec.Version)
+		assert.Equal(t, "storage", component.Spec.DeployNamespace)
+	})
+	t.Run("should not pin empty version of adopted component", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "")
+		component.Annotations = map[string]string{annotations.AdoptAnnotation: "true"}
+		component.Spec.DeployNamespace = "storage"
+		sut := &ComponentDefaulter{helmClientFactory: newMockHelmClientFactory(t), defaultNamespace: "k8s", pinLatestVersion: true}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.Version)
+	})
+	t.Run("should only set name and namespace on update", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "", "")
+		component.Spec.Namespace = ""
+		sut := &ComponentDefaulter{helmClientFactory: newMockHelmClientFactory(t), defaultNamespace: "k8s", pinLatestVersion: true}
+
+		// when
+		err := sut.Default(admissionCtx(admissionv1.Update), component)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "k8s-longhorn", component.Spec.Name)
+		assert.Equal(t, "k8s", component.Spec.Namespace)
+		assert.Empty(t, component.Spec.DeployNamespace)
+		assert.Empty(t, component.Spec.Version)
+	})
+	t.Run("should leave deploy namespace empty if helm client cannot be created", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "1.5.1-1")
+		factoryMock := newMockHelmClientFactory(t)
+		factoryMock.EXPECT().NewHelmClient().Return(nil, assert.AnError)
+		sut := &ComponentDefaulter{helmClientFactory: factoryMock, defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.DeployNamespace)
+	})
+	t.Run("should leave version empty if latest version cannot be determined", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "")
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().GetLatestVersion("k8s/k8s-longhorn").Return("", assert.AnError)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s", pinLatestVersion: true}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.Version)
+		assert.Empty(t, component.Spec.DeployNamespace)
+	})
+	t.Run("should leave deploy namespace empty for invalid version", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "1.a.0")
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, newMockHelmClient(t)), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.DeployNamespace)
+	})
+	t.Run("should leave deploy namespace empty if version cannot be resolved", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "~1.5")
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().ResolveVersion("k8s/k8s-longhorn", mock.Anything, "").Return("", assert.AnError)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.DeployNamespace)
+	})
+	t.Run("should leave deploy namespace empty if chart cannot be pulled", func(t *testing.T) {
+		// given
+		component := getComponent("k8s-longhorn", "k8s-longhorn", "1.5.1-1")
+		helmClientMock := newMockHelmClient(t)
+		helmClientMock.EXPECT().ResolveVersion("k8s/k8s-longhorn", mock.Anything, "").Return("1.5.1-1", nil)
+		helmClientMock.EXPECT().GetChart(createCtx, mock.Anything).Return(nil, assert.AnError)
+		sut := &ComponentDefaulter{helmClientFactory: newHelmClientFactoryMock(t, helmClientMock), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, component.Spec.DeployNamespace)
+		assert.Equal(t, "k8s-longhorn", component.Spec.Name)
+	})
+	t.Run("should fail without admission request", func(t *testing.T) {
+		// given
+		sut := &ComponentDefaulter{helmClientFactory: newMockHelmClientFactory(t), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(testCtx, getComponent("k8s-longhorn", "k8s-longhorn", "1.5.1-1"))
+
+		// then
+		require.Error(t, err)
+	})
+	t.Run("should fail for other objects", func(t *testing.T) {
+		// given
+		sut := &ComponentDefaulter{helmClientFactory: newMockHelmClientFactory(t), defaultNamespace: "k8s"}
+
+		// when
+		err := sut.Default(createCtx, &corev1.ConfigMap{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected a component but got *v1.ConfigMap")
+	})
+}
+
+func TestNewComponentDefaulter(t *testing.T) {
+	// when
+	actual := NewComponentDefaulter(nil, "k8s", true)
+
+	// then
+	assert.Equal(t, "k8s", actual.defaultNamespace)
+	assert.True(t, actual.pinLatestVersion)
+}
@@ -0,0 +1,258 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+var (
+	specPath                = field.NewPath("spec")
+	namePath                = specPath.Child("name")
+	versionPath             = specPath.Child("version")
+	deployNamespacePath     = specPath.Child("deployNamespace")
+	valuesYamlOverwritePath = specPath.Child("valuesYamlOverwrite")
+	valuesConfigRefPath     = specPath.Child("valuesConfigRef")
+)
+
+// ComponentValidator rejects invalid components when they are created or updated so that errors do not only show up
+// during the reconciliation.
+type ComponentValidator struct {
+	configMapClient configMapClient
+	allowDowngrades bool
+}
+
+// NewComponentValidator creates a new validator for components. The config map client must access the namespace of
+// the operator as the values of components are referenced from there.
+func NewComponentValidator(configMapClient configMapClient, allowDowngrades bool) *ComponentValidator {
+	return &ComponentValidator{
+		configMapClient: configMapClient,
+		allowDowngrades: allowDowngrades,
+	}
+}
+
+// SetupWebhookWithManager registers the validating webhook for components with the webhook server of the manager.
+func (v *ComponentValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8sv1.Component{}).
+		WithValidator(v).
+		Complete()
+}
+
+// ValidateCreate validates a new component.
+func (v *ComponentValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	component, err := toComponent(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	errs, err := v.validateSpec(ctx, component)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, toInvalidError(component, errs)
+}
+
+// ValidateUpdate validates a changed component. Additionally to the checks on creation, the deploy namespace of an
+// installed component must only change with a requested migration and downgrades are rejected if they are not allowed.
+func (v *ComponentValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldComponent, err := toComponent(oldObj)
+	if err != nil {
+		return nil, err
+	}
+
+	newComponent, err := toComponent(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newComponent.DeletionTimestamp.IsZero() {
+		// the finalizer must always be removable from a component which is being deleted
+		return nil, nil
+	}
+
+	if reflect.DeepEqual(oldComponent.Spec, newComponent.Spec) {
+		// metadata changes like finalizers or annotations from the operator must not fail because of an already
+		// existing component, e.g. if the referenced config map was deleted after the installation
+		return nil, nil
+	}
+
+	errs, err := v.validateSpec(ctx, newComponent)
+	if err != nil {
+		return nil, err
+	}
+
+	errs = append(errs, validateDeployNamespaceChange(oldComponent, newComponent)...)
+	errs = append(errs, v.validateDowngrade(oldComponent, newComponent)...)
+
+	return nil, toInvalidError(newComponent, errs)
+}
+
+// ValidateDelete accepts every deletion. Dependencies are checked by the reconciler.
+func (v *ComponentValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ComponentValidator) validateSpec(ctx context.Context, component *k8sv1.Component) (field.ErrorList, error) {
+	var errs field.ErrorList
+	errs = append(errs, validateName(component)...)
+	errs = append(errs, validateVersion(component)...)
+	errs = append(errs, validateValuesYamlOverwrite(component)...)
+
+	configRefErrs, err := v.validateValuesConfigRef(ctx, component)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(errs, configRefErrs...), nil
+}
+
+func validateName(component *k8sv1.Component) field.ErrorList {
+	if component.Name == component.Spec.Name {
+		return nil
+	}
+
+	return field.ErrorList{field.Invalid(namePath, component.Spec.Name,
+		fmt.Sprintf("must be equal to the name of the component %q", component.Name))}
+}
+
+func validateVersion(component *k8sv1.Component) field.ErrorList {
+	if component.Spec.Version == "" {
+		return nil
+	}
+
+	_, err := version.ParseSelector(component.Spec.Version)
+	if err != nil {
+		return field.ErrorList{field.Invalid(versionPath, component.Spec.Version, err.Error())}
+	}
+
+	return nil
+}
+
+func validateValuesYamlOverwrite(component *k8sv1.Component) field.ErrorList {
+	values := map[string]interface{}{}
+	err := yaml.Unmarshal([]byte(component.Spec.ValuesYamlOverwrite), &values)
+	if err != nil {
+		return field.ErrorList{field.Invalid(valuesYamlOverwritePath, component.Spec.ValuesYamlOverwrite,
+			fmt.Sprintf("must be a YAML object: %s", err.Error()))}
+	}
+
+	return nil
+}
+
+func (v *ComponentValidator) validateValuesConfigRef(ctx context.Context, component *k8sv1.Component) (field.ErrorList, error) {
+	reference := component.Spec.ValuesConfigRef
+	if reference == nil || reference.Name == "" {
+		return nil, nil
+	}
+
+	configMap, err := v.configMapClient.Get(ctx, reference.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return field.ErrorList{field.NotFound(valuesConfigRefPath.Child("name"), reference.Name)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config map %q referenced by component %q: %w", reference.Name, component.Name, err)
+	}
+
+	if _, exists := configMap.Data[reference.Key]; !exists {
+		return field.ErrorList{field.Invalid(valuesConfigRefPath.Child("key"), reference.Key,
+			fmt.Sprintf("key does not exist in config map %q", reference.Name))}, nil
+	}
+
+	return nil, nil
+}
+
+func validateDeployNamespaceChange(oldComponent, newComponent *k8sv1.Component) field.ErrorList {
+	if !isInstalled(oldComponent) {
+		return nil
+	}
+
+	oldNamespace := effectiveDeployNamespace(oldComponent)
+	newNamespace := effectiveDeployNamespace(newComponent)
+	if oldNamespace == newNamespace {
+		return nil
+	}
+
+	if oldComponent.Status.Migration != nil {
+		return field.ErrorList{field.Forbidden(deployNamespacePath,
+			fmt.Sprintf("must not be changed from %q to %q while the component is migrated", oldNamespace, newNamespace))}
+	}
+
+	if annotations.IsTrue(newComponent, annotations.MigrateNamespaceAnnotation) {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(deployNamespacePath,
+		fmt.Sprintf("must not be changed from %q to %q after the component was installed: set the annotation %q to \"true\" to migrate the component",
+			oldNamespace, newNamespace, annotations.MigrateNamespaceAnnotation))}
+}
+
+func (v *ComponentValidator) validateDowngrade(oldComponent, newComponent *k8sv1.Component) field.ErrorList {
+	installedVersion := oldComponent.Status.InstalledVersion
+	if installedVersion == "" || v.allowDowngrades || annotations.IsTrue(newComponent, annotations.AllowDowngradeAnnotation) {
+		return nil
+	}
+
+	selector, err := version.ParseSelector(newComponent.Spec.Version)
+	if err != nil || !selector.IsExact() {
+		// invalid versions are already reported and only exact versions can be compared before they are resolved
+		return nil
+	}
+
+	installed, err := version.Parse(installedVersion)
+	if err != nil {
+		return nil
+	}
+
+	desired, err := version.Parse(newComponent.Spec.Version)
+	if err != nil || !desired.IsOlderThan(installed) {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(versionPath,
+		fmt.Sprintf("downgrade from %s to %s is not allowed: set the annotation %q to \"true\" to allow it",
+			installedVersion, newComponent.Spec.Version, annotations.AllowDowngradeAnnotation))}
+}
+
+func isInstalled(component *k8sv1.Component) bool {
+	return component.Status.InstalledVersion != "" ||
+		(component.Status.Status != "" && component.Status.Status != k8sv1.ComponentStatusNotInstalled)
+}
+
+func effectiveDeployNamespace(component *k8sv1.Component) string {
+	if component.Spec.DeployNamespace == "" {
+		return component.Namespace
+	}
+
+	return component.Spec.DeployNamespace
+}
+
+func toComponent(obj runtime.Object) (*k8sv1.Component, error) {
+	component, ok := obj.(*k8sv1.Component)
+	if !ok {
+		return nil, fmt.Errorf("expected a component but got %T", obj)
+	}
+
+	return component, nil
+}
+
+func toInvalidError(component *k8sv1.Component, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return k8serrors.NewInvalid(k8sv1.GroupVersion.WithKind("Component").GroupKind(), component.Name, errs)
+}
@@ -0,0 +1,408 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	k8sv1 "github.com/cloudogu/k8s-component-lib/api/v1"
+	"github.com/cloudogu/k8s-component-operator/pkg/annotations"
+)
+
+const testNamespace = "ecosystem"
+
+var testCtx = context.Background()
+
+func getComponent(name, specName, version string) *k8sv1.Component {
+	return &k8sv1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: k8sv1.ComponentSpec{
+			Namespace: "k8s",
+			Name:      specName,
+			Version:   version,
+		},
+	}
+}
+
+func getInstalledComponent(version string) *k8sv1.Component {
+	component := getComponent("dogu-op", "dogu-op", version)
+	component.Status = k8sv1.ComponentStatus{Status: k8sv1.ComponentStatusInstalled, InstalledVersion: version}
+	return component
+}
+
+func TestComponentValidator_ValidateCreate(t *testing.T) {
+	t.Run("should accept valid component", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "dogu-op", "~1.2")
+		component.Spec.ValuesYamlOverwrite = "foo:\n  bar: baz\n"
+		component.Spec.ValuesConfigRef = &k8sv1.Reference{Name: "dogu-op-values", Key: "values"}
+		configMapMock := newMockConfigMapClient(t)
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-values", metav1.GetOptions{}).
+			Return(&corev1.ConfigMap{Data: map[string]string{"values": "foo: bar"}}, nil)
+		sut := NewComponentValidator(configMapMock, false)
+
+		// when
+		warnings, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+	t.Run("should accept component without version", func(t *testing.T) {
+		// given
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, getComponent("dogu-op", "dogu-op", ""))
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should reject name mismatch", func(t *testing.T) {
+		// given
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, getComponent("dogu-op", "service-discovery", "1.0.0"))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.name: Invalid value: \"service-discovery\": must be equal to the name of the component \"dogu-op\"")
+	})
+	t.Run("should reject invalid version", func(t *testing.T) {
+		// given
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, getComponent("dogu-op", "dogu-op", "1.a.0"))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.version: Invalid value: \"1.a.0\"")
+	})
+	t.Run("should reject malformed values yaml", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "dogu-op", "1.0.0")
+		component.Spec.ValuesYamlOverwrite = "foo: [bar"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.valuesYamlOverwrite: Invalid value")
+		assert.ErrorContains(t, err, "must be a YAML object")
+	})
+	t.Run("should reject values yaml which is not an object", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "dogu-op", "1.0.0")
+		component.Spec.ValuesYamlOverwrite = "- foo\n- bar\n"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "spec.valuesYamlOverwrite: Invalid value")
+	})
+	t.Run("should reject missing values config map", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "dogu-op", "1.0.0")
+		component.Spec.ValuesConfigRef = &k8sv1.Reference{Name: "dogu-op-values", Key: "values"}
+		configMapMock := newMockConfigMapClient(t)
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-values", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "dogu-op-values"))
+		sut := NewComponentValidator(configMapMock, false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.valuesConfigRef.name: Not found: \"dogu-op-values\"")
+	})
+	t.Run("should reject missing values config map key", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "dogu-op", "1.0.0")
+		component.Spec.ValuesConfigRef = &k8sv1.Reference{Name: "dogu-op-values", Key: "values"}
+		configMapMock := newMockConfigMapClient(t)
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-values", metav1.GetOptions{}).
+			Return(&corev1.ConfigMap{Data: map[string]string{"other": "foo: bar"}}, nil)
+		sut := NewComponentValidator(configMapMock, false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.valuesConfigRef.key: Invalid value: \"values\": key does not exist in config map \"dogu-op-values\"")
+	})
+	t.Run("should fail to get values config map", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "dogu-op", "1.0.0")
+		component.Spec.ValuesConfigRef = &k8sv1.Reference{Name: "dogu-op-values", Key: "values"}
+		configMapMock := newMockConfigMapClient(t)
+		configMapMock.EXPECT().Get(testCtx, "dogu-op-values", metav1.GetOptions{}).Return(nil, assert.AnError)
+		sut := NewComponentValidator(configMapMock, false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.False(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "failed to get config map \"dogu-op-values\" referenced by component \"dogu-op\"")
+	})
+	t.Run("should report all errors", func(t *testing.T) {
+		// given
+		component := getComponent("dogu-op", "service-discovery", "1.a.0")
+		component.Spec.ValuesYamlOverwrite = "foo: [bar"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, component)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "spec.name")
+		assert.ErrorContains(t, err, "spec.version")
+		assert.ErrorContains(t, err, "spec.valuesYamlOverwrite")
+	})
+	t.Run("should fail for other objects", func(t *testing.T) {
+		// given
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateCreate(testCtx, &corev1.ConfigMap{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected a component but got *v1.ConfigMap")
+	})
+}
+
+func TestComponentValidator_ValidateUpdate(t *testing.T) {
+	t.Run("should accept upgrade", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "1.1.0"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should reject invalid version", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "latest"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "spec.version: Invalid value: \"latest\"")
+	})
+	t.Run("should reject changed deploy namespace of installed component", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.DeployNamespace = "longhorn-system"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.deployNamespace: Forbidden: must not be changed from \"ecosystem\" to \"longhorn-system\" after the component was installed")
+	})
+	t.Run("should accept changed deploy namespace of installed component with migration", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.DeployNamespace = "longhorn-system"
+		newComponent.Annotations = map[string]string{annotations.MigrateNamespaceAnnotation: "true"}
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should reject changed deploy namespace of component which is migrated", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		oldComponent.Spec.DeployNamespace = "longhorn-system"
+		oldComponent.Annotations = map[string]string{annotations.MigrateNamespaceAnnotation: "true"}
+		oldComponent.Status.Migration = &k8sv1.MigrationStatus{Step: "Prepared"}
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.DeployNamespace = "monitoring"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.deployNamespace: Forbidden: must not be changed from \"longhorn-system\" to \"monitoring\" while the component is migrated")
+	})
+	t.Run("should accept explicitly set deploy namespace which equals the namespace of the component", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.DeployNamespace = testNamespace
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should accept changed deploy namespace of component which is not installed", func(t *testing.T) {
+		// given
+		oldComponent := getComponent("dogu-op", "dogu-op", "1.0.0")
+		oldComponent.Status.Status = k8sv1.ComponentStatusNotInstalled
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.DeployNamespace = "longhorn-system"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should reject downgrade", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.2.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "1.1.0"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, k8serrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "spec.version: Forbidden: downgrade from 1.2.0 to 1.1.0 is not allowed")
+	})
+	t.Run("should accept downgrade if allowed globally", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.2.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "1.1.0"
+		sut := NewComponentValidator(newMockConfigMapClient(t), true)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should accept downgrade if allowed by annotation", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.2.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "1.1.0"
+		newComponent.Annotations = map[string]string{annotations.AllowDowngradeAnnotation: "true"}
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should accept version range", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.2.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "~1.1"
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should accept unchanged spec of invalid component", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.0.0")
+		oldComponent.Spec.ValuesConfigRef = &k8sv1.Reference{Name: "deleted-values", Key: "values"}
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Finalizers = []string{"component-finalizer"}
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should accept component which is being deleted", func(t *testing.T) {
+		// given
+		oldComponent := getInstalledComponent("1.2.0")
+		newComponent := oldComponent.DeepCopy()
+		newComponent.Spec.Version = "1.1.0"
+		now := metav1.Now()
+		newComponent.DeletionTimestamp = &now
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, err := sut.ValidateUpdate(testCtx, oldComponent, newComponent)
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail for other objects", func(t *testing.T) {
+		// given
+		sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+		// when
+		_, oldErr := sut.ValidateUpdate(testCtx, &corev1.ConfigMap{}, getInstalledComponent("1.0.0"))
+		_, newErr := sut.ValidateUpdate(testCtx, getInstalledComponent("1.0.0"), &corev1.ConfigMap{})
+
+		// then
+		require.Error(t, oldErr)
+		require.Error(t, newErr)
+	})
+}
+
+func TestComponentValidator_ValidateDelete(t *testing.T) {
+	// given
+	sut := NewComponentValidator(newMockConfigMapClient(t), false)
+
+	// when
+	warnings, err := sut.ValidateDelete(testCtx, getComponent("dogu-op", "service-discovery", "1.a.0"))
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
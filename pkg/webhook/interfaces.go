@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"context"
+
+	"helm.sh/helm/v3/pkg/chart"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/cloudogu/k8s-component-operator/pkg/helm/client"
+	"github.com/cloudogu/k8s-component-operator/pkg/version"
+)
+
+type configMapClient interface {
+	v1.ConfigMapInterface
+}
+
+type helmClient interface {
+	// GetLatestVersion tries to get the latest version identifier for the chart with the given name.
+	GetLatestVersion(chartName string) (string, error)
+	// ResolveVersion returns the newest version of the chart with the given name matching the selector.
+	ResolveVersion(chartName string, selector version.Selector, installedVersion string) (string, error)
+	// GetChart pulls the chart described by the chart spec.
+	GetChart(ctx context.Context, spec *client.ChartSpec) (*chart.Chart, error)
+}
+
+type helmClientFactory interface {
+	NewHelmClient() (helmClient, error)
+}